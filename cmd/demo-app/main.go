@@ -8,7 +8,7 @@ functional example and a testing ground for the component library.
 # Features
 
 • Complete showcase of all available components
-• Light/dark theme switching with live preview
+• Light, dark and high-contrast theme switching with live preview
 • Interactive zoom functionality (Ctrl+/-, Ctrl+0)
 • Custom frameless window with titlebar
 • Responsive layout design
@@ -25,6 +25,7 @@ Run the demo application:
 
 • Button - All variants (default, destructive, outline, secondary, ghost, link)
 • Card - Container with structured content
+• Divider - Fading gradient lines between sections
 • Input - Text input with placeholder and validation
 • Label - Typography elements (H1-H4, body text, small text)
 • Titlebar - Custom window controls and branding
@@ -37,7 +38,8 @@ Keyboard shortcuts:
 • Ctrl + 0 - Reset zoom to 100%
 
 UI controls:
-• Theme toggle button (light/dark mode switching)
+• Theme toggle button (light/dark/high-contrast switching)
+• Save Theme button (writes theme-custom.json)
 • Zoom control buttons with current zoom display
 • Window controls (minimize, maximize, close)
 • Interactive component examples
@@ -59,8 +61,11 @@ package main
 
 import (
 	"fmt"
+	"image"
+	"image/color"
 	"log"
 	"os"
+	"slices"
 
 	"gioui.org/app"
 	"gioui.org/io/key"
@@ -69,14 +74,49 @@ import (
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/unit"
+	"gioui.org/widget"
 	"github.com/bnema/gio-shadcn/components/button"
 	"github.com/bnema/gio-shadcn/components/card"
+	"github.com/bnema/gio-shadcn/components/charts"
+	"github.com/bnema/gio-shadcn/components/divider"
 	"github.com/bnema/gio-shadcn/components/input"
 	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/menubar"
+	"github.com/bnema/gio-shadcn/components/notification"
+	"github.com/bnema/gio-shadcn/components/themebuilder"
 	"github.com/bnema/gio-shadcn/components/titlebar"
 	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+	"golang.org/x/exp/shiny/materialdesign/icons"
 )
 
+// VariantBrand is a custom button variant registered on the demo theme.
+const VariantBrand theme.Variant = "brand"
+
+// newBrandRegistry returns a variant registry holding the teal brand variant.
+func newBrandRegistry() *theme.VariantRegistry {
+	teal := color.NRGBA{R: 0x0d, G: 0x94, B: 0x88, A: 0xff}
+	white := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	reg := theme.NewVariantRegistry()
+	reg.RegisterFunc(VariantBrand, func(colors *theme.ColorScheme) theme.VariantConfig {
+		return theme.VariantConfig{
+			Background: teal,
+			Foreground: white,
+			Border:     teal,
+			HoverBg:    colorutil.Darken(teal, 0.1),
+			HoverFg:    white,
+			ActiveBg:   colorutil.Darken(teal, 0.2),
+			ActiveFg:   white,
+			DisabledBg: colors.Muted,
+			DisabledFg: colors.MutedFg,
+			FocusRing:  teal,
+		}
+	})
+	return reg
+}
+
 func main() {
 	go func() {
 		w := &app.Window{}
@@ -99,36 +139,189 @@ func updateWindowColors(w *app.Window, th *theme.Theme) {
 	w.Option(app.StatusColor(th.Colors.Background))
 }
 
+// themedWidget is a component laid out with a theme.
+type themedWidget interface {
+	Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions
+}
+
+// widgets adapts themed components to layout.Widget for the flex helpers.
+func widgets(th *theme.Theme, components ...themedWidget) []layout.Widget {
+	result := make([]layout.Widget, len(components))
+	for i, c := range components {
+		result[i] = func(gtx layout.Context) layout.Dimensions {
+			return c.Layout(gtx, th)
+		}
+	}
+	return result
+}
+
 // layoutButtonRow renders a horizontal row of buttons with spacing.
 func layoutButtonRow(gtx layout.Context, th *theme.Theme, buttons ...*button.Button) layout.Dimensions {
-	children := make([]layout.FlexChild, 0, len(buttons)*2)
+	components := make([]themedWidget, len(buttons))
 	for i, btn := range buttons {
-		btn := btn // capture loop variable
-		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			return btn.Layout(gtx, th)
-		}))
-		// Add spacing between buttons (except after the last one)
-		if i < len(buttons)-1 {
-			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-				return layout.Spacer{Width: th.Spacing.Space2}.Layout(gtx)
-			}))
-		}
+		components[i] = btn
+	}
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		utils.FlexRow(th.Spacing.Space2, widgets(th, components...)...),
+	)
+}
+
+// newHighContrastTheme creates a theme with maximum-contrast colors:
+// pure black on white, with black borders and a black primary.
+func newHighContrastTheme() (*theme.Theme, error) {
+	th, err := theme.NewFromPalette("neutral", "neutral")
+	if err != nil {
+		return nil, err
+	}
+
+	black := color.NRGBA{A: 255}
+	white := color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	cs := &th.Colors
+	cs.Background, cs.Foreground = white, black
+	cs.Card, cs.CardFg = white, black
+	cs.Popover, cs.PopoverFg = white, black
+	cs.Primary, cs.PrimaryFg = black, white
+	cs.Secondary, cs.SecondaryFg = white, black
+	cs.Muted, cs.MutedFg = white, black
+	cs.Accent, cs.AccentFg = black, white
+	cs.Border, cs.Input, cs.Ring = black, black, black
+	return th, nil
+}
+
+// newThemeSet creates the demo themes, cycled in order by the theme button.
+func newThemeSet() (*theme.ThemeSet, error) {
+	highContrast, err := newHighContrastTheme()
+	if err != nil {
+		return nil, err
+	}
+
+	themes := theme.NewThemeSet(nil, "")
+	themes.Add("light", theme.New())
+	themes.Add("dark", theme.NewDark())
+	themes.Add("high-contrast", highContrast)
+	themes.Add("warm", theme.NewWarm())
+	themes.Add("cool", theme.NewCool())
+	themes.Add("slate", theme.NewSlate())
+	themes.Add("stone", theme.NewStone())
+
+	registry := newBrandRegistry()
+	for _, name := range themes.Names() {
+		t, _ := themes.Get(name)
+		t.Registry = registry
 	}
-	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+	return themes, nil
+}
+
+// themeLabels are the theme button labels announcing each demo theme.
+var themeLabels = map[string]string{
+	"light":         "☀️ Light Mode",
+	"dark":          "🌙 Dark Mode",
+	"high-contrast": "◐ High Contrast",
+	"warm":          "🔥 Warm Dark",
+	"cool":          "❄️ Cool Light",
+	"slate":         "Slate",
+	"stone":         "Stone",
+}
+
+// themeButtonText returns the theme button label announcing the next theme.
+func themeButtonText(themes *theme.ThemeSet) string {
+	names := themes.Names()
+	next := names[(slices.Index(names, themes.CurrentName())+1)%len(names)]
+	return themeLabels[next]
+}
+
+// inputWidth is the width of the demo inputs and drop zone.
+const inputWidth = unit.Dp(400)
+
+// layoutColumn stacks widgets vertically with gap between them.
+func layoutColumn(gtx layout.Context, gap unit.Dp, children ...layout.Widget) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, utils.FlexColumn(gap, children...))
+}
+
+// layoutSection renders a section title above the section's widgets.
+func layoutSection(gtx layout.Context, th *theme.Theme, title string, content ...layout.Widget) layout.Dimensions {
+	heading := label.NewTypography(title, label.H4, "")
+	return layoutColumn(gtx, th.Spacing.Space4, append(widgets(th, heading), content...)...)
+}
+
+// layoutFixedWidth lays out w at width, or at the available width when it is
+// narrower.
+func layoutFixedWidth(gtx layout.Context, width unit.Dp, w layout.Widget) layout.Dimensions {
+	gtx.Constraints.Max.X = min(gtx.Constraints.Max.X, gtx.Dp(width))
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return w(gtx)
+}
+
+// layoutSectionDivider separates demo sections with a fading divider line.
+// The column around the sections spaces it from them.
+func layoutSectionDivider(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	return divider.FadeDivider(th).Layout(gtx)
 }
 
 func run(w *app.Window) error {
-	// Initialize theme
-	th := theme.New()
+	// Initialize themes
+	themes, err := newThemeSet()
+	if err != nil {
+		return err
+	}
+	accessibility := theme.AccessibilityFromSystem()
+	theme.ApplyAccessibility(accessibility)
+	th := themes.Current().WithAccessibility(accessibility)
+
+	// Theme customizer sidebar, editing th in place
+	var presets []themebuilder.Option
+	for _, name := range themes.Names() {
+		preset, _ := themes.Get(name)
+		presets = append(presets, themebuilder.WithPreset(name, preset))
+	}
+	customizer := themebuilder.NewCustomizer(th, w, presets...)
+	showCustomizer := false
 
 	// Set initial window colors to match theme
 	updateWindowColors(w, th)
 
+	// Accessibility menu, applied on top of the current theme
+	accessibilityItem := func(text string, mode theme.AccessibilityMode) menubar.MenuItem {
+		return menubar.MenuItem{
+			Label: text,
+			OnSelect: func() {
+				accessibility = mode
+				theme.ApplyAccessibility(accessibility)
+				th = themes.Current().WithAccessibility(accessibility)
+				customizer.SetTheme(th)
+				updateWindowColors(w, th)
+				w.Invalidate()
+				log.Printf("Accessibility mode: %s", mode)
+			},
+		}
+	}
+	accessibilityMenu := menubar.NewMenuBar(menubar.Menu{
+		Label: "Accessibility",
+		Items: []menubar.MenuItem{
+			accessibilityItem("Default", theme.AccessibilityNone),
+			accessibilityItem("High Contrast", theme.AccessibilityHighContrast),
+			accessibilityItem("Reduced Motion", theme.AccessibilityReducedMotion),
+			accessibilityItem("Large Print", theme.AccessibilityLargePrint),
+		},
+	})
+
+	// Notification bell, cleared when clicked
+	var bell *notification.Bell
+	bell = notification.NewBell(
+		notification.WithUnreadCount(3),
+		notification.WithAnimate(true),
+		notification.WithOnClick(func() {
+			bell.SetUnreadCount(0)
+		}),
+	)
+
 	// Initialize title bar with secondary variant
 	tb := titlebar.NewTitleBar(
 		titlebar.WithTitle("Gio-shadcn Demo"),
 		titlebar.WithWindow(w),
 		titlebar.WithVariant(theme.VariantSecondary),
+		titlebar.WithNotificationBell(bell),
+		titlebar.WithMenuBar(accessibilityMenu),
 	)
 
 	// Zoom state
@@ -195,27 +388,63 @@ func run(w *app.Window) error {
 		},
 	})
 
+	brandBtn := button.New(button.Config{
+		Text:    "Brand",
+		Variant: VariantBrand,
+		Size:    theme.SizeDefault,
+		OnClick: func() {
+			log.Println("Brand button clicked!")
+		},
+	})
+
 	// Theme toggle button
 	var themeToggleBtn *button.Button
 	themeToggleBtn = button.New(button.Config{
-		Text:    "🌙 Dark Mode",
+		Text:    themeButtonText(themes),
 		Variant: theme.VariantOutline,
 		Size:    theme.SizeSM,
 		OnClick: func() {
-			th.ToggleDark()
+			themes.ToggleDark()
+			th = themes.Current().WithAccessibility(accessibility)
+			customizer.SetTheme(th)
 			// Update window colors to match new theme
 			updateWindowColors(w, th)
-			// Update button text based on new theme state
-			if th.IsDark {
-				themeToggleBtn.SetText("☀️ Light Mode")
-			} else {
-				themeToggleBtn.SetText("🌙 Dark Mode")
-			}
+			// Update button text to announce the next theme
+			themeToggleBtn.SetText(themeButtonText(themes))
 			w.Invalidate() // Force immediate redraw
 			log.Println("Theme toggled!")
 		},
 	})
 
+	// Save theme button
+	saveThemeBtn := button.New(button.Config{
+		Text:    "Save Theme",
+		Variant: theme.VariantOutline,
+		Size:    theme.SizeSM,
+		OnClick: func() {
+			if err := th.SaveToFile("theme-custom.json"); err != nil {
+				log.Printf("Failed to save theme: %v", err)
+				return
+			}
+			log.Println("Theme saved to theme-custom.json")
+		},
+	})
+
+	// Customizer toggle button
+	paletteIcon, err := widget.NewIcon(icons.ImagePalette)
+	if err != nil {
+		return err
+	}
+	customizeBtn := button.NewButton(
+		button.WithIcon(paletteIcon),
+		button.WithVariant(theme.VariantOutline),
+		button.WithSize(theme.SizeIcon),
+		button.WithOnClick(func() {
+			showCustomizer = !showCustomizer
+			w.Invalidate()
+		}),
+	)
+
 	// Zoom control buttons
 	zoomInBtn := button.New(button.Config{
 		Text:    "+",
@@ -267,11 +496,53 @@ func run(w *app.Window) error {
 	demoCard := card.New(card.Config{
 		Variant: theme.VariantDefault,
 	})
+	dropZoneCard := card.NewCard(
+		card.WithBorderStyle(theme.BorderDashed),
+		card.WithCardPadding(theme.InsetN(th, "6", "6", "6", "6")),
+	)
+
+	// Kanban board with draggable cards
+	kanban := card.NewKanbanBoard([]card.KanbanColumn{
+		{ID: "todo", Title: "To Do", Items: []string{"Design tokens", "Dialog component"}},
+		{ID: "doing", Title: "In Progress", Items: []string{"Kanban demo"}},
+		{ID: "done", Title: "Done", Items: []string{"Theme sets"}},
+	})
+	kanban.OnMove = func(item, from, to string) {
+		log.Printf("Moved %q from %s to %s", item, from, to)
+	}
+
+	// Dashboard charts
+	barChart := charts.NewBarChart([]charts.BarDataPoint{
+		{Label: "Jan", Value: 186},
+		{Label: "Feb", Value: 305},
+		{Label: "Mar", Value: 237},
+		{Label: "Apr", Value: 73},
+		{Label: "May", Value: 209},
+		{Label: "Jun", Value: 214},
+	}, charts.WithGrid(true), charts.WithHeight(180))
+	lineChart := charts.NewLineChart([]charts.LineSeries{
+		{Points: []image.Point{{0, 186}, {1, 305}, {2, 237}, {3, 73}, {4, 209}, {5, 214}}},
+		{Points: []image.Point{{0, 80}, {1, 200}, {2, 120}, {3, 190}, {4, 130}, {5, 140}}, Color: theme.PaletteBlue[theme.Shade400]},
+	}, charts.WithGrid(true), charts.WithHeight(180))
 
 	// Input component
 	textInput := input.Text("Enter your name...")
+	commentInput := input.NewInput(
+		input.WithPlaceholder("Leave a comment..."),
+		input.WithMultiline(2),
+		input.WithMaxRows(6),
+		input.WithAutoResize(true),
+	)
 
 	titleLabel := label.NewTypography("Demo-app", label.H1, "")
+	cardTitle := label.NewTypography("Component Examples", label.H3, "")
+	dropZoneLabel := label.NewTypography("Drop files here", label.Muted, "")
+	h1Example := label.NewTypography("Heading 1", label.H1, "")
+	h2Example := label.NewTypography("Heading 2", label.H2, "")
+	h3Example := label.NewTypography("Heading 3", label.H3, "")
+	bodyExample := label.NewTypography("This is a paragraph of body text demonstrating the typography system.", label.P, "")
+	smallExample := label.NewTypography("Small text for captions and fine print.", label.Small, "")
+	mutedExample := label.NewTypography("Muted text for secondary information.", label.Muted, "")
 	subtitleLabel := label.NewTypography("A shadcn/ui port for Gio", label.P, "")
 	zoomHelpLabel := label.NewTypography("Use buttons or Ctrl+/- to zoom, Ctrl+0 to reset", label.Small, "")
 
@@ -375,30 +646,13 @@ func run(w *app.Window) error {
 
 				// Header
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-					return layout.Inset{
-						Top:    th.Spacing.Space8,
-						Bottom: th.Spacing.Space8,
-						Left:   th.Spacing.Space8,
-						Right:  th.Spacing.Space8,
-					}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return theme.PageInset(th).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 						return layout.Flex{
 							Axis:      layout.Horizontal,
 							Alignment: layout.Middle,
 						}.Layout(gtx,
 							layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
-								return layout.Flex{
-									Axis: layout.Vertical,
-								}.Layout(gtx,
-									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return titleLabel.Layout(gtx, th)
-									}),
-									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
-									}),
-									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return subtitleLabel.Layout(gtx, th)
-									}),
-								)
+								return layoutColumn(gtx, th.Spacing.Space2, widgets(th, titleLabel, subtitleLabel)...)
 							}),
 							layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 								return layout.Flex{
@@ -406,38 +660,23 @@ func run(w *app.Window) error {
 									Alignment: layout.End,
 								}.Layout(gtx,
 									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return themeToggleBtn.Layout(gtx, th)
-									}),
-									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
+										return layoutButtonRow(gtx, th, saveThemeBtn, themeToggleBtn, customizeBtn)
 									}),
+									// Zoom controls, with the zoom level centered on the buttons
 									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-										return layout.Flex{
-											Axis:      layout.Horizontal,
-											Alignment: layout.Middle,
-										}.Layout(gtx,
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return zoomOutBtn.Layout(gtx, th)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return layout.Spacer{Width: th.Spacing.Space2}.Layout(gtx)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return zoomInBtn.Layout(gtx, th)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return layout.Spacer{Width: th.Spacing.Space2}.Layout(gtx)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return zoomResetBtn.Layout(gtx, th)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return layout.Spacer{Width: th.Spacing.Space4}.Layout(gtx)
-											}),
-											layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-												return zoomLabel.Layout(gtx, th)
-											}),
-										)
+										return layout.Inset{Top: th.Spacing.Space4}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+											return layout.Flex{
+												Axis:      layout.Horizontal,
+												Alignment: layout.Middle,
+											}.Layout(gtx,
+												utils.FlexRow(th.Spacing.Space2, widgets(th, zoomOutBtn, zoomInBtn, zoomResetBtn)...),
+												layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+													return layout.Inset{Left: th.Spacing.Space4}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+														return zoomLabel.Layout(gtx, th)
+													})
+												}),
+											)
+										})
 									}),
 									layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 										return zoomHelpLabel.Layout(gtx, th)
@@ -448,147 +687,112 @@ func run(w *app.Window) error {
 					})
 				}),
 
-				// Main content
+				// Main content, with the customizer sidebar when open
 				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
-					return layout.Inset{
-						Top:    th.Spacing.Space4,
-						Bottom: th.Spacing.Space8,
-						Left:   th.Spacing.Space8,
-						Right:  th.Spacing.Space8,
-					}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-						return demoCard.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
-							return layout.Flex{
-								Axis: layout.Vertical,
-							}.Layout(gtx,
-								// Card header
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									cardTitle := label.NewTypography("Component Examples", label.H3, "")
-									return layout.Flex{
-										Axis: layout.Vertical,
-									}.Layout(gtx,
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+						layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+							return theme.InsetN(th, "4", "8", "8", "8").Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+								return demoCard.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+									sectionDivider := func(gtx layout.Context) layout.Dimensions {
+										return layoutSectionDivider(gtx, th)
+									}
+									return layoutColumn(gtx, th.Spacing.Space4,
+										func(gtx layout.Context) layout.Dimensions {
 											return cardTitle.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
+										},
+
+										// Button examples
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutSection(gtx, th, "Buttons",
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutButtonRow(gtx, th, primaryBtn, destructiveBtn, outlineBtn)
+												},
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutButtonRow(gtx, th, secondaryBtn, ghostBtn, linkBtn, brandBtn)
+												},
+											)
+										},
+
+										// Input examples, including an auto-resizing comment field
+										sectionDivider,
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutSection(gtx, th, "Input Components",
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutFixedWidth(gtx, inputWidth, func(gtx layout.Context) layout.Dimensions {
+														return textInput.Layout(gtx, th)
+													})
+												},
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutFixedWidth(gtx, inputWidth, func(gtx layout.Context) layout.Dimensions {
+														return commentInput.Layout(gtx, th)
+													})
+												},
+											)
+										},
+
+										// Dashed border card example
+										sectionDivider,
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutFixedWidth(gtx, inputWidth, func(gtx layout.Context) layout.Dimensions {
+												return dropZoneCard.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+													gtx.Constraints.Min.X = gtx.Constraints.Max.X
+													return theme.Center(th, "2").Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+														return dropZoneLabel.Layout(gtx, th)
+													})
+												})
+											})
+										},
+
+										// Kanban board example
+										sectionDivider,
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutSection(gtx, th, "Drag and Drop", func(gtx layout.Context) layout.Dimensions {
+												return kanban.Layout(gtx, th)
+											})
+										},
+
+										// Chart examples, sharing the width equally
+										sectionDivider,
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutSection(gtx, th, "Charts", func(gtx layout.Context) layout.Dimensions {
+												return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+													layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+														return barChart.Layout(gtx, th)
+													}),
+													layout.Rigid(layout.Spacer{Width: th.Spacing.Space6}.Layout),
+													layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+														return lineChart.Layout(gtx, th)
+													}),
+												)
+											})
+										},
+
+										// Typography examples
+										sectionDivider,
+										func(gtx layout.Context) layout.Dimensions {
+											return layoutSection(gtx, th, "Typography",
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutColumn(gtx, th.Spacing.Space2, widgets(th, h1Example, h2Example, h3Example)...)
+												},
+												func(gtx layout.Context) layout.Dimensions {
+													return layoutColumn(gtx, th.Spacing.Space2, widgets(th, bodyExample, smallExample, mutedExample)...)
+												},
+											)
+										},
 									)
-								}),
-
-								// Button examples
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									sectionTitle := label.NewTypography("Buttons", label.H4, "")
-									return layout.Flex{
-										Axis: layout.Vertical,
-									}.Layout(gtx,
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return sectionTitle.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layoutButtonRow(gtx, th, primaryBtn, destructiveBtn, outlineBtn)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layoutButtonRow(gtx, th, secondaryBtn, ghostBtn, linkBtn)
-										}),
-									)
-								}),
-
-								// Input examples
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									return layout.Spacer{Height: th.Spacing.Space8}.Layout(gtx)
-								}),
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									sectionTitle := label.NewTypography("Input Components", label.H4, "")
-									return layout.Flex{
-										Axis: layout.Vertical,
-									}.Layout(gtx,
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return sectionTitle.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											// Create a constrained context for inputs
-											maxWidth := gtx.Metric.Dp(400)
-											if gtx.Constraints.Max.X < maxWidth {
-												maxWidth = gtx.Constraints.Max.X
-											}
-
-											gtx.Constraints.Max.X = maxWidth
-											gtx.Constraints.Min.X = maxWidth
-
-											return textInput.Layout(gtx, th)
-										}),
-									)
-								}),
-
-								// Typography examples
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									return layout.Spacer{Height: th.Spacing.Space8}.Layout(gtx)
-								}),
-								layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-									sectionTitle := label.NewTypography("Typography", label.H4, "")
-									h1Example := label.NewTypography("Heading 1", label.H1, "")
-									h2Example := label.NewTypography("Heading 2", label.H2, "")
-									h3Example := label.NewTypography("Heading 3", label.H3, "")
-									bodyExample := label.NewTypography("This is a paragraph of body text demonstrating the typography system.", label.P, "")
-									smallExample := label.NewTypography("Small text for captions and fine print.", label.Small, "")
-									mutedExample := label.NewTypography("Muted text for secondary information.", label.Muted, "")
-
-									return layout.Flex{
-										Axis: layout.Vertical,
-									}.Layout(gtx,
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return sectionTitle.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return h1Example.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return h2Example.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return h3Example.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space4}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return bodyExample.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return smallExample.Layout(gtx, th)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
-										}),
-										layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-											return mutedExample.Layout(gtx, th)
-										}),
-									)
-								}),
-							)
-						})
-					})
+								})
+							})
+						}),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							if !showCustomizer {
+								return layout.Dimensions{}
+							}
+							gtx.Constraints.Min.X = gtx.Dp(320)
+							gtx.Constraints.Max.X = gtx.Constraints.Min.X
+							gtx.Constraints.Min.Y = gtx.Constraints.Max.Y
+							return customizer.Layout(gtx, th)
+						}),
+					)
 				}),
 			)
 
@@ -0,0 +1,13 @@
+//go:build !((linux || freebsd || openbsd) && !nox11)
+
+package main
+
+import (
+	"errors"
+	"image"
+)
+
+// runHost reports that the demo host window is only implemented for X11.
+func runHost(_ image.Point) error {
+	return errors.New("the embed demo host window requires X11")
+}
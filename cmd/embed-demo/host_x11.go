@@ -0,0 +1,87 @@
+//go:build (linux || freebsd || openbsd) && !nox11
+
+package main
+
+/*
+#cgo openbsd CFLAGS: -I/usr/X11R6/include -I/usr/local/include
+#cgo openbsd LDFLAGS: -L/usr/X11R6/lib -L/usr/local/lib
+#cgo freebsd CFLAGS: -I/usr/local/include
+#cgo freebsd LDFLAGS: -L/usr/local/lib
+#cgo LDFLAGS: -lX11
+
+#include <X11/Xlib.h>
+
+enum { hostRedraw, hostClose, hostIgnore };
+
+static Window host_create(Display *dpy, int width, int height, Atom *wmDelete) {
+	int screen = DefaultScreen(dpy);
+	Window win = XCreateSimpleWindow(dpy, RootWindow(dpy, screen), 0, 0, width, height, 0,
+		BlackPixel(dpy, screen), WhitePixel(dpy, screen));
+	XStoreName(dpy, win, "gio-shadcn embed demo");
+	XSelectInput(dpy, win, ExposureMask | StructureNotifyMask);
+	*wmDelete = XInternAtom(dpy, "WM_DELETE_WINDOW", False);
+	XSetWMProtocols(dpy, win, wmDelete, 1);
+	XMapWindow(dpy, win);
+	return win;
+}
+
+// host_next waits for the next event and reports the window size for
+// redraws.
+static int host_next(Display *dpy, Atom wmDelete, int *width, int *height) {
+	XEvent ev;
+	XNextEvent(dpy, &ev);
+	switch (ev.type) {
+	case Expose:
+		return ev.xexpose.count == 0 ? hostRedraw : hostIgnore;
+	case ConfigureNotify:
+		*width = ev.xconfigure.width;
+		*height = ev.xconfigure.height;
+		return hostRedraw;
+	case ClientMessage:
+		return (Atom)ev.xclient.data.l[0] == wmDelete ? hostClose : hostIgnore;
+	}
+	return hostIgnore;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"image"
+)
+
+// runHost opens an X11 window and keeps the embedded card painted until the
+// window is closed.
+func runHost(size image.Point) error {
+	dpy := C.XOpenDisplay(nil)
+	if dpy == nil {
+		return errors.New("cannot open X11 display")
+	}
+	defer C.XCloseDisplay(dpy)
+
+	var wmDelete C.Atom
+	win := C.host_create(dpy, C.int(size.X), C.int(size.Y), &wmDelete)
+	defer C.XDestroyWindow(dpy, win)
+	C.XFlush(dpy)
+
+	v, err := newCardView(uintptr(win), size)
+	if err != nil {
+		return err
+	}
+	defer v.release()
+
+	width, height := C.int(size.X), C.int(size.Y)
+	for {
+		switch C.host_next(dpy, wmDelete, &width, &height) {
+		case C.hostClose:
+			return nil
+		case C.hostRedraw:
+			if err := v.resize(image.Pt(int(width), int(height))); err != nil {
+				return err
+			}
+			if err := v.render(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Package main demonstrates embedding a gio-shadcn card in a native window
+that was not created by Gio.
+
+The demo plays the role of a host application: it opens a plain X11 window
+with Xlib, hands the window ID to embed.NewGioView and renders a composed
+card whenever the window is exposed or resized.
+
+# Usage
+
+Run the demo on Linux or the BSDs with an X11 server:
+
+	go run ./cmd/embed-demo
+*/
+package main
+
+import (
+	"image"
+	"log"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"github.com/bnema/gio-shadcn/components/card"
+	"github.com/bnema/gio-shadcn/components/embed"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// windowSize is the initial size of the host window in pixels.
+var windowSize = image.Pt(480, 260)
+
+func main() {
+	if err := runHost(windowSize); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// cardView renders the embedded card into a host window.
+type cardView struct {
+	view *embed.GioView
+	th   *theme.Theme
+	card *card.ComposedCard
+}
+
+func newCardView(handle uintptr, size image.Point) (*cardView, error) {
+	view, err := embed.NewGioView(handle, size)
+	if err != nil {
+		return nil, err
+	}
+
+	title := label.NewTypography("Embedded card", label.H4, "")
+	body := label.NewTypography("Rendered by gio-shadcn into a window owned by the host.", label.Muted, "")
+	footer := label.NewTypography("Display only: input stays with the host.", label.Small, "")
+
+	v := &cardView{
+		view: view,
+		th:   theme.New(),
+	}
+	v.card = card.NewComposed().
+		Header(func(gtx layout.Context) layout.Dimensions { return title.Layout(gtx, v.th) }).
+		Content(func(gtx layout.Context) layout.Dimensions { return body.Layout(gtx, v.th) }).
+		Footer(func(gtx layout.Context) layout.Dimensions { return footer.Layout(gtx, v.th) }).
+		Build()
+	return v, nil
+}
+
+// resize follows the host window size.
+func (v *cardView) resize(size image.Point) error {
+	if size == v.view.Size() {
+		return nil
+	}
+	return v.view.Resize(size)
+}
+
+// render draws one frame into the host window.
+func (v *cardView) render() error {
+	return v.view.Render(func(gtx layout.Context) layout.Dimensions {
+		paint.FillShape(gtx.Ops, v.th.Colors.Background, clip.Rect{Max: gtx.Constraints.Max}.Op())
+		return layout.UniformInset(v.th.Spacing.Space6).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min = image.Point{}
+			return v.card.Layout(gtx, v.th)
+		})
+	})
+}
+
+func (v *cardView) release() {
+	v.view.Release()
+}
@@ -0,0 +1,380 @@
+/*
+Package accordion provides a vertically stacked set of collapsible sections for
+gio-shadcn applications.
+
+Each item has a trigger row with its title and a chevron. Clicking the trigger
+expands or collapses the item's content with an animated height transition.
+In TypeSingle mode opening an item closes the others; in TypeMultiple mode
+items open independently.
+
+# Quick Start
+
+Create an accordion:
+
+	faq := accordion.NewAccordion([]accordion.Item{
+		{Value: "shipping", Title: "How long does shipping take?", Content: shippingAnswer},
+		{Value: "returns", Title: "Can I return an item?", Content: returnsAnswer},
+		{Value: "gift", Title: "Do you offer gift wrapping?", Content: giftAnswer, Disabled: true},
+	},
+		accordion.WithCollapsible(true),
+		accordion.WithDefaultValue("shipping"),
+	)
+
+Use in layout:
+
+	dims := faq.Layout(gtx, th)
+
+# Types
+
+• TypeSingle - At most one item open at a time
+• TypeMultiple - Any number of items open
+
+# Features
+
+• Animated expand and collapse of the item content
+• Chevron rotating with the item state
+• Per-item disabled state
+• Keyboard toggling with Space or Enter on a focused trigger
+*/
+package accordion
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/font"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/animation"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Type selects how many items can be open at once.
+type Type string
+
+// Types.
+const (
+	TypeSingle   Type = "single"
+	TypeMultiple Type = "multiple"
+)
+
+// Accordion geometry and timing.
+const (
+	chevronSize     = unit.Dp(16)
+	disabledOpacity = 0.5
+	expandDuration  = 200 * time.Millisecond
+)
+
+// chevronIcon is the trigger chevron. The icon data is constant, so NewIcon
+// cannot fail.
+var chevronIcon, _ = widget.NewIcon(icons.NavigationExpandMore)
+
+// Item is a section of an accordion.
+type Item struct {
+	// Value identifies the item in Value, SetValue and OnValueChange.
+	Value    string
+	Title    string
+	Content  layout.Widget
+	Disabled bool
+}
+
+// itemState is the per-item state kept alongside Items.
+type itemState struct {
+	trigger widget.Clickable
+	expand  animation.Animator
+	laidOut bool
+}
+
+// Accordion represents a shadcn/ui accordion.
+//
+// Example usage:.
+//
+//	acc := accordion.NewAccordion(items, accordion.WithType(accordion.TypeMultiple))
+//	dims := acc.Layout(gtx, th)
+type Accordion struct {
+	// State
+	states []*itemState
+	open   map[string]bool
+
+	// Configuration
+	Items []Item
+	Type  Type
+	// Collapsible lets the open item close again in TypeSingle mode.
+	Collapsible bool
+
+	// Callbacks
+	OnValueChange func(values []string)
+}
+
+// Option is a functional option for configuring Accordion components.
+type Option func(*Accordion)
+
+// WithType sets whether one or many items can be open at once.
+func WithType(t Type) Option {
+	return func(a *Accordion) {
+		a.Type = t
+	}
+}
+
+// WithCollapsible lets the open item close again in TypeSingle mode.
+func WithCollapsible(collapsible bool) Option {
+	return func(a *Accordion) {
+		a.Collapsible = collapsible
+	}
+}
+
+// WithDefaultValue opens the items with the given values. In TypeSingle mode
+// only the first of them in item order is opened.
+func WithDefaultValue(values ...string) Option {
+	return func(a *Accordion) {
+		for _, value := range values {
+			a.open[value] = true
+		}
+	}
+}
+
+// WithOnValueChange sets the callback called with the open item values when
+// the user opens or closes an item.
+func WithOnValueChange(onValueChange func(values []string)) Option {
+	return func(a *Accordion) {
+		a.OnValueChange = onValueChange
+	}
+}
+
+// NewAccordion creates a new Accordion with the given items and options.
+func NewAccordion(items []Item, options ...Option) *Accordion {
+	a := &Accordion{
+		Items: items,
+		Type:  TypeSingle,
+		open:  make(map[string]bool),
+	}
+
+	for _, option := range options {
+		option(a)
+	}
+	a.SetValue(a.Value()...)
+
+	return a
+}
+
+// Value returns the values of the open items in item order.
+func (a *Accordion) Value() []string {
+	var values []string
+	for _, item := range a.Items {
+		if a.open[item.Value] {
+			values = append(values, item.Value)
+		}
+	}
+	return values
+}
+
+// SetValue opens exactly the items with the given values, without calling
+// OnValueChange. In TypeSingle mode only the first is opened.
+func (a *Accordion) SetValue(values ...string) {
+	if a.open == nil {
+		a.open = make(map[string]bool)
+	}
+	clear(a.open)
+	for _, value := range values {
+		a.open[value] = true
+		if a.Type != TypeMultiple {
+			break
+		}
+	}
+}
+
+// IsOpen returns true if the item with value is open.
+func (a *Accordion) IsOpen(value string) bool {
+	return a.open[value]
+}
+
+// Toggle opens or closes the item with value, as a click on its trigger
+// does, and calls OnValueChange.
+func (a *Accordion) Toggle(value string) {
+	if a.open == nil {
+		a.open = make(map[string]bool)
+	}
+
+	switch {
+	case a.open[value] && a.Type != TypeMultiple && !a.Collapsible:
+		return
+	case a.open[value]:
+		delete(a.open, value)
+	case a.Type == TypeMultiple:
+		a.open[value] = true
+	default:
+		clear(a.open)
+		a.open[value] = true
+	}
+
+	if a.OnValueChange != nil {
+		a.OnValueChange(a.Value())
+	}
+}
+
+// Layout renders the items one above the other.
+func (a *Accordion) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	a.syncStates()
+
+	for i, item := range a.Items {
+		if a.states[i].trigger.Clicked(gtx) && !item.Disabled {
+			a.Toggle(item.Value)
+		}
+	}
+
+	children := make([]layout.FlexChild, len(a.Items))
+	for i := range a.Items {
+		children[i] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return a.layoutItem(gtx, th, &a.Items[i], a.states[i])
+		})
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// syncStates keeps one state per item.
+func (a *Accordion) syncStates() {
+	for len(a.states) < len(a.Items) {
+		s := &itemState{}
+		s.expand.Duration = expandDuration
+		a.states = append(a.states, s)
+	}
+	a.states = a.states[:len(a.Items)]
+}
+
+// layoutItem renders an item's trigger, its content while open and the
+// bottom border.
+func (a *Accordion) layoutItem(gtx layout.Context, th *theme.Theme, item *Item, state *itemState) layout.Dimensions {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	// Items start in place, then animate on later changes unless motion is
+	// reduced
+	target := float32(0)
+	if a.open[item.Value] {
+		target = 1
+	}
+	if !state.laidOut || th.ReducedMotion() {
+		state.laidOut = true
+		state.expand.Set(target)
+	} else if state.expand.Target() != target {
+		state.expand.Animate(target)
+	}
+	progress := state.expand.Value(gtx)
+
+	dims := layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return a.layoutTrigger(gtx, th, item, state, progress)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if progress <= 0 || item.Content == nil {
+				return layout.Dimensions{}
+			}
+			return layoutContent(gtx, th, item.Content, progress)
+		}),
+	)
+
+	border := image.Rect(0, dims.Size.Y-gtx.Dp(unit.Dp(1)), dims.Size.X, dims.Size.Y)
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect(border).Op())
+
+	return dims
+}
+
+// layoutTrigger renders the title and the chevron, rotated by progress.
+func (a *Accordion) layoutTrigger(gtx layout.Context, th *theme.Theme, item *Item, state *itemState, progress float32) layout.Dimensions {
+	fg, chevronColor := th.Colors.Foreground, th.Colors.MutedFg
+	if item.Disabled {
+		fg = utils.ApplyOpacity(fg, disabledOpacity)
+		chevronColor = utils.ApplyOpacity(chevronColor, disabledOpacity)
+	}
+
+	return state.trigger.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		if !item.Disabled {
+			pointer.CursorPointer.Add(gtx.Ops)
+		}
+
+		inset := layout.Inset{Top: th.Spacing.Space4, Bottom: th.Spacing.Space4}
+		return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, item.Title)
+					lbl.Color = fg
+					lbl.Font.Weight = font.Medium
+					return lbl.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					size := gtx.Dp(chevronSize)
+					center := f32.Pt(float32(size)/2, float32(size)/2)
+					rotate := op.Affine(f32.Affine2D{}.Rotate(center, progress*math.Pi)).Push(gtx.Ops)
+					gtx.Constraints = layout.Exact(image.Pt(size, size))
+					chevronIcon.Layout(gtx, chevronColor)
+					rotate.Pop()
+					return layout.Dimensions{Size: image.Pt(size, size)}
+				}),
+			)
+		})
+	})
+}
+
+// layoutContent renders content clipped to progress of its full height.
+func layoutContent(gtx layout.Context, th *theme.Theme, content layout.Widget, progress float32) layout.Dimensions {
+	gtx.Constraints.Min.Y = 0
+
+	macro := op.Record(gtx.Ops)
+	dims := layout.Inset{Bottom: th.Spacing.Space4}.Layout(gtx, content)
+	call := macro.Stop()
+
+	size := image.Pt(dims.Size.X, int(float32(dims.Size.Y)*progress))
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+
+	return layout.Dimensions{Size: size}
+}
+
+// Update returns the component state for Accordion.
+func (a *Accordion) Update(_ layout.Context) theme.ComponentState {
+	state := &State{active: len(a.open) > 0}
+	for _, s := range a.states {
+		state.hovered = state.hovered || s.trigger.Hovered()
+		state.pressed = state.pressed || s.trigger.Pressed()
+	}
+	return state
+}
+
+// State implements ComponentState for Accordion.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if any item is open.
+func (as *State) IsActive() bool {
+	return as.active
+}
+
+// IsHovered returns true if an item trigger is being hovered over.
+func (as *State) IsHovered() bool {
+	return as.hovered
+}
+
+// IsPressed returns true if an item trigger is being pressed.
+func (as *State) IsPressed() bool {
+	return as.pressed
+}
+
+// IsDisabled always returns false; individual items are disabled instead.
+func (as *State) IsDisabled() bool {
+	return as.disabled
+}
@@ -0,0 +1,290 @@
+/*
+Package avatar provides user avatar components for gio-shadcn applications.
+
+An Avatar shows a user's picture clipped to a circle or a rounded square, or
+their initials on a muted background when there is no picture. Pictures are
+read from a file, or downloaded in the background from an http or https URL
+and cached for every avatar showing the same URL. An AvatarStack overlaps a few
+avatars, collaborator-style, and lists the rest in a tooltip.
+
+# Quick Start
+
+Create an avatar:
+
+	a := avatar.NewAvatar(
+		avatar.WithImage("assets/ada.png"),
+		avatar.WithName("Ada Lovelace"),
+	)
+
+Load a remote picture in a rounded square:
+
+	a := avatar.NewAvatar(
+		avatar.WithImage("https://example.com/ada.png"),
+		avatar.WithName("Ada Lovelace"),
+		avatar.WithShape(avatar.ShapeRoundedSquare),
+	)
+
+Create a stack of members:
+
+	stack := avatar.NewAvatarStack([]avatar.AvatarMember{
+		{Name: "Ada Lovelace", ImageSrc: "assets/ada.png"},
+		{Name: "Alan Turing"},
+		{Name: "Grace Hopper"},
+		{Name: "Linus Torvalds"},
+	}, avatar.WithMaxVisible(3))
+
+Use in layout:
+
+	dims := stack.Layout(gtx, th)
+
+# Sizes
+
+• SizeSM - 24dp
+• SizeDefault - 32dp
+• SizeLG - 40dp
+
+# Shapes
+
+• ShapeCircle - Round avatar (default)
+• ShapeRoundedSquare - Square with the theme's large radius
+
+# Features
+
+• Circular or rounded square image, loaded from a file the first time it is shown
+• Remote images downloaded in the background and cached by URL
+• Initials fallback, derived from the name when not given
+• AvatarStack with overlap and a "+N" overflow avatar
+• Tooltip listing the hidden members of a stack
+*/
+package avatar
+
+import (
+	"image"
+	_ "image/gif"  // Register GIF decoding for avatar images
+	_ "image/jpeg" // Register JPEG decoding for avatar images
+	_ "image/png"  // Register PNG decoding for avatar images
+	"os"
+	"strings"
+	"unicode"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// initialsScale is the initials font size relative to the avatar size.
+const initialsScale = 0.4
+
+// Shape is the outline an avatar is clipped to.
+type Shape string
+
+// Shapes.
+const (
+	ShapeCircle        Shape = "circle"
+	ShapeRoundedSquare Shape = "rounded-square"
+)
+
+// Avatar represents a circular user picture with an initials fallback.
+//
+// Example usage:.
+//
+//	a := avatar.NewAvatar(avatar.WithInitials("AL"), avatar.WithSize(theme.SizeLG))
+//	dims := a.Layout(gtx, th)
+type Avatar struct {
+	// Configuration
+	// ImageSrc is a file path or an http or https URL.
+	ImageSrc string
+	// Initials are shown when there is no image. When empty, they are derived
+	// from Name.
+	Initials string
+	Name     string
+	Size     theme.Size
+	Shape    Shape
+
+	// Image state, loaded on first layout
+	loadedSrc string
+	loaded    bool
+	pending   bool
+	img       paint.ImageOp
+	hasImg    bool
+}
+
+// Option is a functional option for configuring Avatar components.
+type Option func(*Avatar)
+
+// WithImage sets the file path or http or https URL of the avatar picture.
+func WithImage(src string) Option {
+	return func(a *Avatar) {
+		a.ImageSrc = src
+	}
+}
+
+// WithInitials sets the fallback initials.
+func WithInitials(initials string) Option {
+	return func(a *Avatar) {
+		a.Initials = initials
+	}
+}
+
+// WithName sets the user name, used to derive the initials.
+func WithName(name string) Option {
+	return func(a *Avatar) {
+		a.Name = name
+	}
+}
+
+// WithSize sets the avatar size.
+func WithSize(size theme.Size) Option {
+	return func(a *Avatar) {
+		a.Size = size
+	}
+}
+
+// WithShape sets the outline the avatar is clipped to.
+func WithShape(shape Shape) Option {
+	return func(a *Avatar) {
+		a.Shape = shape
+	}
+}
+
+// NewAvatar creates a new Avatar with the given options.
+func NewAvatar(options ...Option) *Avatar {
+	a := &Avatar{
+		Size:  theme.SizeDefault,
+		Shape: ShapeCircle,
+	}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	return a
+}
+
+// Diameter returns the avatar diameter for size.
+func Diameter(size theme.Size) unit.Dp {
+	switch size {
+	case theme.SizeSM:
+		return unit.Dp(24)
+	case theme.SizeLG:
+		return unit.Dp(40)
+	default:
+		return unit.Dp(32)
+	}
+}
+
+// Layout renders the image, or the initials while a remote image downloads
+// and when the image can't be loaded.
+func (a *Avatar) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	a.load(gtx)
+
+	diameter := Diameter(a.Size)
+	size := gtx.Dp(diameter)
+	bounds := image.Rectangle{Max: image.Pt(size, size)}
+
+	outline := a.clip(gtx, th, bounds).Push(gtx.Ops)
+	if a.hasImg {
+		gtx.Constraints = layout.Exact(bounds.Max)
+		picture := widget.Image{Src: a.img, Fit: widget.Cover, Position: layout.Center}
+		picture.Layout(gtx)
+	} else {
+		paint.ColorOp{Color: th.Colors.Muted}.Add(gtx.Ops)
+		paint.PaintOp{}.Add(gtx.Ops)
+		layoutCentered(gtx, bounds.Max, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), unit.Sp(float32(diameter)*initialsScale), a.initials())
+			lbl.Color = th.Colors.MutedFg
+			lbl.Alignment = text.Middle
+			lbl.MaxLines = 1
+			return lbl.Layout(gtx)
+		})
+	}
+	outline.Pop()
+
+	return layout.Dimensions{Size: bounds.Max}
+}
+
+// clip returns the avatar outline for bounds.
+func (a *Avatar) clip(gtx layout.Context, th *theme.Theme, bounds image.Rectangle) clip.Op {
+	if a.Shape == ShapeRoundedSquare {
+		return clip.UniformRRect(bounds, gtx.Dp(th.Radius.RadiusLG)).Op(gtx.Ops)
+	}
+	return clip.Ellipse(bounds).Op(gtx.Ops)
+}
+
+// initials returns Initials, or the initials of Name.
+func (a *Avatar) initials() string {
+	if a.Initials != "" {
+		return a.Initials
+	}
+	return Initials(a.Name)
+}
+
+// load decodes ImageSrc once per source. Remote images are polled until
+// their download finishes.
+func (a *Avatar) load(gtx layout.Context) {
+	if a.loaded && a.loadedSrc == a.ImageSrc && !a.pending {
+		return
+	}
+	a.loaded = true
+	a.loadedSrc = a.ImageSrc
+	a.hasImg = false
+	a.pending = false
+
+	if a.ImageSrc == "" {
+		return
+	}
+	if isRemote(a.ImageSrc) {
+		img, ok, done := fetchRemote(a.ImageSrc)
+		a.img, a.hasImg, a.pending = img, ok, !done
+		if a.pending {
+			gtx.Execute(op.InvalidateCmd{At: gtx.Now.Add(remotePoll)})
+		}
+		return
+	}
+	file, err := os.Open(a.ImageSrc) // #nosec G304 - path is provided by the caller
+	if err != nil {
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return
+	}
+	a.img = paint.NewImageOp(img)
+	a.hasImg = true
+}
+
+// Initials returns the upper case first letters of the first and last words
+// of name, such as "AL" for "Ada Lovelace".
+func Initials(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+
+	first := []rune(words[0])[0]
+	if len(words) == 1 {
+		return string(unicode.ToUpper(first))
+	}
+	last := []rune(words[len(words)-1])[0]
+	return string([]rune{unicode.ToUpper(first), unicode.ToUpper(last)})
+}
+
+// layoutCentered lays out w centered in an area of the given size.
+func layoutCentered(gtx layout.Context, size image.Point, w layout.Widget) {
+	gtx.Constraints = layout.Constraints{Max: size}
+	macro := op.Record(gtx.Ops)
+	dims := w(gtx)
+	call := macro.Stop()
+
+	offset := op.Offset(size.Sub(dims.Size).Div(2)).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	offset.Pop()
+}
@@ -0,0 +1,95 @@
+package avatar
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gioui.org/op/paint"
+)
+
+// Remote image limits.
+const (
+	remoteTimeout = 10 * time.Second
+	// remotePoll is how often a layout waiting for a download redraws.
+	remotePoll = 100 * time.Millisecond
+	// maxRemoteSize caps the bytes read from a remote image.
+	maxRemoteSize = 10 << 20
+)
+
+// remoteImage is a downloaded image, or a download in progress.
+type remoteImage struct {
+	done bool
+	ok   bool
+	img  paint.ImageOp
+}
+
+// remoteCache holds remote images by URL, shared by all avatars so each URL
+// is downloaded once.
+var remoteCache = struct {
+	sync.Mutex
+	images map[string]*remoteImage
+}{images: make(map[string]*remoteImage)}
+
+var httpClient = &http.Client{Timeout: remoteTimeout}
+
+// isRemote reports whether src is an http or https URL.
+func isRemote(src string) bool {
+	return strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://")
+}
+
+// fetchRemote returns the cached image for url, starting its download on the
+// first call. done is false while the download is in progress; ok is false
+// when it failed.
+func fetchRemote(url string) (img paint.ImageOp, ok, done bool) {
+	remoteCache.Lock()
+	defer remoteCache.Unlock()
+
+	if r, found := remoteCache.images[url]; found {
+		return r.img, r.ok, r.done
+	}
+
+	r := &remoteImage{}
+	remoteCache.images[url] = r
+	go func() {
+		decoded, err := downloadImage(url)
+
+		remoteCache.Lock()
+		defer remoteCache.Unlock()
+		r.done = true
+		if err == nil {
+			r.img = paint.NewImageOp(decoded)
+			r.ok = true
+		}
+	}()
+
+	return paint.ImageOp{}, false, false
+}
+
+// downloadImage fetches and decodes the image at url.
+func downloadImage(url string) (image.Image, error) {
+	resp, err := httpClient.Get(url) // #nosec G107 - URL is provided by the caller
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("avatar: fetching %s: %s", url, resp.Status)
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxRemoteSize))
+	return img, err
+}
+
+// ClearCache forgets downloaded avatar images, so they are fetched again the
+// next time they are shown. Failed downloads are retried the same way.
+func ClearCache() {
+	remoteCache.Lock()
+	defer remoteCache.Unlock()
+	clear(remoteCache.images)
+}
@@ -0,0 +1,223 @@
+package avatar
+
+import (
+	"fmt"
+	"image"
+	"slices"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/tooltip"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Stack defaults.
+const (
+	DefaultMaxVisible = 3
+	// ringWidth is the background-colored ring that separates overlapping
+	// avatars.
+	ringWidth = unit.Dp(2)
+)
+
+// AvatarMember is one person in an AvatarStack.
+//
+//nolint:revive // AvatarMember is the documented public name
+type AvatarMember struct {
+	Name     string
+	ImageSrc string
+	// Initials override the initials derived from Name.
+	Initials string
+}
+
+// AvatarStack shows the first members as overlapping avatars and the rest as
+// a "+N" avatar, with a tooltip listing the hidden members.
+//
+// Example usage:.
+//
+//	stack := avatar.NewAvatarStack(members, avatar.WithMaxVisible(4))
+//	dims := stack.Layout(gtx, th)
+//
+//nolint:revive // AvatarStack is the documented public name
+type AvatarStack struct {
+	// Configuration
+	Members    []AvatarMember
+	MaxVisible int
+	AvatarSize theme.Size
+	// Overlap is how far each avatar covers the previous one. Zero uses a
+	// third of the avatar size.
+	Overlap unit.Dp
+
+	// State
+	synced   []AvatarMember
+	avatars  []*Avatar
+	listed   []*Avatar
+	overflow *tooltip.RichTooltip
+}
+
+// StackOption is a functional option for configuring AvatarStack components.
+type StackOption func(*AvatarStack)
+
+// WithMaxVisible sets how many avatars are shown before the "+N" avatar.
+func WithMaxVisible(maxVisible int) StackOption {
+	return func(s *AvatarStack) {
+		s.MaxVisible = maxVisible
+	}
+}
+
+// WithAvatarSize sets the size of the stacked avatars.
+func WithAvatarSize(size theme.Size) StackOption {
+	return func(s *AvatarStack) {
+		s.AvatarSize = size
+	}
+}
+
+// WithOverlap sets how far each avatar covers the previous one.
+func WithOverlap(overlap unit.Dp) StackOption {
+	return func(s *AvatarStack) {
+		s.Overlap = overlap
+	}
+}
+
+// NewAvatarStack creates an AvatarStack of members with the given options.
+func NewAvatarStack(members []AvatarMember, options ...StackOption) *AvatarStack {
+	s := &AvatarStack{
+		Members:    members,
+		MaxVisible: DefaultMaxVisible,
+		AvatarSize: theme.SizeDefault,
+		overflow:   tooltip.NewRichTooltip(nil),
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// sync rebuilds the avatars when Members changed.
+func (s *AvatarStack) sync() {
+	if slices.Equal(s.synced, s.Members) {
+		return
+	}
+	s.synced = slices.Clone(s.Members)
+
+	s.avatars = make([]*Avatar, len(s.Members))
+	s.listed = make([]*Avatar, len(s.Members))
+	for i, m := range s.Members {
+		options := []Option{WithName(m.Name), WithImage(m.ImageSrc), WithInitials(m.Initials)}
+		s.avatars[i] = NewAvatar(options...)
+		s.listed[i] = NewAvatar(append(options, WithSize(theme.SizeSM))...)
+	}
+}
+
+// Layout renders the visible avatars left to right, each over the previous
+// one, followed by the "+N" avatar when members are hidden.
+func (s *AvatarStack) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	s.sync()
+
+	visible := len(s.Members)
+	if s.MaxVisible > 0 && visible > s.MaxVisible {
+		visible = s.MaxVisible
+	}
+	hidden := len(s.Members) - visible
+
+	diameter := Diameter(s.AvatarSize)
+	overlap := s.Overlap
+	if overlap <= 0 {
+		overlap = diameter / 3
+	}
+	size := gtx.Dp(diameter)
+	step := size - gtx.Dp(overlap)
+
+	count := visible
+	if hidden > 0 {
+		count++
+	}
+	if count == 0 {
+		return layout.Dimensions{}
+	}
+
+	for i := range visible {
+		offset := op.Offset(image.Pt(i*step, 0)).Push(gtx.Ops)
+		s.avatars[i].Size = s.AvatarSize
+		layoutRinged(gtx, th, size, func(gtx layout.Context) layout.Dimensions {
+			return s.avatars[i].Layout(gtx, th)
+		})
+		offset.Pop()
+	}
+
+	if hidden > 0 {
+		offset := op.Offset(image.Pt(visible*step, 0)).Push(gtx.Ops)
+		s.overflow.Content = func(gtx layout.Context) layout.Dimensions {
+			return layoutHiddenList(gtx, th, s.listed[visible:])
+		}
+		s.overflow.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+			return layoutRinged(gtx, th, size, func(gtx layout.Context) layout.Dimensions {
+				return layoutOverflow(gtx, th, diameter, hidden)
+			})
+		})
+		offset.Pop()
+	}
+
+	return layout.Dimensions{Size: image.Pt((count-1)*step+size, size)}
+}
+
+// layoutRinged draws a background-colored ring around an avatar of the given
+// size so overlapping avatars stay distinct.
+func layoutRinged(gtx layout.Context, th *theme.Theme, size int, avatar layout.Widget) layout.Dimensions {
+	ring := gtx.Dp(ringWidth)
+	outer := image.Rectangle{Min: image.Pt(-ring, -ring), Max: image.Pt(size+ring, size+ring)}
+	paint.FillShape(gtx.Ops, th.Colors.Background, clip.Ellipse(outer).Op(gtx.Ops))
+	return avatar(gtx)
+}
+
+// layoutOverflow renders the "+N" avatar for n hidden members.
+func layoutOverflow(gtx layout.Context, th *theme.Theme, diameter unit.Dp, n int) layout.Dimensions {
+	size := gtx.Dp(diameter)
+	bounds := image.Rectangle{Max: image.Pt(size, size)}
+
+	paint.FillShape(gtx.Ops, th.Colors.Secondary, clip.Ellipse(bounds).Op(gtx.Ops))
+	layoutCentered(gtx, bounds.Max, func(gtx layout.Context) layout.Dimensions {
+		lbl := material.Label(material.NewTheme(), unit.Sp(float32(diameter)*initialsScale), fmt.Sprintf("+%d", n))
+		lbl.Color = th.Colors.SecondaryFg
+		lbl.Alignment = text.Middle
+		lbl.MaxLines = 1
+		return lbl.Layout(gtx)
+	})
+
+	return layout.Dimensions{Size: bounds.Max}
+}
+
+// layoutHiddenList renders the tooltip rows of the hidden members: a small
+// avatar followed by the name.
+func layoutHiddenList(gtx layout.Context, th *theme.Theme, avatars []*Avatar) layout.Dimensions {
+	children := make([]layout.FlexChild, 0, 2*len(avatars))
+	for i, a := range avatars {
+		if i > 0 {
+			children = append(children, layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout))
+		}
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return a.Layout(gtx, th)
+				}),
+				layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					name := a.Name
+					if name == "" {
+						name = a.initials()
+					}
+					lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, name)
+					lbl.Color = th.Colors.PopoverFg
+					return lbl.Layout(gtx)
+				}),
+			)
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
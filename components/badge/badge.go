@@ -0,0 +1,201 @@
+/*
+Package badge provides small status indicators for gio-shadcn applications.
+
+A Badge is a compact pill displaying a short text such as a count or a status,
+and a DotBadge is a plain colored dot used when only presence matters.
+
+# Quick Start
+
+Create a badge:
+
+	b := badge.NewBadge("New")
+
+Create a destructive count badge:
+
+	unread := badge.NewBadge("3", badge.WithVariant(theme.VariantDestructive))
+
+Create a status badge with a leading dot:
+
+	online := badge.NewBadge("Online", badge.WithVariant(theme.VariantOutline), badge.WithDot(true))
+
+Use in layout:
+
+	dims := unread.Layout(gtx, th)
+
+# Features
+
+• Default, secondary, destructive, outline, warning, info and success variants
+• Pill shape from the theme's RadiusFull token
+• Optional leading dot in the text color
+• Single characters kept round
+*/
+package badge
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// dotSize is the diameter of the leading dot.
+const dotSize = unit.Dp(6)
+
+// Badge represents a shadcn/ui badge component.
+type Badge struct {
+	// Configuration
+	Text    string
+	Variant theme.Variant
+	// Dot shows a small dot before the text.
+	Dot bool
+}
+
+// Option is a functional option for configuring Badge components.
+type Option func(*Badge)
+
+// WithVariant sets the badge variant.
+func WithVariant(variant theme.Variant) Option {
+	return func(b *Badge) {
+		b.Variant = variant
+	}
+}
+
+// WithDot shows a small dot before the text.
+func WithDot(dot bool) Option {
+	return func(b *Badge) {
+		b.Dot = dot
+	}
+}
+
+// NewBadge creates a new Badge with the given text and options.
+func NewBadge(text string, options ...Option) *Badge {
+	b := &Badge{
+		Text:    text,
+		Variant: theme.VariantDefault,
+	}
+
+	for _, option := range options {
+		option(b)
+	}
+
+	return b
+}
+
+// Layout renders the badge.
+func (b *Badge) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	bg, fg := colors(b.Variant, th)
+
+	macro := op.Record(gtx.Ops)
+	dims := layout.Inset{
+		Top:    unit.Dp(2),
+		Bottom: unit.Dp(2),
+		Left:   th.Spacing.Space2,
+		Right:  th.Spacing.Space2,
+	}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min = image.Point{}
+		text := func(gtx layout.Context) layout.Dimensions {
+			return label.NewLabel(
+				label.WithLabelText(b.Text),
+				label.WithTextStyle(theme.TextStyle{
+					Size:   th.Typography.FontSizeXS,
+					Weight: font.SemiBold,
+					Color:  &theme.ColorScheme{Foreground: fg},
+				}),
+			).Layout(gtx, th)
+		}
+		if !b.Dot {
+			return text(gtx)
+		}
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				size := gtx.Dp(dotSize)
+				paint.FillShape(gtx.Ops, fg, clip.Ellipse{Max: image.Pt(size, size)}.Op(gtx.Ops))
+				return layout.Dimensions{Size: image.Pt(size, size)}
+			}),
+			layout.Rigid(layout.Spacer{Width: th.Spacing.Space1}.Layout),
+			layout.Rigid(text),
+		)
+	})
+	call := macro.Stop()
+
+	// Keep the pill at least as wide as it is tall so single digits stay round
+	contentWidth := dims.Size.X
+	dims.Size.X = max(dims.Size.X, dims.Size.Y)
+
+	drawPill(gtx, th, dims.Size, bg, b.Variant == theme.VariantOutline)
+
+	// Center the content in case the pill was widened
+	offset := op.Offset(image.Pt((dims.Size.X-contentWidth)/2, 0)).Push(gtx.Ops)
+	call.Add(gtx.Ops)
+	offset.Pop()
+
+	return dims
+}
+
+// DotBadge represents a small dot indicator without text.
+type DotBadge struct {
+	// Configuration
+	Variant theme.Variant
+	Size    unit.Dp
+}
+
+// NewDotBadge creates a new DotBadge with the given variant.
+func NewDotBadge(variant theme.Variant) *DotBadge {
+	return &DotBadge{
+		Variant: variant,
+		Size:    unit.Dp(8),
+	}
+}
+
+// Layout renders the dot.
+func (d *DotBadge) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	bg, _ := colors(d.Variant, th)
+	size := gtx.Dp(d.Size)
+
+	paint.FillShape(gtx.Ops, bg, clip.Ellipse{Max: image.Pt(size, size)}.Op(gtx.Ops))
+
+	return layout.Dimensions{Size: image.Pt(size, size)}
+}
+
+// drawPill fills a fully rounded rectangle, or strokes it for outline badges.
+func drawPill(gtx layout.Context, th *theme.Theme, size image.Point, bg color.NRGBA, outline bool) {
+	radius := min(gtx.Dp(th.Radius.RadiusFull), size.Y/2)
+	rr := clip.UniformRRect(image.Rectangle{Max: size}, radius)
+
+	if outline {
+		paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(1))),
+		}.Op())
+		return
+	}
+
+	paint.FillShape(gtx.Ops, bg, rr.Op(gtx.Ops))
+}
+
+// colors returns the background and foreground colors for a variant.
+func colors(variant theme.Variant, th *theme.Theme) (bg, fg color.NRGBA) {
+	switch variant {
+	case theme.VariantSecondary:
+		return th.Colors.Secondary, th.Colors.SecondaryFg
+	case theme.VariantDestructive:
+		return th.Colors.Destructive, th.Colors.DestructiveFg
+	case theme.VariantWarning:
+		return th.Colors.Amber, th.Colors.AmberFg
+	case theme.VariantInfo:
+		return th.Colors.Blue, th.Colors.BlueFg
+	case theme.VariantSuccess:
+		return th.Colors.Green, th.Colors.GreenFg
+	case theme.VariantOutline:
+		return color.NRGBA{}, th.Colors.Foreground
+	default:
+		return th.Colors.Primary, th.Colors.PrimaryFg
+	}
+}
@@ -0,0 +1,196 @@
+package button
+
+import (
+	"context"
+	"sync"
+
+	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// ErrorToaster shows an error to the user. *toast.Manager implements it; the
+// toast package builds on button, so AsyncButton can't name the type itself.
+type ErrorToaster interface {
+	Error(err error)
+}
+
+// AsyncButton is a Button whose click handler runs in a goroutine. While the
+// handler runs the button shows its loading state and ignores further clicks.
+// OnSuccess and OnError are called from Layout, on the UI goroutine, once the
+// handler returns.
+//
+// Example usage:.
+//
+//	save := button.NewAsyncButton(func(ctx context.Context) error {
+//		return api.Save(ctx, doc)
+//	},
+//		button.WithOnError(func(err error) { log.Println(err) }),
+//		button.WithInvalidate(w.Invalidate),
+//		button.WithButtonOptions(button.WithText("Save")),
+//	)
+//	dims := save.Layout(gtx, th)
+type AsyncButton struct {
+	*Button
+
+	// OnClick runs in a goroutine each time the button is clicked while idle.
+	// It shadows Button.OnClick, which AsyncButton does not use.
+	OnClick func(ctx context.Context) error
+	// OnSuccess is called after OnClick returns nil.
+	OnSuccess func()
+	// OnError is called after OnClick returns an error.
+	OnError func(error)
+	// ToastManager shows errors that OnError does not handle, typically a
+	// *toast.Manager.
+	ToastManager ErrorToaster
+	// Invalidate is called when OnClick returns so the window redraws and
+	// delivers the result, typically app.Window.Invalidate.
+	Invalidate func()
+	// Context is passed to OnClick. context.Background is used when nil.
+	Context context.Context
+
+	running sync.Mutex
+
+	mu      sync.Mutex
+	done    bool
+	lastErr error
+}
+
+// AsyncOption is a functional option for configuring AsyncButton components.
+type AsyncOption func(*AsyncButton)
+
+// WithOnSuccess sets the callback for handlers that return nil.
+func WithOnSuccess(onSuccess func()) AsyncOption {
+	return func(b *AsyncButton) {
+		b.OnSuccess = onSuccess
+	}
+}
+
+// WithOnError sets the callback for handlers that return an error.
+func WithOnError(onError func(error)) AsyncOption {
+	return func(b *AsyncButton) {
+		b.OnError = onError
+	}
+}
+
+// WithToastManager shows handler errors as toasts when OnError is nil.
+//
+// Example:.
+//
+//	save := button.NewAsyncButton(saveDoc, button.WithToastManager(toasts))
+func WithToastManager(m ErrorToaster) AsyncOption {
+	return func(b *AsyncButton) {
+		b.ToastManager = m
+	}
+}
+
+// WithInvalidate sets the function that requests a redraw when the handler
+// returns.
+func WithInvalidate(invalidate func()) AsyncOption {
+	return func(b *AsyncButton) {
+		b.Invalidate = invalidate
+	}
+}
+
+// WithContext sets the context passed to the handler.
+func WithContext(ctx context.Context) AsyncOption {
+	return func(b *AsyncButton) {
+		b.Context = ctx
+	}
+}
+
+// WithButtonOptions applies Button options such as WithText or WithVariant to
+// the underlying button.
+func WithButtonOptions(options ...Option) AsyncOption {
+	return func(b *AsyncButton) {
+		for _, option := range options {
+			option(b.Button)
+		}
+	}
+}
+
+// NewAsyncButton creates an AsyncButton that runs onClick in a goroutine.
+func NewAsyncButton(onClick func(ctx context.Context) error, options ...AsyncOption) *AsyncButton {
+	b := &AsyncButton{
+		Button:  NewButton(),
+		OnClick: onClick,
+	}
+
+	for _, option := range options {
+		option(b)
+	}
+
+	return b
+}
+
+// Layout delivers the result of a finished handler, starts a new one when the
+// button is clicked and renders the button.
+func (b *AsyncButton) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	b.deliver()
+
+	if b.Button.Clicked(gtx) && !b.Loading {
+		b.start()
+	}
+
+	return b.Button.Layout(gtx, th)
+}
+
+// Running reports whether the handler is currently running.
+func (b *AsyncButton) Running() bool {
+	if b.running.TryLock() {
+		b.running.Unlock()
+		return false
+	}
+	return true
+}
+
+// start runs the handler in a goroutine unless one is already running.
+func (b *AsyncButton) start() {
+	if b.OnClick == nil || !b.running.TryLock() {
+		return
+	}
+	b.SetLoading(true)
+
+	ctx := b.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	onClick := b.OnClick
+
+	go func() {
+		err := onClick(ctx)
+
+		b.mu.Lock()
+		b.done = true
+		b.lastErr = err
+		b.mu.Unlock()
+
+		b.running.Unlock()
+		if b.Invalidate != nil {
+			b.Invalidate()
+		}
+	}()
+}
+
+// deliver clears the loading state and calls OnSuccess or OnError once the
+// handler has returned. Errors go to OnError, or to ToastManager without
+// one.
+func (b *AsyncButton) deliver() {
+	b.mu.Lock()
+	done, err := b.done, b.lastErr
+	b.done, b.lastErr = false, nil
+	b.mu.Unlock()
+
+	if !done {
+		return
+	}
+
+	b.SetLoading(false)
+	switch {
+	case err != nil && b.OnError != nil:
+		b.OnError(err)
+	case err != nil && b.ToastManager != nil:
+		b.ToastManager.Error(err)
+	case err == nil && b.OnSuccess != nil:
+		b.OnSuccess()
+	}
+}
@@ -46,8 +46,14 @@ Available sizes:
 • Hover and active states
 • Disabled state support
 • Optional icon support
+• Loading state with an inline spinner
+• Opacity for dimmed states that keep the variant tint
+• Emoji-prefixed text with separately centered emoji
+• AsyncButton for click handlers that run in the background
+• ConfirmButton for inline "Are you sure?" confirmation
 • Custom CSS-style class utilities
-• Accessible keyboard interaction
+• Accessible keyboard interaction: Tab focus, Enter and Space activation
+• Focus ring for keyboard focus
 • Theme integration with automatic color adaptation
 
 # Examples
@@ -81,7 +87,9 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"time"
 
+	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
@@ -89,6 +97,7 @@ import (
 	"gioui.org/unit"
 	"gioui.org/widget"
 	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/spinner"
 	"github.com/bnema/gio-shadcn/theme"
 	"github.com/bnema/gio-shadcn/utils"
 )
@@ -109,6 +118,7 @@ import (
 type Button struct {
 	// State
 	clickable *widget.Clickable
+	pointer   pointerClickable
 
 	// Configuration
 	Text     string
@@ -118,11 +128,27 @@ type Button struct {
 	Disabled bool
 	Classes  string
 	OnClick  func()
-
-	// Cached parsed styles to avoid re-parsing on every frame
-	cachedStyles     utils.StyleUtility
-	cachedClasses    string
-	stylesCacheValid bool
+	Ripple   bool
+	Loading  bool
+	// Focusable lets the button take keyboard focus with Tab and activate on
+	// Enter or Space. It is true by default.
+	Focusable bool
+
+	// Opacity dims the button colors while keeping their tint. Zero leaves
+	// them unchanged.
+	Opacity float32
+
+	// Ripple animation state
+	ripples   []rippleState
+	lastPress time.Time
+	lastFrame time.Time
+
+	// Loading indicator, created on first use
+	spinner *spinner.Spinner
+
+	// Focus state: the ring is only shown for keyboard focus
+	pointerFocus bool
+	focusVisible bool
 }
 
 // Option is a functional option for configuring Button components.
@@ -177,12 +203,42 @@ func WithClasses(classes string) Option {
 	}
 }
 
+// WithRipple enables a Material-style ripple animation on click.
+func WithRipple(ripple bool) Option {
+	return func(b *Button) {
+		b.Ripple = ripple
+	}
+}
+
+// WithLoading sets the loading state.
+func WithLoading(loading bool) Option {
+	return func(b *Button) {
+		b.Loading = loading
+	}
+}
+
+// WithOpacity dims the button colors by opacity (0-1) using
+// utils.ApplyOpacity, e.g. for a disabled look that keeps the variant tint.
+func WithOpacity(opacity float32) Option {
+	return func(b *Button) {
+		b.Opacity = opacity
+	}
+}
+
+// WithFocusable sets whether the button can take keyboard focus.
+func WithFocusable(focusable bool) Option {
+	return func(b *Button) {
+		b.Focusable = focusable
+	}
+}
+
 // NewButton creates a new Button with the given options.
 func NewButton(options ...Option) *Button {
 	b := &Button{
 		clickable: &widget.Clickable{},
 		Variant:   theme.VariantDefault,
 		Size:      theme.SizeDefault,
+		Focusable: true,
 	}
 
 	for _, option := range options {
@@ -244,6 +300,21 @@ type Config struct {
 	Disabled bool
 	Classes  string
 	OnClick  func()
+	Ripple   bool
+}
+
+// emojiScale is the size of a leading emoji relative to the button text.
+const emojiScale = 1.15
+
+// WithEmoji returns a Config whose text is emoji followed by text. The emoji
+// is rendered slightly larger than the text and both are centered vertically.
+// Button text starting with an emoji gets the same treatment automatically.
+//
+// Example:.
+//
+//	btn := button.New(button.WithEmoji("🌙", "Dark Mode"))
+func WithEmoji(emoji, text string) Config {
+	return Config{Text: emoji + " " + text}
 }
 
 // New creates a new button with the given configuration.
@@ -268,6 +339,8 @@ func New(config Config) *Button {
 		Disabled:  config.Disabled,
 		Classes:   config.Classes,
 		OnClick:   config.OnClick,
+		Ripple:    config.Ripple,
+		Focusable: true,
 	}
 }
 
@@ -282,26 +355,26 @@ func New(config Config) *Button {
 // Returns the dimensions occupied by the button after rendering.
 func (b *Button) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	// Handle click events
-	if b.clickable.Clicked(gtx) && !b.Disabled && b.OnClick != nil {
+	if b.input().Clicked(gtx) && !b.Disabled && !b.Loading && b.OnClick != nil {
 		b.OnClick()
 	}
 
+	if b.Ripple && !b.Disabled && !th.ReducedMotion() {
+		b.updateRipples(gtx)
+	} else {
+		b.ripples = nil
+	}
+
+	b.updateFocus(gtx)
+
 	// Get variant configuration
-	variant := theme.GetButtonVariant(b.Variant, &th.Colors)
+	variant := th.ButtonVariant(b.Variant)
 
 	// Get size configuration
 	padding, minHeight, fontSize := b.getSizeConfig(th)
 
-	// Parse additional classes (with caching)
-	var styles utils.StyleUtility
-	if !b.stylesCacheValid || b.cachedClasses != b.Classes {
-		styles = utils.ParseClasses(b.Classes)
-		b.cachedStyles = styles
-		b.cachedClasses = b.Classes
-		b.stylesCacheValid = true
-	} else {
-		styles = b.cachedStyles
-	}
+	// Parse additional classes (cached by utils.ParseClasses)
+	styles := utils.ParseClasses(b.Classes)
 
 	// Apply custom padding if specified
 	if styles.Padding != (layout.Inset{}) {
@@ -316,10 +389,10 @@ func (b *Button) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	case b.Disabled:
 		bgColor = variant.DisabledBg
 		fgColor = variant.DisabledFg
-	case b.clickable.Pressed():
+	case b.input().Pressed():
 		bgColor = variant.ActiveBg
 		fgColor = variant.ActiveFg
-	case b.clickable.Hovered():
+	case b.input().Hovered():
 		bgColor = variant.HoverBg
 		fgColor = variant.HoverFg
 	}
@@ -329,9 +402,26 @@ func (b *Button) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 		bgColor = styles.Background
 	}
 
-	return b.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+	if b.Opacity > 0 {
+		bgColor = utils.ApplyOpacity(bgColor, b.Opacity)
+		fgColor = utils.ApplyOpacity(fgColor, b.Opacity)
+		variant.Border = utils.ApplyOpacity(variant.Border, b.Opacity)
+	}
+
+	dims := b.input().Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 		return b.drawButton(gtx, th, bgColor, fgColor, variant, padding, minHeight, fontSize, styles)
 	})
+
+	// The ring lies outside the button, so it is drawn outside the clickable's clip
+	if b.focusVisible {
+		radius := th.Radius.RadiusMD
+		if styles.Radius > 0 {
+			radius = styles.Radius
+		}
+		b.drawFocusRing(gtx, th, image.Rectangle{Max: dims.Size}, radius)
+	}
+
+	return dims
 }
 
 // Update returns the current state of the button component.
@@ -347,9 +437,9 @@ func (b *Button) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 //	}
 func (b *Button) Update(gtx layout.Context) theme.ComponentState {
 	return &State{
-		active:   b.clickable.Clicked(gtx),
-		hovered:  b.clickable.Hovered(),
-		pressed:  b.clickable.Pressed(),
+		active:   b.input().Clicked(gtx),
+		hovered:  b.input().Hovered(),
+		pressed:  b.input().Pressed(),
 		disabled: b.Disabled,
 	}
 }
@@ -414,6 +504,9 @@ func (b *Button) drawButton(gtx layout.Context, th *theme.Theme, bgColor, fgColo
 			rr := clip.UniformRRect(rect, gtx.Dp(radius))
 			paint.FillShape(gtx.Ops, bgColor, rr.Op(gtx.Ops))
 
+			// Draw ripples over the background, beneath the content
+			b.drawRipples(gtx, rr, rect.Max, fgColor)
+
 			// Draw border if specified
 			if variant.BorderWidth > 0 {
 				border := clip.Stroke{
@@ -437,8 +530,85 @@ func (b *Button) drawButton(gtx layout.Context, th *theme.Theme, bgColor, fgColo
 	)
 }
 
+// Focus ring geometry, matching shadcn/ui's ring-2 with ring-offset-2.
+const (
+	focusRingWidth  = unit.Dp(2)
+	focusRingOffset = unit.Dp(2)
+)
+
+// clicker is the input handling shared by widget.Clickable and
+// pointerClickable.
+type clicker interface {
+	Clicked(gtx layout.Context) bool
+	Hovered() bool
+	Pressed() bool
+	History() []widget.Press
+	Layout(gtx layout.Context, w layout.Widget) layout.Dimensions
+}
+
+// input returns the clickable handling the button's input: the focusable
+// widget.Clickable, or the pointer-only one for buttons that are not
+// Focusable.
+func (b *Button) input() clicker {
+	if b.Focusable {
+		return b.clickable
+	}
+	return &b.pointer
+}
+
+// updateFocus tracks whether the button has keyboard focus. widget.Clickable
+// takes focus on Tab and on press, and turns Enter and Space into clicks while
+// focused. Disabled buttons give focus up, and focus taken by a pointer press
+// doesn't show the ring. Buttons that are not Focusable never take focus.
+func (b *Button) updateFocus(gtx layout.Context) {
+	focused := b.Focusable && gtx.Focused(b.clickable)
+	if focused && b.Disabled {
+		gtx.Execute(key.FocusCmd{})
+		focused = false
+	}
+
+	switch {
+	case !focused:
+		b.pointerFocus = false
+	case b.input().Pressed():
+		b.pointerFocus = true
+	}
+	b.focusVisible = focused && !b.pointerFocus
+}
+
+// drawFocusRing strokes a ring in the theme's ring color just outside rect.
+func (b *Button) drawFocusRing(gtx layout.Context, th *theme.Theme, rect image.Rectangle, radius unit.Dp) {
+	width := gtx.Dp(focusRingWidth)
+	gap := gtx.Dp(focusRingOffset)
+	ring := clip.UniformRRect(rect.Inset(-gap-width/2), gtx.Dp(radius)+gap+width/2)
+	paint.FillShape(gtx.Ops, th.Colors.Ring, clip.Stroke{
+		Path:  ring.Path(gtx.Ops),
+		Width: float32(width),
+	}.Op())
+}
+
 func (b *Button) layoutContent(gtx layout.Context, th *theme.Theme, fgColor color.NRGBA, fontSize unit.Sp) layout.Dimensions {
 	switch {
+	case b.Loading:
+		// Spinner replaces the icon while loading
+		if b.spinner == nil {
+			b.spinner = spinner.SpinnerSM()
+		}
+		b.spinner.Color = fgColor
+		if b.Text == "" {
+			return b.spinner.Layout(gtx, th)
+		}
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return b.spinner.Layout(gtx, th)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Spacer{Width: th.Spacing.Space2}.Layout(gtx)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return b.layoutText(gtx, th, fgColor, fontSize)
+			}),
+		)
 	case b.Icon != nil && b.Text != "":
 		// Icon + text layout
 		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
@@ -461,8 +631,31 @@ func (b *Button) layoutContent(gtx layout.Context, th *theme.Theme, fgColor colo
 	}
 }
 
-func (b *Button) layoutText(gtx layout.Context, _ *theme.Theme, fgColor color.NRGBA, fontSize unit.Sp) layout.Dimensions {
-	label := material.Label(material.NewTheme(), fontSize, b.Text)
+func (b *Button) layoutText(gtx layout.Context, th *theme.Theme, fgColor color.NRGBA, fontSize unit.Sp) layout.Dimensions {
+	// Emoji have different metrics from text, so center them separately
+	emoji, txt := utils.SplitEmojiText(b.Text)
+	if emoji == "" {
+		return b.layoutLabel(gtx, b.Text, fgColor, fontSize)
+	}
+	if txt == "" {
+		return b.layoutLabel(gtx, emoji, fgColor, fontSize*emojiScale)
+	}
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return b.layoutLabel(gtx, emoji, fgColor, fontSize*emojiScale)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Spacer{Width: th.Spacing.Space2}.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return b.layoutLabel(gtx, txt, fgColor, fontSize)
+		}),
+	)
+}
+
+func (b *Button) layoutLabel(gtx layout.Context, txt string, fgColor color.NRGBA, fontSize unit.Sp) layout.Dimensions {
+	label := material.Label(material.NewTheme(), fontSize, txt)
 	label.Color = fgColor
 	label.Alignment = text.Middle
 	return label.Layout(gtx)
@@ -506,7 +699,7 @@ func (b *Button) getSizeConfig(th *theme.Theme) (layout.Inset, unit.Dp, unit.Sp)
 
 // Clicked returns true if the button was clicked.
 func (b *Button) Clicked(gtx layout.Context) bool {
-	return b.clickable.Clicked(gtx) && !b.Disabled
+	return b.input().Clicked(gtx) && !b.Disabled
 }
 
 // SetDisabled sets the disabled state of the button.
@@ -514,11 +707,33 @@ func (b *Button) SetDisabled(disabled bool) {
 	b.Disabled = disabled
 }
 
+// SetLoading sets the loading state. Loading buttons show a spinner and
+// ignore clicks.
+func (b *Button) SetLoading(loading bool) {
+	b.Loading = loading
+}
+
 // SetText sets the button text.
 func (b *Button) SetText(text string) {
 	b.Text = text
 }
 
+// SetIcon sets the button icon. The change shows on the next frame, so icon
+// buttons can swap icons, e.g. between play and pause.
+func (b *Button) SetIcon(icon *widget.Icon) {
+	b.Icon = icon
+}
+
+// ClearIcon removes the button icon.
+func (b *Button) ClearIcon() {
+	b.Icon = nil
+}
+
+// SetSize sets the button size.
+func (b *Button) SetSize(size theme.Size) {
+	b.Size = size
+}
+
 // SetVariant sets the button variant.
 func (b *Button) SetVariant(variant theme.Variant) {
 	b.Variant = variant
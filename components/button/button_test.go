@@ -0,0 +1,121 @@
+package button
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// layoutOnce lays out b in a fresh frame and returns its dimensions.
+func layoutOnce(r *input.Router, b *Button, th *theme.Theme) layout.Dimensions {
+	ops := new(op.Ops)
+	gtx := layout.Context{
+		Ops:         ops,
+		Source:      r.Source(),
+		Constraints: layout.Constraints{Max: image.Pt(800, 200)},
+	}
+	dims := b.Layout(gtx, th)
+	r.Frame(ops)
+	return dims
+}
+
+func TestButtonSetters(t *testing.T) {
+	playIcon, err := widget.NewIcon(icons.AVPlayArrow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pauseIcon, err := widget.NewIcon(icons.AVPause)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var r input.Router
+	th := theme.TestTheme()
+	btn := NewButton(WithIcon(playIcon), WithSize(theme.SizeIcon))
+	iconOnly := layoutOnce(&r, btn, th)
+
+	btn.SetIcon(pauseIcon)
+	if btn.Icon != pauseIcon {
+		t.Error("SetIcon(pauseIcon) did not set the icon")
+	}
+	if got := layoutOnce(&r, btn, th); got != iconOnly {
+		t.Errorf("swapping icons changed the size from %v to %v", iconOnly.Size, got.Size)
+	}
+
+	btn.SetIcon(playIcon)
+	btn.SetText("Playing")
+	if btn.Icon != playIcon || btn.Text != "Playing" {
+		t.Errorf("Icon, Text = %p, %q, want %p, %q", btn.Icon, btn.Text, playIcon, "Playing")
+	}
+	withText := layoutOnce(&r, btn, th)
+	if withText.Size.X <= iconOnly.Size.X {
+		t.Errorf("next frame width with text = %d, want more than the icon-only %d", withText.Size.X, iconOnly.Size.X)
+	}
+
+	btn.ClearIcon()
+	if btn.Icon != nil {
+		t.Error("ClearIcon did not remove the icon")
+	}
+	if got := layoutOnce(&r, btn, th); got.Size.X >= withText.Size.X {
+		t.Errorf("next frame width without icon = %d, want less than %d", got.Size.X, withText.Size.X)
+	}
+
+	btn.SetSize(theme.SizeLG)
+	btn.SetVariant(theme.VariantOutline)
+	if btn.Size != theme.SizeLG || btn.Variant != theme.VariantOutline {
+		t.Errorf("Size, Variant = %v, %v, want %v, %v", btn.Size, btn.Variant, theme.SizeLG, theme.VariantOutline)
+	}
+}
+
+func TestNonFocusableButton(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	clicks := 0
+	btn := NewButton(WithText("Close"), WithFocusable(false), WithOnClick(func() { clicks++ }))
+	layoutOnce(&r, btn, th)
+
+	r.MoveFocus(key.FocusForward)
+	if r.Source().Focused(btn.clickable) || r.Source().Focused(&btn.pointer) {
+		t.Error("Tab focused a non-focusable button")
+	}
+
+	pos := f32.Pt(10, 10)
+	r.Queue(
+		pointer.Event{Kind: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: pos},
+		pointer.Event{Kind: pointer.Release, Source: pointer.Mouse, Position: pos},
+	)
+	layoutOnce(&r, btn, th)
+	if clicks != 1 {
+		t.Errorf("clicks = %d after a mouse click, want 1", clicks)
+	}
+	if r.Source().Focused(btn.clickable) || r.Source().Focused(&btn.pointer) {
+		t.Error("a mouse click focused a non-focusable button")
+	}
+}
+
+func BenchmarkButtonLayout(b *testing.B) {
+	var r input.Router
+	th := theme.TestTheme()
+	btn := NewButton(WithText("Save changes"), WithClasses("px-6 bg-blue-500"))
+	ops := new(op.Ops)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		ops.Reset()
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Constraints: layout.Constraints{Max: image.Pt(800, 200)},
+		}
+		btn.Layout(gtx, th)
+	}
+}
@@ -0,0 +1,189 @@
+package button
+
+import (
+	"time"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// ConfirmButton is a button that asks for confirmation inline before acting.
+// Clicking it replaces the button with a prompt, a destructive "Yes" button
+// and a ghost "No" button. "Yes" calls OnConfirm; "No" and Escape go back to
+// the initial button.
+//
+// Example usage:.
+//
+//	del := button.NewConfirmButton("Delete", func() { deleteItem() },
+//		button.WithConfirmVariant(theme.VariantDestructive),
+//		button.WithResetDelay(5*time.Second),
+//	)
+//	dims := del.Layout(gtx, th)
+type ConfirmButton struct {
+	// Configuration
+	PrimaryText string
+	// ConfirmText is the prompt shown while confirming. The theme locale's
+	// "Are you sure?" is used when empty.
+	ConfirmText string
+	// CancelText is the label of the "No" button. The theme locale's "No" is
+	// used when empty.
+	CancelText string
+	OnConfirm  func()
+	Variant    theme.Variant
+	// ResetDelay returns to the initial button when the user doesn't answer
+	// within the delay. Zero waits indefinitely.
+	ResetDelay time.Duration
+
+	// State
+	confirming bool
+	askedAt    time.Time
+	primary    *Button
+	yes        *Button
+	no         *Button
+}
+
+// ConfirmOption is a functional option for configuring ConfirmButton
+// components.
+type ConfirmOption func(*ConfirmButton)
+
+// WithConfirmText sets the confirmation prompt.
+func WithConfirmText(text string) ConfirmOption {
+	return func(b *ConfirmButton) {
+		b.ConfirmText = text
+	}
+}
+
+// WithCancelText sets the label of the "No" button.
+func WithCancelText(text string) ConfirmOption {
+	return func(b *ConfirmButton) {
+		b.CancelText = text
+	}
+}
+
+// WithConfirmVariant sets the variant of the initial button.
+func WithConfirmVariant(variant theme.Variant) ConfirmOption {
+	return func(b *ConfirmButton) {
+		b.Variant = variant
+	}
+}
+
+// WithResetDelay sets how long the confirmation waits for an answer.
+func WithResetDelay(delay time.Duration) ConfirmOption {
+	return func(b *ConfirmButton) {
+		b.ResetDelay = delay
+	}
+}
+
+// NewConfirmButton creates a ConfirmButton that calls onConfirm once the
+// click is confirmed.
+func NewConfirmButton(text string, onConfirm func(), options ...ConfirmOption) *ConfirmButton {
+	b := &ConfirmButton{
+		PrimaryText: text,
+		OnConfirm:   onConfirm,
+		Variant:     theme.VariantDefault,
+		primary:     NewButton(),
+		yes:         NewButton(WithVariant(theme.VariantDestructive), WithSize(theme.SizeSM)),
+		no:          NewButton(WithVariant(theme.VariantGhost), WithSize(theme.SizeSM)),
+	}
+
+	for _, option := range options {
+		option(b)
+	}
+
+	return b
+}
+
+// Confirming reports whether the confirmation prompt is shown.
+func (b *ConfirmButton) Confirming() bool {
+	return b.confirming
+}
+
+// Reset returns to the initial button without calling OnConfirm.
+func (b *ConfirmButton) Reset() {
+	b.confirming = false
+}
+
+// Layout processes clicks, Escape and the reset delay, then renders either the
+// initial button or the confirmation row.
+func (b *ConfirmButton) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	b.update(gtx)
+
+	if !b.confirming {
+		b.primary.Text = b.PrimaryText
+		b.primary.Variant = b.Variant
+		return b.primary.Layout(gtx, th)
+	}
+
+	b.yes.Text = th.Locale.T(i18n.KeyYes)
+	b.no.Text = b.CancelText
+	if b.no.Text == "" {
+		b.no.Text = th.Locale.T(i18n.KeyNo)
+	}
+	prompt := b.ConfirmText
+	if prompt == "" {
+		prompt = th.Locale.T(i18n.KeyAreYouSure)
+	}
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, prompt)
+			lbl.Color = th.Colors.Foreground
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return b.yes.Layout(gtx, th)
+		}),
+		layout.Rigid(layout.Spacer{Width: th.Spacing.Space1}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return b.no.Layout(gtx, th)
+		}),
+	)
+}
+
+// update moves between the initial and confirming states.
+func (b *ConfirmButton) update(gtx layout.Context) {
+	if !b.confirming {
+		if b.primary.Clicked(gtx) && !b.primary.Disabled {
+			b.confirming = true
+			b.askedAt = gtx.Now
+		}
+		return
+	}
+
+	if b.yes.Clicked(gtx) {
+		b.confirming = false
+		if b.OnConfirm != nil {
+			b.OnConfirm()
+		}
+		return
+	}
+	if b.no.Clicked(gtx) {
+		b.confirming = false
+		return
+	}
+
+	for {
+		ev, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok && e.State == key.Press {
+			b.confirming = false
+			return
+		}
+	}
+
+	if b.ResetDelay > 0 {
+		deadline := b.askedAt.Add(b.ResetDelay)
+		if !gtx.Now.Before(deadline) {
+			b.confirming = false
+			return
+		}
+		gtx.Execute(op.InvalidateCmd{At: deadline})
+	}
+}
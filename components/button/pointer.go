@@ -0,0 +1,91 @@
+package button
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/gesture"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/widget"
+)
+
+// pointerClickable is the pointer half of widget.Clickable. Buttons that are
+// not Focusable use it so they never register for keyboard focus, which
+// widget.Clickable always does.
+type pointerClickable struct {
+	click   gesture.Click
+	history []widget.Press
+}
+
+// update processes pointer events and reports whether a click completed.
+func (c *pointerClickable) update(gtx layout.Context) bool {
+	// Forget presses that ended more than a second ago, like widget.Clickable
+	for len(c.history) > 0 {
+		p := c.history[0]
+		if p.End.IsZero() || gtx.Now.Sub(p.End) < time.Second {
+			break
+		}
+		n := copy(c.history, c.history[1:])
+		c.history = c.history[:n]
+	}
+
+	for {
+		e, ok := c.click.Update(gtx.Source)
+		if !ok {
+			return false
+		}
+		switch e.Kind {
+		case gesture.KindClick:
+			if l := len(c.history); l > 0 {
+				c.history[l-1].End = gtx.Now
+			}
+			return true
+		case gesture.KindCancel:
+			for i := range c.history {
+				c.history[i].Cancelled = true
+				if c.history[i].End.IsZero() {
+					c.history[i].End = gtx.Now
+				}
+			}
+		case gesture.KindPress:
+			c.history = append(c.history, widget.Press{Position: e.Position, Start: gtx.Now})
+		}
+	}
+}
+
+// Clicked reports whether the clickable was clicked since the last call.
+func (c *pointerClickable) Clicked(gtx layout.Context) bool {
+	return c.update(gtx)
+}
+
+// Hovered reports whether a pointer is over the clickable.
+func (c *pointerClickable) Hovered() bool {
+	return c.click.Hovered()
+}
+
+// Pressed reports whether a pointer is pressing the clickable.
+func (c *pointerClickable) Pressed() bool {
+	return c.click.Pressed()
+}
+
+// History returns the recent presses, oldest first.
+func (c *pointerClickable) History() []widget.Press {
+	return c.history
+}
+
+// Layout lays out w and registers the clickable over its area.
+func (c *pointerClickable) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	for c.update(gtx) {
+		// Clicks not consumed before Layout are dropped, like widget.Clickable
+	}
+
+	m := op.Record(gtx.Ops)
+	dims := w(gtx)
+	call := m.Stop()
+	defer clip.Rect(image.Rectangle{Max: dims.Size}).Push(gtx.Ops).Pop()
+	c.click.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return dims
+}
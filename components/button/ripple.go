@@ -0,0 +1,80 @@
+package button
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+)
+
+// rippleDuration is how long a ripple takes to expand and fade out.
+const rippleDuration = 450 * time.Millisecond
+
+// rippleState is a single expanding circle spawned by a press.
+type rippleState struct {
+	origin   image.Point
+	progress float32
+	alpha    float32
+}
+
+// updateRipples spawns ripples for new presses and advances the active ones.
+func (b *Button) updateRipples(gtx layout.Context) {
+	for _, press := range b.input().History() {
+		if !press.Start.After(b.lastPress) {
+			continue
+		}
+		b.lastPress = press.Start
+		b.ripples = append(b.ripples, rippleState{origin: press.Position, alpha: 1})
+	}
+
+	if len(b.ripples) == 0 {
+		b.lastFrame = time.Time{}
+		return
+	}
+
+	var dt time.Duration
+	if !b.lastFrame.IsZero() {
+		dt = gtx.Now.Sub(b.lastFrame)
+	}
+	b.lastFrame = gtx.Now
+
+	active := b.ripples[:0]
+	for _, r := range b.ripples {
+		r.progress += float32(dt) / float32(rippleDuration)
+		r.alpha = 1 - r.progress
+		if r.progress < 1 {
+			active = append(active, r)
+		}
+	}
+	b.ripples = active
+
+	if len(b.ripples) > 0 {
+		gtx.Execute(op.InvalidateCmd{})
+	}
+}
+
+// drawRipples draws the active ripples clipped to the button shape.
+func (b *Button) drawRipples(gtx layout.Context, rr clip.RRect, size image.Point, fg color.NRGBA) {
+	if len(b.ripples) == 0 {
+		return
+	}
+
+	defer rr.Push(gtx.Ops).Pop()
+
+	maxRadius := float32(max(size.X, size.Y)) * 1.4
+	for _, r := range b.ripples {
+		radius := maxRadius * r.progress
+		center := f32.Pt(float32(r.origin.X), float32(r.origin.Y))
+		circle := clip.Ellipse{
+			Min: image.Pt(int(center.X-radius), int(center.Y-radius)),
+			Max: image.Pt(int(center.X+radius), int(center.Y+radius)),
+		}
+		paint.FillShape(gtx.Ops, colorutil.WithAlpha(fg, uint8(51*r.alpha)), circle.Op(gtx.Ops))
+	}
+}
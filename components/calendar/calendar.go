@@ -0,0 +1,601 @@
+/*
+Package calendar provides a month calendar for gio-shadcn applications.
+
+A Calendar shows one month as a grid of days under localized weekday names,
+with buttons to move between months. Clicking a day selects it. When the
+calendar has keyboard focus, the arrow keys move a cursor between days and
+Enter or Space selects the day under it.
+
+# Quick Start
+
+Create a calendar:
+
+	cal := calendar.NewCalendar(
+		calendar.WithWeekStart(time.Monday),
+		calendar.WithOnSelect(func(date time.Time) {
+			fmt.Println("Picked", date.Format("2006-01-02"))
+		}),
+	)
+
+Only allow weekdays in the next thirty days:
+
+	today := time.Now()
+	cal := calendar.NewCalendar(
+		calendar.WithMin(today),
+		calendar.WithMax(today.AddDate(0, 0, 30)),
+		calendar.WithDisabled(func(date time.Time) bool {
+			return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+		}),
+	)
+
+Use in layout:
+
+	dims := cal.Layout(gtx, th)
+
+# Keyboard
+
+• Left and Right arrows - Previous and next day
+• Up and Down arrows - Same day of the previous and next week
+• Page Up and Page Down - Same day of the previous and next month
+• Home and End - First and last day of the week
+• Enter and Space - Select the day under the cursor
+
+# Features
+
+• Six-week month grid including days of the adjacent months
+• Selected date, today's date and hover highlighting
+• Minimum and maximum dates, and a callback to disable any date
+• Configurable first day of the week
+• Month and weekday names from the theme locale
+*/
+package calendar
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/gesture"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Calendar geometry.
+const (
+	cellSize        = unit.Dp(36)
+	navSize         = unit.Dp(28)
+	navIconSize     = unit.Dp(16)
+	focusWidth      = unit.Dp(2)
+	weeks           = 6
+	disabledOpacity = 0.5
+)
+
+// Navigation icons. The icon data is constant, so NewIcon cannot fail.
+var (
+	previousIcon, _ = widget.NewIcon(icons.NavigationChevronLeft)
+	nextIcon, _     = widget.NewIcon(icons.NavigationChevronRight)
+)
+
+// Calendar represents a shadcn/ui calendar. Dates are calendar days: the
+// time of day is ignored, and the dates the calendar reports are at
+// midnight in the local time zone.
+//
+// Example usage:.
+//
+//	cal := calendar.NewCalendar(calendar.WithSelected(birthday))
+//	dims := cal.Layout(gtx, th)
+type Calendar struct {
+	// State
+	month    time.Time
+	cursor   time.Time
+	today    time.Time
+	days     [weeks * 7]gesture.Click
+	previous widget.Clickable
+	next     widget.Clickable
+	// labels shapes the text of every cell, created once instead of per label
+	labels *material.Theme
+
+	// Configuration
+	// Selected is the selected date, or the zero time for none.
+	Selected time.Time
+	// Min and Max bound the selectable dates when they are not zero.
+	Min      time.Time
+	Max      time.Time
+	Disabled func(date time.Time) bool
+	// WeekStart is the weekday of the first column.
+	WeekStart time.Weekday
+	OnSelect  func(date time.Time)
+}
+
+// Option is a functional option for configuring Calendar components.
+type Option func(*Calendar)
+
+// WithSelected sets the selected date and shows its month.
+func WithSelected(date time.Time) Option {
+	return func(c *Calendar) {
+		c.Selected = dayOf(date)
+		c.month = monthOf(date)
+	}
+}
+
+// WithMonth sets the month shown first.
+func WithMonth(month time.Time) Option {
+	return func(c *Calendar) {
+		c.month = monthOf(month)
+	}
+}
+
+// WithMin sets the earliest selectable date.
+func WithMin(date time.Time) Option {
+	return func(c *Calendar) {
+		c.Min = date
+	}
+}
+
+// WithMax sets the latest selectable date.
+func WithMax(date time.Time) Option {
+	return func(c *Calendar) {
+		c.Max = date
+	}
+}
+
+// WithDisabled sets a function reporting dates that cannot be selected.
+func WithDisabled(disabled func(date time.Time) bool) Option {
+	return func(c *Calendar) {
+		c.Disabled = disabled
+	}
+}
+
+// WithWeekStart sets the weekday of the first column.
+func WithWeekStart(weekday time.Weekday) Option {
+	return func(c *Calendar) {
+		c.WeekStart = weekday
+	}
+}
+
+// WithOnSelect sets the callback called when the user selects a date.
+func WithOnSelect(onSelect func(date time.Time)) Option {
+	return func(c *Calendar) {
+		c.OnSelect = onSelect
+	}
+}
+
+// NewCalendar creates a new Calendar with the given options. It shows the
+// month of the selected date, or the current month, with weeks starting on
+// Sunday.
+func NewCalendar(options ...Option) *Calendar {
+	c := &Calendar{
+		WeekStart: time.Sunday,
+		today:     dayOf(time.Now()),
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	if c.month.IsZero() {
+		c.month = monthOf(c.today)
+	}
+	c.cursor = c.month
+	if !c.Selected.IsZero() && sameMonth(c.Selected, c.month) {
+		c.cursor = c.Selected
+	} else if sameMonth(c.today, c.month) {
+		c.cursor = c.today
+	}
+
+	return c
+}
+
+// Month returns the first day of the shown month.
+func (c *Calendar) Month() time.Time {
+	return c.month
+}
+
+// SetMonth shows the month of date.
+func (c *Calendar) SetMonth(date time.Time) {
+	c.month = monthOf(date)
+	if !sameMonth(c.cursor, c.month) {
+		c.cursor = c.month
+	}
+}
+
+// Select selects date and shows its month, unless date is disabled. It does
+// not call OnSelect.
+func (c *Calendar) Select(date time.Time) {
+	date = dayOf(date)
+	if c.IsDisabled(date) {
+		return
+	}
+	c.Selected = date
+	c.cursor = date
+	c.month = monthOf(date)
+}
+
+// IsDisabled reports whether date is outside Min and Max or rejected by
+// Disabled.
+func (c *Calendar) IsDisabled(date time.Time) bool {
+	date = dayOf(date)
+	if !c.Min.IsZero() && date.Before(dayOf(c.Min)) {
+		return true
+	}
+	if !c.Max.IsZero() && date.After(dayOf(c.Max)) {
+		return true
+	}
+	return c.Disabled != nil && c.Disabled(date)
+}
+
+// gridStart returns the date in the first cell of the grid.
+func (c *Calendar) gridStart() time.Time {
+	offset := (int(c.month.Weekday()) - int(c.WeekStart) + 7) % 7
+	return c.month.AddDate(0, 0, -offset)
+}
+
+// canShow reports whether the month of date has any day within Min and Max.
+func (c *Calendar) canShow(date time.Time) bool {
+	month := monthOf(date)
+	if !c.Min.IsZero() && month.AddDate(0, 1, -1).Before(dayOf(c.Min)) {
+		return false
+	}
+	return c.Max.IsZero() || !month.After(dayOf(c.Max))
+}
+
+// choose selects date on behalf of the user.
+func (c *Calendar) choose(date time.Time) {
+	if c.IsDisabled(date) {
+		return
+	}
+	c.Select(date)
+	if c.OnSelect != nil {
+		c.OnSelect(c.Selected)
+	}
+}
+
+// moveCursor moves the keyboard cursor to date, keeping it within Min and
+// Max, and shows its month.
+func (c *Calendar) moveCursor(date time.Time) {
+	if !c.Min.IsZero() && date.Before(dayOf(c.Min)) {
+		date = dayOf(c.Min)
+	}
+	if !c.Max.IsZero() && date.After(dayOf(c.Max)) {
+		date = dayOf(c.Max)
+	}
+	c.cursor = date
+	c.month = monthOf(date)
+}
+
+// processKeys handles the keyboard while the calendar is focused.
+func (c *Calendar) processKeys(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(
+			key.FocusFilter{Target: c},
+			key.Filter{Focus: c, Name: key.NameLeftArrow},
+			key.Filter{Focus: c, Name: key.NameRightArrow},
+			key.Filter{Focus: c, Name: key.NameUpArrow},
+			key.Filter{Focus: c, Name: key.NameDownArrow},
+			key.Filter{Focus: c, Name: key.NamePageUp},
+			key.Filter{Focus: c, Name: key.NamePageDown},
+			key.Filter{Focus: c, Name: key.NameHome},
+			key.Filter{Focus: c, Name: key.NameEnd},
+			key.Filter{Focus: c, Name: key.NameReturn},
+			key.Filter{Focus: c, Name: key.NameEnter},
+			key.Filter{Focus: c, Name: key.NameSpace},
+		)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press {
+			continue
+		}
+
+		weekday := (int(c.cursor.Weekday()) - int(c.WeekStart) + 7) % 7
+		switch e.Name {
+		case key.NameLeftArrow:
+			c.moveCursor(c.cursor.AddDate(0, 0, -1))
+		case key.NameRightArrow:
+			c.moveCursor(c.cursor.AddDate(0, 0, 1))
+		case key.NameUpArrow:
+			c.moveCursor(c.cursor.AddDate(0, 0, -7))
+		case key.NameDownArrow:
+			c.moveCursor(c.cursor.AddDate(0, 0, 7))
+		case key.NamePageUp:
+			c.moveCursor(c.cursor.AddDate(0, -1, 0))
+		case key.NamePageDown:
+			c.moveCursor(c.cursor.AddDate(0, 1, 0))
+		case key.NameHome:
+			c.moveCursor(c.cursor.AddDate(0, 0, -weekday))
+		case key.NameEnd:
+			c.moveCursor(c.cursor.AddDate(0, 0, 6-weekday))
+		case key.NameReturn, key.NameEnter, key.NameSpace:
+			c.choose(c.cursor)
+		}
+	}
+}
+
+// Layout renders the month title, the navigation buttons, the weekday names
+// and the day grid.
+func (c *Calendar) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	c.today = dayOf(gtx.Now)
+	if c.labels == nil {
+		c.labels = material.NewTheme()
+	}
+
+	if c.previous.Clicked(gtx) && c.canShow(c.month.AddDate(0, -1, 0)) {
+		c.SetMonth(c.month.AddDate(0, -1, 0))
+	}
+	if c.next.Clicked(gtx) && c.canShow(c.month.AddDate(0, 1, 0)) {
+		c.SetMonth(c.month.AddDate(0, 1, 0))
+	}
+	c.processKeys(gtx)
+
+	start := c.gridStart()
+	for i := range c.days {
+		for {
+			e, ok := c.days[i].Update(gtx.Source)
+			if !ok {
+				break
+			}
+			if e.Kind == gesture.KindClick {
+				date := start.AddDate(0, 0, i)
+				if !c.IsDisabled(date) {
+					c.choose(date)
+					gtx.Execute(key.FocusCmd{Tag: c})
+				}
+			}
+		}
+	}
+	// A click may have shown another month
+	start = c.gridStart()
+
+	width := gtx.Dp(cellSize) * 7
+	return layout.UniformInset(th.Spacing.Space3).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = min(width, gtx.Constraints.Max.X)
+		gtx.Constraints.Max.X = gtx.Constraints.Min.X
+
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return c.layoutHeader(gtx, th)
+			}),
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return c.layoutWeekdays(gtx, th)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return c.layoutGrid(gtx, th, start)
+			}),
+		)
+	})
+}
+
+// layoutHeader renders the month title between the navigation buttons.
+func (c *Calendar) layoutHeader(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	title := fmt.Sprintf(th.Locale.T(i18n.KeyMonthYear), th.Locale.T(i18n.MonthKey(c.month.Month())), c.month.Year())
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutNavButton(gtx, th, &c.previous, previousIcon, c.canShow(c.month.AddDate(0, -1, 0)))
+		}),
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(c.labels, th.Typography.FontSizeSM, title)
+			lbl.Color = th.Colors.Foreground
+			lbl.Font.Weight = font.Medium
+			lbl.Alignment = text.Middle
+			lbl.MaxLines = 1
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutNavButton(gtx, th, &c.next, nextIcon, c.canShow(c.month.AddDate(0, 1, 0)))
+		}),
+	)
+}
+
+// layoutNavButton renders an outlined month navigation button. Disabled
+// buttons are faded and ignore the pointer.
+func layoutNavButton(gtx layout.Context, th *theme.Theme, click *widget.Clickable, icon *widget.Icon, enabled bool) layout.Dimensions {
+	if !enabled {
+		gtx = gtx.Disabled()
+	}
+
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		size := gtx.Dp(navSize)
+		box := image.Rectangle{Max: image.Pt(size, size)}
+		rrect := clip.UniformRRect(box, gtx.Dp(th.Radius.RadiusMD))
+
+		border, fg := th.Colors.Input, th.Colors.Foreground
+		if !enabled {
+			border = utils.ApplyOpacity(border, disabledOpacity)
+			fg = utils.ApplyOpacity(fg, disabledOpacity)
+		} else if click.Hovered() || gtx.Focused(click) {
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rrect.Op(gtx.Ops))
+		}
+		paint.FillShape(gtx.Ops, border, clip.Stroke{
+			Path:  rrect.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(1))),
+		}.Op())
+		if enabled {
+			pointer.CursorPointer.Add(gtx.Ops)
+		}
+
+		iconSize := gtx.Dp(navIconSize)
+		gtx.Constraints = layout.Exact(image.Pt(iconSize, iconSize))
+		offset := (size - iconSize) / 2
+		iconOffset := op.Offset(image.Pt(offset, offset)).Push(gtx.Ops)
+		icon.Layout(gtx, fg)
+		iconOffset.Pop()
+
+		return layout.Dimensions{Size: box.Max}
+	})
+}
+
+// layoutWeekdays renders the weekday names above the grid columns.
+func (c *Calendar) layoutWeekdays(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	children := make([]layout.FlexChild, 7)
+	for i := range children {
+		weekday := time.Weekday((int(c.WeekStart) + i) % 7)
+		children[i] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Dp(cellSize)
+			gtx.Constraints.Max.X = gtx.Constraints.Min.X
+			lbl := material.Label(c.labels, th.Typography.FontSizeXS, th.Locale.T(i18n.WeekdayKey(weekday)))
+			lbl.Color = th.Colors.MutedFg
+			lbl.Alignment = text.Middle
+			lbl.MaxLines = 1
+			return layout.Inset{Bottom: th.Spacing.Space2}.Layout(gtx, lbl.Layout)
+		})
+	}
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+}
+
+// layoutGrid renders six weeks of days starting at start.
+func (c *Calendar) layoutGrid(gtx layout.Context, th *theme.Theme, start time.Time) layout.Dimensions {
+	// The grid takes keyboard focus for the whole calendar
+	cell := gtx.Dp(cellSize)
+	defer clip.Rect{Max: image.Pt(cell*7, cell*weeks)}.Push(gtx.Ops).Pop()
+	event.Op(gtx.Ops, c)
+
+	rows := make([]layout.FlexChild, weeks)
+	for w := range rows {
+		rows[w] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			days := make([]layout.FlexChild, 7)
+			for d := range days {
+				i := w*7 + d
+				days[d] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return c.layoutDay(gtx, th, i, start.AddDate(0, 0, i))
+				})
+			}
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, days...)
+		})
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+// layoutDay renders the day cell at grid index i. Days of the adjacent
+// months are muted, disabled days faded, and the keyboard cursor ringed
+// while the calendar is focused.
+func (c *Calendar) layoutDay(gtx layout.Context, th *theme.Theme, i int, date time.Time) layout.Dimensions {
+	size := gtx.Dp(cellSize)
+	box := image.Rectangle{Max: image.Pt(size, size)}
+	rrect := clip.UniformRRect(box, gtx.Dp(th.Radius.RadiusMD))
+	disabled := c.IsDisabled(date)
+
+	var bg color.NRGBA
+	fg := th.Colors.Foreground
+	if !sameMonth(date, c.month) {
+		fg = th.Colors.MutedFg
+	}
+	switch {
+	case disabled:
+		fg = utils.ApplyOpacity(th.Colors.MutedFg, disabledOpacity)
+	case sameDay(date, c.Selected):
+		bg, fg = th.Colors.Primary, th.Colors.PrimaryFg
+	case c.days[i].Hovered() || sameDay(date, c.today):
+		bg, fg = th.Colors.Accent, th.Colors.AccentFg
+	}
+	if bg.A > 0 {
+		paint.FillShape(gtx.Ops, bg, rrect.Op(gtx.Ops))
+	}
+	if gtx.Focused(c) && sameDay(date, c.cursor) {
+		paint.FillShape(gtx.Ops, th.Colors.Ring, clip.Stroke{
+			Path:  rrect.Path(gtx.Ops),
+			Width: float32(gtx.Dp(focusWidth)),
+		}.Op())
+	}
+
+	area := clip.Rect(box).Push(gtx.Ops)
+	if !disabled {
+		c.days[i].Add(gtx.Ops)
+		pointer.CursorPointer.Add(gtx.Ops)
+	}
+	area.Pop()
+
+	gtx.Constraints = layout.Exact(box.Max)
+	layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		lbl := material.Label(c.labels, th.Typography.FontSizeSM, strconv.Itoa(date.Day()))
+		lbl.Color = fg
+		lbl.MaxLines = 1
+		return lbl.Layout(gtx)
+	})
+
+	return layout.Dimensions{Size: box.Max}
+}
+
+// dayOf returns midnight of t's date in the local time zone.
+func dayOf(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+// monthOf returns the first day of t's month.
+func monthOf(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.Local)
+}
+
+func sameDay(a, b time.Time) bool {
+	return sameMonth(a, b) && a.Day() == b.Day()
+}
+
+func sameMonth(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
+
+// Update returns the component state for Calendar.
+func (c *Calendar) Update(_ layout.Context) theme.ComponentState {
+	state := &State{active: !c.Selected.IsZero()}
+	for i := range c.days {
+		if c.days[i].Hovered() {
+			state.hovered = true
+		}
+		if c.days[i].Pressed() {
+			state.pressed = true
+		}
+	}
+	return state
+}
+
+// State implements ComponentState for Calendar.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if a date is selected.
+func (cs *State) IsActive() bool {
+	return cs.active
+}
+
+// IsHovered returns true if the pointer is over a day.
+func (cs *State) IsHovered() bool {
+	return cs.hovered
+}
+
+// IsPressed returns true if a day is being pressed.
+func (cs *State) IsPressed() bool {
+	return cs.pressed
+}
+
+// IsDisabled always returns false; calendars cannot be disabled as a whole.
+func (cs *State) IsDisabled() bool {
+	return cs.disabled
+}
@@ -0,0 +1,215 @@
+/*
+Package canvas provides a freeform 2D drawing surface for gio-shadcn applications.
+
+A Canvas fills the space it is given, paints its background, and calls an OnDraw
+function clipped to its bounds. It reports pointer input in canvas coordinates,
+which makes it a building block for whiteboards, charts and annotation layers.
+DrawLine, DrawCircle and DrawRect cover the most common shapes.
+
+# Quick Start
+
+Draw a simple chart:
+
+	c := canvas.NewCanvas(func(gtx layout.Context, bounds image.Rectangle) {
+		canvas.DrawLine(gtx, image.Pt(0, bounds.Max.Y), image.Pt(bounds.Max.X, 0), unit.Dp(2), th.Colors.Primary)
+	})
+	dims := c.Layout(gtx, th)
+
+# Signature Pad
+
+Collect strokes while the pointer is down and draw them as connected lines:
+
+	var strokes [][]image.Point
+	var drawing bool
+
+	pad := canvas.NewCanvas(func(gtx layout.Context, _ image.Rectangle) {
+		for _, stroke := range strokes {
+			for i := 1; i < len(stroke); i++ {
+				canvas.DrawLine(gtx, stroke[i-1], stroke[i], unit.Dp(2), th.Colors.Foreground)
+			}
+		}
+	},
+		canvas.WithOnPointerDown(func(p image.Point, _ pointer.Buttons) {
+			drawing = true
+			strokes = append(strokes, []image.Point{p})
+		}),
+		canvas.WithOnPointerMove(func(p image.Point) {
+			if drawing {
+				last := len(strokes) - 1
+				strokes[last] = append(strokes[last], p)
+			}
+		}),
+		canvas.WithOnPointerUp(func(image.Point) {
+			drawing = false
+		}),
+	)
+
+# Features
+
+• Background fill and clipping to the canvas bounds
+• Pointer down, move (including drag) and up callbacks
+• Line, circle and rectangle drawing helpers
+*/
+package canvas
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Canvas represents a freeform drawing surface.
+type Canvas struct {
+	// Configuration
+	OnDraw          func(gtx layout.Context, bounds image.Rectangle)
+	BackgroundColor color.NRGBA
+
+	// Callbacks
+	OnPointerMove func(image.Point)
+	OnPointerDown func(image.Point, pointer.Buttons)
+	OnPointerUp   func(image.Point)
+}
+
+// Option is a functional option for configuring Canvas components.
+type Option func(*Canvas)
+
+// WithBackgroundColor sets the canvas background color. A zero color uses
+// the theme's background color.
+func WithBackgroundColor(bg color.NRGBA) Option {
+	return func(c *Canvas) {
+		c.BackgroundColor = bg
+	}
+}
+
+// WithOnPointerMove sets the callback for pointer moves and drags.
+func WithOnPointerMove(onMove func(image.Point)) Option {
+	return func(c *Canvas) {
+		c.OnPointerMove = onMove
+	}
+}
+
+// WithOnPointerDown sets the callback for pointer presses.
+func WithOnPointerDown(onDown func(image.Point, pointer.Buttons)) Option {
+	return func(c *Canvas) {
+		c.OnPointerDown = onDown
+	}
+}
+
+// WithOnPointerUp sets the callback for pointer releases.
+func WithOnPointerUp(onUp func(image.Point)) Option {
+	return func(c *Canvas) {
+		c.OnPointerUp = onUp
+	}
+}
+
+// NewCanvas creates a new Canvas that draws with onDraw.
+func NewCanvas(onDraw func(gtx layout.Context, bounds image.Rectangle), options ...Option) *Canvas {
+	c := &Canvas{
+		OnDraw: onDraw,
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Layout renders the canvas over all the available space.
+func (c *Canvas) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	c.processPointer(gtx)
+
+	bounds := image.Rectangle{Max: gtx.Constraints.Max}
+	area := clip.Rect(bounds).Push(gtx.Ops)
+	defer area.Pop()
+
+	// Register for pointer input over the canvas
+	event.Op(gtx.Ops, c)
+
+	bg := c.BackgroundColor
+	if bg == (color.NRGBA{}) {
+		bg = th.Colors.Background
+	}
+	paint.ColorOp{Color: bg}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+
+	if c.OnDraw != nil {
+		c.OnDraw(gtx, bounds)
+	}
+
+	return layout.Dimensions{Size: bounds.Max}
+}
+
+// processPointer dispatches pointer events to the callbacks.
+func (c *Canvas) processPointer(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{
+			Target: c,
+			Kinds:  pointer.Press | pointer.Drag | pointer.Move | pointer.Release,
+		})
+		if !ok {
+			break
+		}
+		e, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+
+		pos := e.Position.Round()
+		switch e.Kind {
+		case pointer.Press:
+			if c.OnPointerDown != nil {
+				c.OnPointerDown(pos, e.Buttons)
+			}
+		case pointer.Move, pointer.Drag:
+			if c.OnPointerMove != nil {
+				c.OnPointerMove(pos)
+			}
+		case pointer.Release:
+			if c.OnPointerUp != nil {
+				c.OnPointerUp(pos)
+			}
+		}
+	}
+}
+
+// DrawLine draws a straight line of the given width between two points.
+func DrawLine(gtx layout.Context, from, to image.Point, width unit.Dp, col color.NRGBA) {
+	var path clip.Path
+	path.Begin(gtx.Ops)
+	path.MoveTo(toF32(from))
+	path.LineTo(toF32(to))
+
+	paint.FillShape(gtx.Ops, col, clip.Stroke{
+		Path:  path.End(),
+		Width: float32(gtx.Dp(width)),
+	}.Op())
+}
+
+// DrawCircle draws a filled circle.
+func DrawCircle(gtx layout.Context, center image.Point, radius unit.Dp, col color.NRGBA) {
+	r := gtx.Dp(radius)
+	circle := clip.Ellipse{
+		Min: center.Sub(image.Pt(r, r)),
+		Max: center.Add(image.Pt(r, r)),
+	}
+	paint.FillShape(gtx.Ops, col, circle.Op(gtx.Ops))
+}
+
+// DrawRect draws a filled rectangle.
+func DrawRect(gtx layout.Context, rect image.Rectangle, col color.NRGBA) {
+	paint.FillShape(gtx.Ops, col, clip.Rect(rect).Op())
+}
+
+// toF32 converts an integer point to a float point.
+func toF32(p image.Point) f32.Point {
+	return f32.Pt(float32(p.X), float32(p.Y))
+}
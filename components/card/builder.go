@@ -0,0 +1,131 @@
+package card
+
+import (
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/separator"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// CardLayoutBuilder assembles the inside of a card: a title, description,
+// separators, content and footer stacked with a consistent gap. Unlike
+// Composed, it doesn't draw a card, so the result can be laid out inside any
+// card. The theme is taken at layout time, so switching themes or dark mode
+// restyles an already built layout.
+//
+// Example usage:.
+//
+//	body := card.NewCardLayout().
+//		Title("Notifications").
+//		Description("Choose what you want to be notified about.").
+//		Separator().
+//		Content(settingsList).
+//		Footer(saveButton).
+//		Build()
+//	dims := c.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+//		return body(gtx, th)
+//	})
+//
+//nolint:revive // CardLayoutBuilder reads better than LayoutBuilder at call sites
+type CardLayoutBuilder struct {
+	gap      unit.Dp
+	gapSet   bool
+	sections []cardSection
+}
+
+// cardSection is one entry of a CardLayoutBuilder.
+type cardSection struct {
+	widget      func(gtx layout.Context, th *theme.Theme) layout.Dimensions
+	title       bool
+	description bool
+	footer      bool
+}
+
+// NewCardLayout starts building card internals. The gap between sections
+// defaults to th.Spacing.Space4 of the theme used at layout time.
+func NewCardLayout() *CardLayoutBuilder {
+	return &CardLayoutBuilder{}
+}
+
+// Title adds a card title.
+func (b *CardLayoutBuilder) Title(text string) *CardLayoutBuilder {
+	title := label.NewTypography(text, label.H4, "")
+	return b.add(cardSection{title: true, widget: title.Layout})
+}
+
+// Description adds muted description text.
+func (b *CardLayoutBuilder) Description(text string) *CardLayoutBuilder {
+	description := label.NewTypography(text, label.Muted, "")
+	return b.add(cardSection{description: true, widget: description.Layout})
+}
+
+// Separator adds a full-width horizontal line.
+func (b *CardLayoutBuilder) Separator() *CardLayoutBuilder {
+	return b.add(cardSection{widget: separator.NewHorizontal().Layout})
+}
+
+// Content adds a content section.
+func (b *CardLayoutBuilder) Content(widget layout.Widget) *CardLayoutBuilder {
+	return b.add(cardSection{widget: themeless(widget)})
+}
+
+// Footer adds a footer section, separated from the sections above it by an
+// extra gap.
+func (b *CardLayoutBuilder) Footer(widget layout.Widget) *CardLayoutBuilder {
+	return b.add(cardSection{widget: themeless(widget), footer: true})
+}
+
+// Gap sets the space between sections.
+func (b *CardLayoutBuilder) Gap(gap unit.Dp) *CardLayoutBuilder {
+	b.gap = gap
+	b.gapSet = true
+	return b
+}
+
+func (b *CardLayoutBuilder) add(section cardSection) *CardLayoutBuilder {
+	b.sections = append(b.sections, section)
+	return b
+}
+
+// themeless adapts a plain widget to a section that ignores the theme.
+func themeless(widget layout.Widget) func(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	return func(gtx layout.Context, _ *theme.Theme) layout.Dimensions {
+		return widget(gtx)
+	}
+}
+
+// Build returns a function that stacks the sections vertically using the
+// given theme. Title and Description sit closer together, mirroring the
+// shadcn/ui card header.
+func (b *CardLayoutBuilder) Build() func(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	sections := append([]cardSection(nil), b.sections...)
+	customGap, gapSet := b.gap, b.gapSet
+
+	return func(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+		gap := th.Spacing.Space4
+		if gapSet {
+			gap = customGap
+		}
+		headerGap := th.Spacing.Space1
+
+		children := make([]layout.FlexChild, 0, 2*len(sections))
+		for idx, section := range sections {
+			if idx > 0 {
+				space := gap
+				switch {
+				case section.description && sections[idx-1].title:
+					space = headerGap
+				case section.footer:
+					space += gap / 2
+				}
+				children = append(children, layout.Rigid(layout.Spacer{Height: space}.Layout))
+			}
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return section.widget(gtx, th)
+			}))
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	}
+}
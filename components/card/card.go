@@ -28,6 +28,14 @@ Use as a container:
 • Support for CSS-like class utilities
 • Flexible content layout with layout function parameter
 • Proper background and border rendering
+• Overflow control (visible, hidden or scrollable content)
+• Optional animated hover effect for interactive cards
+• Opacity for dimmed cards
+• PropertyTable for two-column key:value detail panels
+• DraggableCard and DragTarget for drag-and-drop, with a KanbanBoard
+• CardLayoutBuilder for title, description, content and footer internals
+• Skeleton loading state that fades into the content when loading ends
+• Maximum and minimum widths for centered narrow cards such as login forms
 
 # Examples
 
@@ -41,6 +49,11 @@ Basic card with content:
 		)
 	})
 
+Centered card for a login form:
+
+	card := card.NewCard(card.WithMaxWidth(400))
+	dims := card.Layout(gtx, th, formWidget)
+
 Card with custom padding:
 
 	card := card.New(card.Config{
@@ -52,22 +65,96 @@ package card
 
 import (
 	"image"
+	"image/color"
 
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
 	"gioui.org/layout"
+	"gioui.org/op"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/unit"
 	"gioui.org/widget/material"
 	"github.com/bnema/gio-shadcn/theme"
 	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/animation"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
 )
 
 // Card represents a shadcn/ui card component.
 type Card struct {
+	// State
+	scroll  layout.List
+	hovered bool
+	hover   animation.Animator
+	// skeletonFade is the skeleton opacity, faded out when loading ends
+	skeletonFade animation.Animator
+
 	// Configuration
-	Variant theme.Variant
-	Classes string
-	Padding layout.Inset
+	Variant     theme.Variant
+	Classes     string
+	Padding     layout.Inset
+	BorderStyle theme.BorderStyle
+	Shadow      ShadowPreset
+	Overflow    OverflowMode
+	HoverEffect bool
+	Transition  animation.Config
+	Opacity     float32
+	// Loading replaces the content with skeleton placeholders
+	Loading bool
+	// MaxWidth limits the card width and centers it in the available space.
+	// Zero means no limit.
+	MaxWidth unit.Dp
+	// MinWidth is the smallest card width, limited by the available space.
+	MinWidth unit.Dp
+}
+
+// OverflowMode controls how content larger than the card's constraints is handled.
+type OverflowMode string
+
+// Available overflow modes.
+const (
+	// OverflowVisible lets content grow past the card bounds (the default).
+	OverflowVisible OverflowMode = "visible"
+	// OverflowHidden clips content to the card bounds.
+	OverflowHidden OverflowMode = "hidden"
+	// OverflowScroll clips content and makes it scrollable vertically.
+	OverflowScroll OverflowMode = "scroll"
+)
+
+// ShadowPreset represents the elevation of a card.
+type ShadowPreset string
+
+// Available shadow presets.
+const (
+	ShadowNone ShadowPreset = "none"
+	ShadowSM   ShadowPreset = "sm"
+	ShadowMD   ShadowPreset = "md"
+	ShadowLG   ShadowPreset = "lg"
+)
+
+// shadowSpec describes a drop shadow in dp.
+type shadowSpec struct {
+	offsetY unit.Dp
+	blur    unit.Dp
+	spread  unit.Dp
+	alpha   uint8
+}
+
+// shadowLayers is the number of rounded rectangles used to fake the blur.
+const shadowLayers = 4
+
+func (s ShadowPreset) spec() (shadowSpec, bool) {
+	switch s {
+	case ShadowSM:
+		return shadowSpec{offsetY: 1, blur: 3, spread: 0, alpha: 0x1a}, true
+	case ShadowMD:
+		return shadowSpec{offsetY: 4, blur: 6, spread: -1, alpha: 0x1a}, true
+	case ShadowLG:
+		return shadowSpec{offsetY: 10, blur: 15, spread: -3, alpha: 0x1a}, true
+	default:
+		return shadowSpec{}, false
+	}
 }
 
 // Option is a functional option for configuring Card components.
@@ -94,11 +181,81 @@ func WithCardPadding(padding layout.Inset) Option {
 	}
 }
 
+// WithBorderStyle sets the border line style (solid, dashed, dotted or none).
+func WithBorderStyle(style theme.BorderStyle) Option {
+	return func(c *Card) {
+		c.BorderStyle = style
+	}
+}
+
+// WithShadow sets the card shadow preset.
+func WithShadow(shadow ShadowPreset) Option {
+	return func(c *Card) {
+		c.Shadow = shadow
+	}
+}
+
+// WithOverflow sets how content larger than the card is handled.
+func WithOverflow(mode OverflowMode) Option {
+	return func(c *Card) {
+		c.Overflow = mode
+	}
+}
+
+// WithHoverEffect makes the card background and border shift subtly while
+// the pointer hovers over it, to signal interactivity.
+func WithHoverEffect() Option {
+	return func(c *Card) {
+		c.HoverEffect = true
+	}
+}
+
+// WithTransition animates the hover effect with the given timing instead of
+// switching colors instantly.
+func WithTransition(config animation.Config) Option {
+	return func(c *Card) {
+		c.Transition = config
+	}
+}
+
+// WithOpacity dims the card background and border by opacity (0-1) using
+// utils.ApplyOpacity. Zero leaves them unchanged.
+func WithOpacity(opacity float32) Option {
+	return func(c *Card) {
+		c.Opacity = opacity
+	}
+}
+
+// WithLoading shows skeleton placeholders instead of the content while
+// loading is true.
+func WithLoading(loading bool) Option {
+	return func(c *Card) {
+		c.Loading = loading
+	}
+}
+
+// WithMaxWidth limits the card to width and centers it, for narrow cards
+// such as login forms. Combined with WithMinWidth of the same width it gives
+// a fixed-width card.
+func WithMaxWidth(width unit.Dp) Option {
+	return func(c *Card) {
+		c.MaxWidth = width
+	}
+}
+
+// WithMinWidth makes the card at least width wide, when there is room.
+func WithMinWidth(width unit.Dp) Option {
+	return func(c *Card) {
+		c.MinWidth = width
+	}
+}
+
 // NewCard creates a new Card with the given options.
 func NewCard(options ...Option) *Card {
 	c := &Card{
 		Variant: theme.VariantDefault,
 		Padding: layout.Inset{Top: 24, Right: 24, Bottom: 24, Left: 24},
+		Shadow:  ShadowSM,
 	}
 
 	for _, option := range options {
@@ -110,24 +267,60 @@ func NewCard(options ...Option) *Card {
 
 // Config represents card configuration.
 type Config struct {
-	Variant theme.Variant
-	Classes string
-	Padding layout.Inset
+	Variant     theme.Variant
+	Classes     string
+	Padding     layout.Inset
+	BorderStyle theme.BorderStyle
+	Shadow      ShadowPreset
+	Overflow    OverflowMode
+	HoverEffect bool
+	Transition  animation.Config
+	Loading     bool
+	MaxWidth    unit.Dp
+	MinWidth    unit.Dp
 }
 
 // New creates a new card with the given configuration.
 func New(config Config) *Card {
 	return &Card{
-		Variant: config.Variant,
-		Classes: config.Classes,
-		Padding: config.Padding,
+		Variant:     config.Variant,
+		Classes:     config.Classes,
+		Padding:     config.Padding,
+		BorderStyle: config.BorderStyle,
+		Shadow:      config.Shadow,
+		Overflow:    config.Overflow,
+		HoverEffect: config.HoverEffect,
+		Transition:  config.Transition,
+		Loading:     config.Loading,
+		MaxWidth:    config.MaxWidth,
+		MinWidth:    config.MinWidth,
 	}
 }
 
-// Layout renders the card with the given content.
+// Layout renders the card with the given content. Cards with a MaxWidth are
+// centered in the available space.
 func (c *Card) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	if c.MaxWidth <= 0 {
+		return c.render(gtx, th, content)
+	}
+
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Max.X = min(gtx.Constraints.Max.X, gtx.Dp(c.MaxWidth))
+		gtx.Constraints.Min.X = min(gtx.Constraints.Min.X, gtx.Constraints.Max.X)
+		return c.render(gtx, th, content)
+	})
+}
+
+// render draws the card background, content and border within the given
+// constraints.
+func (c *Card) render(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	// Stretch the content to the minimum width, when there is room
+	if c.MinWidth > 0 {
+		gtx.Constraints.Min.X = max(gtx.Constraints.Min.X, min(gtx.Dp(c.MinWidth), gtx.Constraints.Max.X))
+	}
+
 	// Get variant configuration
-	variant := theme.GetCardVariant(c.Variant, &th.Colors)
+	variant := th.CardVariant(c.Variant)
 
 	// Parse additional classes
 	styles := utils.ParseClasses(c.Classes)
@@ -148,11 +341,19 @@ func (c *Card) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget
 		padding = styles.Padding
 	}
 
-	// Determine background color
+	// Determine background and border colors
 	bgColor := variant.Background
 	if styles.Background.A > 0 {
 		bgColor = styles.Background
 	}
+	borderColor := variant.Border
+	if c.HoverEffect {
+		bgColor, borderColor = c.hoverColors(gtx, th, bgColor, borderColor)
+	}
+	if c.Opacity > 0 {
+		bgColor = utils.ApplyOpacity(bgColor, c.Opacity)
+		borderColor = utils.ApplyOpacity(borderColor, c.Opacity)
+	}
 
 	// Determine border radius
 	radius := th.Radius.RadiusLG
@@ -160,40 +361,153 @@ func (c *Card) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget
 		radius = styles.Radius
 	}
 
-	return layout.Stack{}.Layout(gtx,
-		// Background
-		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-			dims := padding.Layout(gtx, content)
+	// Loading cards show skeletons, cross-fading to the content when done
+	content = c.loadingContent(th, content)
+
+	// Scrollable content is laid out in a vertical list
+	body := content
+	if c.Overflow == OverflowScroll {
+		c.scroll.Axis = layout.Vertical
+		body = func(gtx layout.Context) layout.Dimensions {
+			return c.scroll.Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
+				return content(gtx)
+			})
+		}
+	}
+
+	// Record content once so the background can be sized to it
+	macro := op.Record(gtx.Ops)
+	dims := padding.Layout(gtx, body)
+	call := macro.Stop()
+	if c.MinWidth > 0 {
+		dims.Size.X = max(dims.Size.X, gtx.Constraints.Min.X)
+	}
+
+	clipped := c.Overflow == OverflowHidden || c.Overflow == OverflowScroll
+	if clipped {
+		dims.Size = gtx.Constraints.Constrain(dims.Size)
+	}
 
-			// Draw background
-			rect := image.Rectangle{Max: dims.Size}
-			rr := clip.UniformRRect(rect, gtx.Dp(radius))
-			paint.FillShape(gtx.Ops, bgColor, rr.Op(gtx.Ops))
+	// Draw shadow beneath the card
+	rect := image.Rectangle{Max: dims.Size}
+	c.drawShadow(gtx, th, rect, radius)
 
-			// Draw border
-			if variant.BorderWidth > 0 {
-				border := clip.Stroke{
-					Path:  rr.Path(gtx.Ops),
-					Width: float32(gtx.Dp(unit.Dp(variant.BorderWidth))),
-				}
-				paint.FillShape(gtx.Ops, variant.Border, border.Op())
+	// Draw background
+	rr := clip.UniformRRect(rect, gtx.Dp(radius))
+	paint.FillShape(gtx.Ops, bgColor, rr.Op(gtx.Ops))
+
+	// Register for hover events; the area encloses the content so that
+	// content widgets still receive their own pointer events.
+	var hoverArea clip.Stack
+	if c.HoverEffect {
+		hoverArea = clip.Rect(rect).Push(gtx.Ops)
+		event.Op(gtx.Ops, c)
+	}
+
+	// Draw content, clipped to the card bounds when overflow is not visible
+	if clipped {
+		stack := rr.Push(gtx.Ops)
+		call.Add(gtx.Ops)
+		stack.Pop()
+	} else {
+		call.Add(gtx.Ops)
+	}
+
+	if c.HoverEffect {
+		hoverArea.Pop()
+	}
+
+	// Draw border
+	if variant.BorderWidth > 0 {
+		borderWidth := unit.Dp(variant.BorderWidth)
+		switch c.BorderStyle {
+		case theme.BorderNone:
+			// No border
+		case theme.BorderDashed:
+			utils.DrawDashedBorder(gtx, rect, radius, borderWidth, borderColor, unit.Dp(6), unit.Dp(4))
+		case theme.BorderDotted:
+			utils.DrawDashedBorder(gtx, rect, radius, borderWidth, borderColor, unit.Dp(2), unit.Dp(2))
+		default:
+			border := clip.Stroke{
+				Path:  rr.Path(gtx.Ops),
+				Width: float32(gtx.Dp(borderWidth)),
 			}
+			paint.FillShape(gtx.Ops, borderColor, border.Op())
+		}
+	}
 
-			return dims
-		}),
+	return dims
+}
 
-		// Content
-		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-			return padding.Layout(gtx, content)
-		}),
-	)
+// hoverColors tracks pointer enter and leave events and returns the background
+// and border colors for the current hover progress. Hovered cards move 3%
+// towards the foreground, with a stronger border.
+func (c *Card) hoverColors(gtx layout.Context, th *theme.Theme, bg, border color.NRGBA) (color.NRGBA, color.NRGBA) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: c, Kinds: pointer.Enter | pointer.Leave | pointer.Cancel})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok {
+			c.hovered = e.Kind == pointer.Enter
+		}
+	}
+
+	target := float32(0)
+	if c.hovered {
+		target = 1
+	}
+
+	progress := target
+	if c.Transition.Duration > 0 && !th.ReducedMotion() {
+		c.hover.Configure(c.Transition)
+		c.hover.Animate(target)
+		progress = c.hover.Value(gtx)
+	} else {
+		c.hover.Set(target)
+	}
+
+	hoverBg := colorutil.Darken(bg, 0.03)
+	hoverBorder := colorutil.Darken(border, 0.15)
+	if th.IsDark {
+		hoverBg = colorutil.Lighten(bg, 0.03)
+		hoverBorder = colorutil.Lighten(border, 0.15)
+	}
+
+	return colorutil.Mix(bg, hoverBg, progress), colorutil.Mix(border, hoverBorder, progress)
+}
+
+// drawShadow fakes a blurred drop shadow with stacked translucent rounded
+// rectangles, since Gio has no blur operation. The layers overlap near the
+// card, so the shadow fades out towards its outer edge.
+func (c *Card) drawShadow(gtx layout.Context, th *theme.Theme, rect image.Rectangle, radius unit.Dp) {
+	spec, ok := c.Shadow.spec()
+	if !ok {
+		return
+	}
+
+	// Dark backgrounds need a softer shadow to avoid looking harsh
+	alpha := spec.alpha
+	if th.IsDark {
+		alpha /= 2
+	}
+
+	offset := image.Pt(0, gtx.Dp(spec.offsetY))
+	spread := gtx.Dp(spec.spread)
+	blur := gtx.Dp(spec.blur)
+	for i := shadowLayers; i >= 1; i-- {
+		grow := spread + blur*i/shadowLayers
+		layer := rect.Inset(-grow).Add(offset)
+		rr := clip.UniformRRect(layer, max(0, gtx.Dp(radius)+grow))
+		paint.FillShape(gtx.Ops, color.NRGBA{A: alpha / shadowLayers}, rr.Op(gtx.Ops))
+	}
 }
 
 // Update returns the component state for Card.
 func (c *Card) Update(_ layout.Context) theme.ComponentState {
 	return &State{
 		active:   false,
-		hovered:  false,
+		hovered:  c.hovered,
 		pressed:  false,
 		disabled: false,
 	}
@@ -0,0 +1,162 @@
+package card
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/separator"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Composed builds a card from header, content and footer sections, mirroring
+// the shadcn/ui Card > CardHeader > CardContent > CardFooter structure.
+//
+// Example usage:.
+//
+//	c := card.NewComposed().
+//		Header(title).
+//		Content(body).
+//		Footer(actions).
+//		Build()
+//	dims := c.Layout(gtx, th)
+type Composed struct {
+	card *ComposedCard
+}
+
+// NewComposed starts building a composed card.
+func NewComposed() *Composed {
+	return &Composed{
+		card: &ComposedCard{
+			Variant: theme.VariantDefault,
+			Shadow:  ShadowSM,
+		},
+	}
+}
+
+// Header sets the header section.
+func (b *Composed) Header(content layout.Widget) *Composed {
+	b.card.header = content
+	return b
+}
+
+// Content sets the main content section.
+func (b *Composed) Content(content layout.Widget) *Composed {
+	b.card.content = content
+	return b
+}
+
+// Footer sets the footer section, rendered on a muted background.
+func (b *Composed) Footer(content layout.Widget) *Composed {
+	b.card.footer = content
+	return b
+}
+
+// Build returns the composed card.
+func (b *Composed) Build() *ComposedCard {
+	return b.card
+}
+
+// ComposedCard is a card made of optional header, content and footer sections
+// separated by horizontal lines.
+type ComposedCard struct {
+	header  layout.Widget
+	content layout.Widget
+	footer  layout.Widget
+
+	// Configuration
+	Variant theme.Variant
+	Shadow  ShadowPreset
+	// Section paddings. A zero inset uses the theme's spacing at layout time:
+	// Space6 for the header and content, Space4 for the footer.
+	HeaderPadding  layout.Inset
+	ContentPadding layout.Inset
+	FooterPadding  layout.Inset
+}
+
+// Layout renders the card and its sections.
+func (cc *ComposedCard) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	variant := th.CardVariant(cc.Variant)
+	radius := th.Radius.RadiusLG
+
+	// Sections in order, with a separator between consecutive ones
+	type section struct {
+		widget  layout.Widget
+		padding layout.Inset
+		footer  bool
+	}
+	var sections []section
+	for _, s := range []section{
+		{cc.header, sectionPadding(cc.HeaderPadding, th.Spacing.Space6), false},
+		{cc.content, sectionPadding(cc.ContentPadding, th.Spacing.Space6), false},
+		{cc.footer, sectionPadding(cc.FooterPadding, th.Spacing.Space4), true},
+	} {
+		if s.widget != nil {
+			sections = append(sections, s)
+		}
+	}
+
+	footerTop := -1
+	y := 0
+	sep := separator.NewHorizontal()
+	children := make([]layout.FlexChild, 0, 2*len(sections))
+	for i, s := range sections {
+		if i > 0 {
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				dims := sep.Layout(gtx, th)
+				y += dims.Size.Y
+				return dims
+			}))
+		}
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			if s.footer {
+				footerTop = y
+			}
+			dims := s.padding.Layout(gtx, s.widget)
+			y += dims.Size.Y
+			return dims
+		}))
+	}
+
+	macro := op.Record(gtx.Ops)
+	dims := layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	call := macro.Stop()
+
+	rect := image.Rectangle{Max: dims.Size}
+	rr := clip.UniformRRect(rect, gtx.Dp(radius))
+
+	(&Card{Shadow: cc.Shadow}).drawShadow(gtx, th, rect, radius)
+	paint.FillShape(gtx.Ops, variant.Background, rr.Op(gtx.Ops))
+
+	// Muted footer background, clipped to the card's rounded corners
+	if footerTop >= 0 {
+		clipCard := rr.Push(gtx.Ops)
+		footer := clip.Rect{Min: image.Pt(0, footerTop), Max: dims.Size}
+		paint.FillShape(gtx.Ops, th.Colors.Muted, footer.Op())
+		clipCard.Pop()
+	}
+
+	call.Add(gtx.Ops)
+
+	if variant.BorderWidth > 0 {
+		paint.FillShape(gtx.Ops, variant.Border, clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(variant.BorderWidth))),
+		}.Op())
+	}
+
+	return dims
+}
+
+// sectionPadding returns padding, or a uniform inset of fallback when
+// padding is unset.
+func sectionPadding(padding layout.Inset, fallback unit.Dp) layout.Inset {
+	if padding != (layout.Inset{}) {
+		return padding
+	}
+	return layout.UniformInset(fallback)
+}
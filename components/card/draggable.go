@@ -0,0 +1,279 @@
+package card
+
+import (
+	"image"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/transfer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+)
+
+// dragMIME is the transfer type shared by draggable cards and drag targets.
+const dragMIME = "application/x-gio-shadcn-card"
+
+// draggedOpacity is the opacity of a card while it follows the pointer.
+const draggedOpacity = 200.0 / 255.0
+
+// dragHandleWidth is the width of the grip strip shown when DragHandle is set.
+const dragHandleWidth = unit.Dp(24)
+
+// DraggableCard is a card that can be dragged onto a DragTarget. While
+// dragging, the card follows the pointer semi-transparently and a dashed
+// outline marks its original slot. Dropping it on a target calls OnDrop with
+// the target's ID.
+//
+// Example usage:.
+//
+//	task := card.NewDraggableCard("task-1", card.WithCardPadding(layout.UniformInset(12)))
+//	task.OnDrop = func(target string) { moveTask("task-1", target) }
+//	dims := task.Layout(gtx, th, content)
+type DraggableCard struct {
+	*Card
+
+	// Configuration
+	ID          string
+	DragHandle  bool
+	DragData    any
+	OnDragStart func()
+	OnDrop      func(target string)
+
+	// State
+	pressPos f32.Point
+	offset   f32.Point
+	dragging bool
+}
+
+// NewDraggableCard creates a draggable card with the given ID and card options.
+func NewDraggableCard(id string, options ...Option) *DraggableCard {
+	return &DraggableCard{
+		Card: NewCard(options...),
+		ID:   id,
+	}
+}
+
+// Dragging reports whether the card is being dragged.
+func (d *DraggableCard) Dragging() bool {
+	return d.dragging
+}
+
+// Layout renders the card with content, or its placeholder and a floating copy
+// while it is being dragged.
+func (d *DraggableCard) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	d.processEvents(gtx)
+
+	macro := op.Record(gtx.Ops)
+	dims := d.Card.Layout(gtx, th, content)
+	call := macro.Stop()
+
+	rect := image.Rectangle{Max: dims.Size}
+	if d.dragging {
+		utils.DrawDashedBorder(gtx, rect, th.Radius.RadiusLG, unit.Dp(1), th.Colors.Border, unit.Dp(6), unit.Dp(4))
+
+		// Draw the card above everything else, without blocking drop targets
+		floating := op.Record(gtx.Ops)
+		transform := op.Affine(f32.Affine2D{}.Offset(d.offset)).Push(gtx.Ops)
+		opacity := paint.PushOpacity(gtx.Ops, draggedOpacity)
+		pass := pointer.PassOp{}.Push(gtx.Ops)
+		call.Add(gtx.Ops)
+		pass.Pop()
+		opacity.Pop()
+		transform.Pop()
+		op.Defer(gtx.Ops, floating.Stop())
+	} else {
+		call.Add(gtx.Ops)
+	}
+
+	area := rect
+	if d.DragHandle {
+		area.Max.X = min(area.Max.X, gtx.Dp(dragHandleWidth))
+		if !d.dragging {
+			d.drawGrip(gtx, th, area)
+		}
+	}
+
+	defer clip.Rect(area).Push(gtx.Ops).Pop()
+	event.Op(gtx.Ops, d)
+	cursor := pointer.CursorGrab
+	if d.dragging {
+		cursor = pointer.CursorGrabbing
+	}
+	cursor.Add(gtx.Ops)
+
+	return dims
+}
+
+// processEvents tracks the pointer and answers drop requests with the card.
+func (d *DraggableCard) processEvents(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(
+			pointer.Filter{Target: d, Kinds: pointer.Press | pointer.Drag | pointer.Release | pointer.Cancel},
+			transfer.SourceFilter{Target: d, Type: dragMIME},
+		)
+		if !ok {
+			break
+		}
+
+		switch e := ev.(type) {
+		case pointer.Event:
+			switch e.Kind {
+			case pointer.Press:
+				d.pressPos = e.Position
+				d.offset = f32.Point{}
+			case pointer.Drag:
+				d.offset = e.Position.Sub(d.pressPos)
+			case pointer.Release, pointer.Cancel:
+				d.offset = f32.Point{}
+			}
+		case transfer.InitiateEvent:
+			d.dragging = true
+			if d.OnDragStart != nil {
+				d.OnDragStart()
+			}
+		case transfer.RequestEvent:
+			gtx.Execute(transfer.OfferCmd{
+				Tag:  d,
+				Type: e.Type,
+				Data: &dragPayload{Reader: strings.NewReader(d.ID), card: d},
+			})
+		case transfer.CancelEvent:
+			d.dragging = false
+			d.offset = f32.Point{}
+		}
+	}
+}
+
+// drawGrip draws a two-by-three grid of dots centered in area.
+func (d *DraggableCard) drawGrip(gtx layout.Context, th *theme.Theme, area image.Rectangle) {
+	dot := gtx.Dp(unit.Dp(3))
+	gap := gtx.Dp(unit.Dp(3))
+	width := 2*dot + gap
+	height := 3*dot + 2*gap
+	origin := area.Min.Add(image.Pt((area.Dx()-width)/2, (area.Dy()-height)/2))
+
+	for row := range 3 {
+		for col := range 2 {
+			pos := origin.Add(image.Pt(col*(dot+gap), row*(dot+gap)))
+			ellipse := clip.Ellipse{Min: pos, Max: pos.Add(image.Pt(dot, dot))}
+			paint.FillShape(gtx.Ops, th.Colors.MutedFg, ellipse.Op(gtx.Ops))
+		}
+	}
+}
+
+// dragPayload is the data offered on drop. Targets in this package unwrap it
+// to reach the dragged card; other targets read the card ID.
+type dragPayload struct {
+	*strings.Reader
+	card *DraggableCard
+}
+
+// Close implements io.Closer.
+func (p *dragPayload) Close() error {
+	return nil
+}
+
+// DragTarget is a drop zone for DraggableCard. While a card is dragged over
+// it, the target is outlined with the theme ring color. Dropping a card calls
+// its OnDrop with the target ID, then the target's OnReceive.
+//
+// Example usage:.
+//
+//	done := card.NewDragTarget("done")
+//	dims := done.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+//		return list.Layout(gtx, len(tasks), layoutTask)
+//	})
+type DragTarget struct {
+	// Configuration
+	ID        string
+	OnReceive func(card *DraggableCard)
+
+	// State
+	active  bool
+	hovered bool
+}
+
+// NewDragTarget creates a drop zone with the given ID.
+func NewDragTarget(id string) *DragTarget {
+	return &DragTarget{ID: id}
+}
+
+// Hovered reports whether a card is being dragged over the target.
+func (t *DragTarget) Hovered() bool {
+	return t.active && t.hovered
+}
+
+// Layout renders content as a drop zone.
+func (t *DragTarget) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	t.processEvents(gtx)
+
+	macro := op.Record(gtx.Ops)
+	dims := content(gtx)
+	call := macro.Stop()
+	rect := image.Rectangle{Max: dims.Size}
+
+	// The drop area encloses the content so it doesn't block the cards
+	area := clip.Rect(rect).Push(gtx.Ops)
+	event.Op(gtx.Ops, t)
+	call.Add(gtx.Ops)
+	area.Pop()
+
+	if t.Hovered() {
+		rr := clip.UniformRRect(rect, gtx.Dp(th.Radius.RadiusLG))
+		paint.FillShape(gtx.Ops, th.Colors.Ring, clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(2))),
+		}.Op())
+	}
+
+	return dims
+}
+
+// processEvents tracks dragged cards entering and leaving the target and
+// delivers dropped cards.
+func (t *DragTarget) processEvents(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(
+			pointer.Filter{Target: t, Kinds: pointer.Enter | pointer.Leave},
+			transfer.TargetFilter{Target: t, Type: dragMIME},
+		)
+		if !ok {
+			break
+		}
+
+		switch e := ev.(type) {
+		case pointer.Event:
+			t.hovered = e.Kind == pointer.Enter
+		case transfer.InitiateEvent:
+			t.active = true
+		case transfer.CancelEvent:
+			t.active = false
+		case transfer.DataEvent:
+			t.receive(e)
+		}
+	}
+}
+
+// receive notifies the dropped card and OnReceive.
+func (t *DragTarget) receive(e transfer.DataEvent) {
+	data := e.Open()
+	defer func() { _ = data.Close() }()
+
+	payload, ok := data.(*dragPayload)
+	if !ok {
+		return
+	}
+	if payload.card.OnDrop != nil {
+		payload.card.OnDrop(t.ID)
+	}
+	if t.OnReceive != nil {
+		t.OnReceive(payload.card)
+	}
+}
@@ -0,0 +1,172 @@
+package card
+
+import (
+	"image"
+	"slices"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+)
+
+// kanbanColumnMinHeight keeps empty columns large enough to drop cards on.
+const kanbanColumnMinHeight = unit.Dp(160)
+
+// KanbanColumn is a column of a KanbanBoard. Items are the card texts and
+// must be unique across the board.
+type KanbanColumn struct {
+	ID    string
+	Title string
+	Items []string
+}
+
+// KanbanBoard lays out columns of draggable cards. Dragging a card onto
+// another column moves it to the end of that column.
+//
+// Example usage:.
+//
+//	board := card.NewKanbanBoard([]card.KanbanColumn{
+//		{ID: "todo", Title: "To Do", Items: []string{"Write docs"}},
+//		{ID: "doing", Title: "In Progress"},
+//		{ID: "done", Title: "Done"},
+//	})
+//	dims := board.Layout(gtx, th)
+type KanbanBoard struct {
+	Columns []KanbanColumn
+	OnMove  func(item, from, to string)
+
+	cards   map[string]*DraggableCard
+	targets map[string]*DragTarget
+	moves   []kanbanMove
+}
+
+// kanbanMove is a drop waiting to be applied at the start of the next frame.
+type kanbanMove struct {
+	item, to string
+}
+
+// NewKanbanBoard creates a board with the given columns.
+func NewKanbanBoard(columns []KanbanColumn) *KanbanBoard {
+	return &KanbanBoard{
+		Columns: columns,
+		cards:   make(map[string]*DraggableCard),
+		targets: make(map[string]*DragTarget),
+	}
+}
+
+// Layout renders the columns side by side.
+func (k *KanbanBoard) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	// Drops arrive while columns are laid out; apply them before the next pass
+	if len(k.moves) > 0 {
+		for _, m := range k.moves {
+			k.move(m.item, m.to)
+		}
+		k.moves = nil
+		gtx.Execute(op.InvalidateCmd{})
+	}
+
+	children := make([]layout.FlexChild, 0, 2*len(k.Columns))
+	for idx := range k.Columns {
+		if idx > 0 {
+			children = append(children, layout.Rigid(layout.Spacer{Width: th.Spacing.Space4}.Layout))
+		}
+		column := &k.Columns[idx]
+		children = append(children, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return k.layoutColumn(gtx, th, column)
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+}
+
+// layoutColumn renders a column title and its cards inside a drop target.
+func (k *KanbanBoard) layoutColumn(gtx layout.Context, th *theme.Theme, column *KanbanColumn) layout.Dimensions {
+	target, ok := k.targets[column.ID]
+	if !ok {
+		target = NewDragTarget(column.ID)
+		k.targets[column.ID] = target
+	}
+
+	items := make([]layout.Widget, len(column.Items))
+	for idx, item := range column.Items {
+		items[idx] = func(gtx layout.Context) layout.Dimensions {
+			return k.layoutCard(gtx, th, item)
+		}
+	}
+
+	return target.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		gtx.Constraints.Min.Y = min(gtx.Dp(kanbanColumnMinHeight), gtx.Constraints.Max.Y)
+
+		return layout.Background{}.Layout(gtx,
+			func(gtx layout.Context) layout.Dimensions {
+				rr := clip.UniformRRect(image.Rectangle{Max: gtx.Constraints.Min}, gtx.Dp(th.Radius.RadiusLG))
+				paint.FillShape(gtx.Ops, th.Colors.Muted, rr.Op(gtx.Ops))
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			},
+			func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(th.Spacing.Space3).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							title := material.Label(material.NewTheme(), th.Typography.FontSizeSM, column.Title)
+							title.Color = th.Colors.Foreground
+							title.Font.Weight = font.SemiBold
+							return title.Layout(gtx)
+						}),
+						layout.Rigid(layout.Spacer{Height: th.Spacing.Space3}.Layout),
+						utils.FlexColumn(th.Spacing.Space2, items...),
+					)
+				})
+			},
+		)
+	})
+}
+
+// layoutCard renders the draggable card for item.
+func (k *KanbanBoard) layoutCard(gtx layout.Context, th *theme.Theme, item string) layout.Dimensions {
+	c, ok := k.cards[item]
+	if !ok {
+		c = NewDraggableCard(item,
+			WithCardPadding(layout.UniformInset(th.Spacing.Space3)),
+			WithShadow(ShadowSM),
+		)
+		c.OnDrop = func(target string) {
+			k.moves = append(k.moves, kanbanMove{item: item, to: target})
+		}
+		k.cards[item] = c
+	}
+
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return c.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, item)
+		lbl.Color = th.Colors.CardFg
+		return lbl.Layout(gtx)
+	})
+}
+
+// move moves item to the end of the column with ID to.
+func (k *KanbanBoard) move(item, to string) {
+	from := -1
+	for idx, column := range k.Columns {
+		if slices.Contains(column.Items, item) {
+			from = idx
+		}
+	}
+	dest := slices.IndexFunc(k.Columns, func(c KanbanColumn) bool { return c.ID == to })
+	if from < 0 || dest < 0 || from == dest {
+		return
+	}
+
+	k.Columns[from].Items = slices.DeleteFunc(k.Columns[from].Items, func(s string) bool { return s == item })
+	k.Columns[dest].Items = append(k.Columns[dest].Items, item)
+
+	if k.OnMove != nil {
+		k.OnMove(item, k.Columns[from].ID, to)
+	}
+}
@@ -0,0 +1,78 @@
+package card
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/skeleton"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// loadingContent wraps content so that it is replaced by skeletons while the
+// card is loading. When loading ends the skeletons fade out over the content
+// as it fades in, using the card's Transition timing.
+func (c *Card) loadingContent(th *theme.Theme, content layout.Widget) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		if c.Loading {
+			c.skeletonFade.Set(1)
+			return c.layoutSkeletons(gtx, th)
+		}
+
+		c.skeletonFade.Configure(c.Transition)
+		c.skeletonFade.Animate(0)
+		if th.ReducedMotion() {
+			c.skeletonFade.Set(0)
+		}
+		fade := c.skeletonFade.Value(gtx)
+		if fade <= 0 {
+			return content(gtx)
+		}
+
+		opacity := paint.PushOpacity(gtx.Ops, 1-fade)
+		dims := content(gtx)
+		opacity.Pop()
+
+		// Skeletons are drawn over the content, within its bounds
+		area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+		opacity = paint.PushOpacity(gtx.Ops, fade)
+		gtx.Constraints = layout.Constraints{Max: dims.Size}
+		c.layoutSkeletons(gtx, th)
+		opacity.Pop()
+		area.Pop()
+
+		return dims
+	}
+}
+
+// layoutSkeletons renders the placeholder stack of a loading card: a short
+// title line, two full-width body lines and a narrower subtitle line.
+func (c *Card) layoutSkeletons(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	titleHeight := unit.Dp(float32(th.Typography.FontSize2XL) * 1.3)
+	bodyHeight := unit.Dp(float32(th.Typography.FontSizeBase) * 1.4)
+
+	skeletons := []struct {
+		s   *skeleton.Skeleton
+		gap unit.Dp
+	}{
+		{skeleton.NewSkeleton(titleHeight, skeleton.WithWidthRatio(0.6)), 0},
+		{skeleton.NewSkeleton(bodyHeight), th.Spacing.Space4},
+		{skeleton.NewSkeleton(bodyHeight), th.Spacing.Space2},
+		{skeleton.NewSkeleton(bodyHeight, skeleton.WithWidthRatio(0.4)), th.Spacing.Space2},
+	}
+
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	children := make([]layout.FlexChild, 0, len(skeletons))
+	for _, item := range skeletons {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: item.gap}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min = image.Point{}
+				return item.s.Layout(gtx, th)
+			})
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
@@ -0,0 +1,108 @@
+package card
+
+import (
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// DefaultMaxLabelWidth is the label column width used when MaxLabelWidth is zero.
+const DefaultMaxLabelWidth = unit.Dp(160)
+
+// PropertyRow is a single key:value row of a PropertyTable.
+type PropertyRow struct {
+	Label string
+	Value layout.Widget
+}
+
+// PropertyTable renders a two-column key:value table, as used in details
+// panels. Labels use muted text in a fixed-width left column, values fill the
+// remaining width, and every other row has a muted background.
+//
+// Example usage:.
+//
+//	props := card.NewPropertyTable()
+//	props.AddRow("Status", statusBadge.Layout)
+//	props.AddRow("Created", createdLabel.Layout)
+//	dims := props.Layout(gtx, th)
+type PropertyTable struct {
+	// State
+	list layout.List
+
+	// Configuration
+	Rows          []PropertyRow
+	MaxLabelWidth unit.Dp
+}
+
+// NewPropertyTable creates a property table with the given rows.
+func NewPropertyTable(rows ...PropertyRow) *PropertyTable {
+	return &PropertyTable{
+		list:          layout.List{Axis: layout.Vertical},
+		Rows:          rows,
+		MaxLabelWidth: DefaultMaxLabelWidth,
+	}
+}
+
+// AddRow appends a row with a text label and a value widget.
+func (t *PropertyTable) AddRow(text string, value layout.Widget) {
+	t.Rows = append(t.Rows, PropertyRow{Label: text, Value: value})
+}
+
+// Layout renders the property table.
+func (t *PropertyTable) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	return t.list.Layout(gtx, len(t.Rows), func(gtx layout.Context, index int) layout.Dimensions {
+		return t.layoutRow(gtx, th, index)
+	})
+}
+
+// layoutRow renders a single row, with a muted background on odd rows.
+func (t *PropertyTable) layoutRow(gtx layout.Context, th *theme.Theme, index int) layout.Dimensions {
+	row := t.Rows[index]
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	labelWidth := t.MaxLabelWidth
+	if labelWidth <= 0 {
+		labelWidth = DefaultMaxLabelWidth
+	}
+
+	background := func(gtx layout.Context) layout.Dimensions {
+		if index%2 == 1 {
+			rect := clip.Rect{Max: gtx.Constraints.Min}
+			paint.FillShape(gtx.Ops, th.Colors.Muted, rect.Op())
+		}
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+
+	return layout.Background{}.Layout(gtx, background, func(gtx layout.Context) layout.Dimensions {
+		inset := layout.Inset{
+			Top:    th.Spacing.Space2,
+			Bottom: th.Spacing.Space2,
+			Left:   th.Spacing.Space3,
+			Right:  th.Spacing.Space3,
+		}
+		return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					width := gtx.Dp(labelWidth)
+					if width > gtx.Constraints.Max.X {
+						width = gtx.Constraints.Max.X
+					}
+					gtx.Constraints.Min.X = width
+					gtx.Constraints.Max.X = width
+					dims := label.NewTypography(row.Label, label.Muted, "").Layout(gtx, th)
+					dims.Size.X = width
+					return dims
+				}),
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					if row.Value == nil {
+						return layout.Dimensions{}
+					}
+					return row.Value(gtx)
+				}),
+			)
+		})
+	})
+}
@@ -0,0 +1,105 @@
+package charts
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// BarDataPoint is one bar of a BarChart. A zero Color uses the theme's
+// primary color.
+type BarDataPoint struct {
+	Label string
+	Value float64
+	Color color.NRGBA
+}
+
+// BarChart renders vertical bars in a row, with the label of each bar below
+// it. Bar heights are proportional to Value/MaxValue.
+//
+// Example usage:.
+//
+//	chart := charts.NewBarChart(points, charts.WithGrid(true))
+//	chart.MaxValue = 100
+//	dims := chart.Layout(gtx, th)
+type BarChart struct {
+	ChartOptions
+
+	Data []BarDataPoint
+	// MaxValue is the top of the Y axis. Zero uses the largest value.
+	MaxValue float64
+	// BarWidth is the maximum bar width; bars shrink to fit narrow charts.
+	BarWidth unit.Dp
+	// Gap is the minimum space between bars.
+	Gap unit.Dp
+}
+
+// NewBarChart creates a bar chart for data.
+func NewBarChart(data []BarDataPoint, options ...Option) *BarChart {
+	return &BarChart{
+		ChartOptions: defaultOptions(options),
+		Data:         data,
+		BarWidth:     unit.Dp(32),
+		Gap:          unit.Dp(8),
+	}
+}
+
+// Layout renders the chart across the available width.
+func (b *BarChart) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	maxValue := b.MaxValue
+	if maxValue <= 0 {
+		for _, point := range b.Data {
+			maxValue = max(maxValue, point.Value)
+		}
+	}
+
+	// Record the bar labels to reserve room for them below the plot
+	labels := make([]op.CallOp, len(b.Data))
+	sizes := make([]image.Point, len(b.Data))
+	labelHeight := 0
+	measure := gtx
+	measure.Constraints.Min = image.Point{}
+	for i, point := range b.Data {
+		macro := op.Record(gtx.Ops)
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeXS, point.Label)
+		lbl.Color = th.Colors.MutedFg
+		sizes[i] = lbl.Layout(measure).Size
+		labels[i] = macro.Stop()
+		labelHeight = max(labelHeight, sizes[i].Y)
+	}
+	bottom := labelHeight + gtx.Dp(axisGap)
+
+	return b.layoutFrame(gtx, th, 0, maxValue, bottom, func(axis yAxis) {
+		if len(b.Data) == 0 {
+			return
+		}
+		slot := axis.plot.Dx() / len(b.Data)
+		width := max(min(gtx.Dp(b.BarWidth), slot-gtx.Dp(b.Gap)), 1)
+		radius := min(gtx.Dp(th.Radius.RadiusSM), width/2)
+		base := axis.y(0)
+
+		for i, point := range b.Data {
+			center := axis.plot.Min.X + slot*i + slot/2
+			top := axis.y(min(max(point.Value, 0), axis.hi))
+			bar := image.Rect(center-width/2, top, center-width/2+width, base)
+
+			c := point.Color
+			if c == (color.NRGBA{}) {
+				c = th.Colors.Primary
+			}
+			rr := clip.RRect{Rect: bar, NW: radius, NE: radius}
+			paint.FillShape(gtx.Ops, c, rr.Op(gtx.Ops))
+
+			offset := op.Offset(image.Pt(center-sizes[i].X/2, axis.plot.Max.Y+gtx.Dp(axisGap))).Push(gtx.Ops)
+			labels[i].Add(gtx.Ops)
+			offset.Pop()
+		}
+	})
+}
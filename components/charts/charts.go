@@ -0,0 +1,201 @@
+/*
+Package charts provides minimal bar and line charts for gio-shadcn dashboards.
+
+Both charts draw a Y axis with labeled tick marks at rounded values and can
+show faint horizontal grid lines. They are meant for small dashboard cards,
+not for interactive data exploration.
+
+# Quick Start
+
+Create a bar chart:
+
+	sales := charts.NewBarChart([]charts.BarDataPoint{
+		{Label: "Jan", Value: 186},
+		{Label: "Feb", Value: 305},
+		{Label: "Mar", Value: 237},
+	}, charts.WithGrid(true))
+
+Create a line chart:
+
+	visitors := charts.NewLineChart([]charts.LineSeries{
+		{Points: []image.Point{{0, 120}, {1, 180}, {2, 150}, {3, 240}}},
+	})
+
+Use in layout:
+
+	dims := sales.Layout(gtx, th)
+
+# Features
+
+• Vertical bar charts with a label below each bar
+• Line charts with any number of series
+• Y axis with tick labels at 1, 2 and 5 multiples
+• Optional horizontal grid lines
+• Theme primary color for points and series without a color
+*/
+package charts
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Axis geometry.
+const (
+	tickLength = unit.Dp(4)
+	axisGap    = unit.Dp(6)
+)
+
+// ChartOptions holds the settings shared by all charts.
+type ChartOptions struct {
+	// ShowGrid draws a horizontal line across the plot at every tick.
+	ShowGrid bool
+	// Ticks is the number of intervals on the Y axis.
+	Ticks int
+	// Height is the total chart height, including axis labels.
+	Height unit.Dp
+}
+
+// Option is a functional option shared by BarChart and LineChart.
+type Option func(*ChartOptions)
+
+// WithGrid sets whether horizontal grid lines are drawn.
+func WithGrid(grid bool) Option {
+	return func(o *ChartOptions) {
+		o.ShowGrid = grid
+	}
+}
+
+// WithTicks sets the number of intervals on the Y axis.
+func WithTicks(ticks int) Option {
+	return func(o *ChartOptions) {
+		o.Ticks = ticks
+	}
+}
+
+// WithHeight sets the total chart height.
+func WithHeight(height unit.Dp) Option {
+	return func(o *ChartOptions) {
+		o.Height = height
+	}
+}
+
+// defaultOptions returns the default chart options with options applied.
+func defaultOptions(options []Option) ChartOptions {
+	o := ChartOptions{
+		Ticks:  4,
+		Height: unit.Dp(200),
+	}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// yAxis maps values to vertical pixel positions in a plot area.
+type yAxis struct {
+	lo, hi float64
+	plot   image.Rectangle
+}
+
+// y returns the vertical position of v.
+func (a yAxis) y(v float64) int {
+	if a.hi == a.lo {
+		return a.plot.Max.Y
+	}
+	return a.plot.Max.Y - int(math.Round((v-a.lo)/(a.hi-a.lo)*float64(a.plot.Dy())))
+}
+
+// layoutFrame draws the Y axis, its tick labels and the grid for values
+// between lo and hi, leaving bottom pixels free below the plot, then calls
+// draw with the plot area.
+func (o *ChartOptions) layoutFrame(gtx layout.Context, th *theme.Theme, lo, hi float64, bottom int, draw func(axis yAxis)) layout.Dimensions {
+	// Round the range outwards to whole steps
+	step := niceStep((hi - lo) / float64(max(o.Ticks, 1)))
+	lo = math.Floor(lo/step) * step
+	ticks := max(int(math.Ceil((hi-lo)/step-1e-9)), 1)
+	hi = lo + step*float64(ticks)
+
+	// Measure the tick labels to size the axis
+	labels := make([]op.CallOp, ticks+1)
+	sizes := make([]image.Point, ticks+1)
+	labelWidth := 0
+	measure := gtx
+	measure.Constraints.Min = image.Point{}
+	for i := range labels {
+		macro := op.Record(gtx.Ops)
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeXS, formatTick(lo+step*float64(i), step))
+		lbl.Color = th.Colors.MutedFg
+		sizes[i] = lbl.Layout(measure).Size
+		labels[i] = macro.Stop()
+		labelWidth = max(labelWidth, sizes[i].X)
+	}
+
+	size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(o.Height))
+	half := sizes[0].Y / 2
+	axis := yAxis{
+		lo:   lo,
+		hi:   hi,
+		plot: image.Rect(labelWidth+gtx.Dp(axisGap)+gtx.Dp(tickLength), half, size.X, size.Y-max(bottom, half)),
+	}
+
+	line := gtx.Dp(unit.Dp(1))
+	for i, call := range labels {
+		y := axis.y(lo + step*float64(i))
+
+		offset := op.Offset(image.Pt(labelWidth-sizes[i].X, y-sizes[i].Y/2)).Push(gtx.Ops)
+		call.Add(gtx.Ops)
+		offset.Pop()
+
+		tick := clip.Rect{Min: image.Pt(axis.plot.Min.X-gtx.Dp(tickLength), y), Max: image.Pt(axis.plot.Min.X, y+line)}
+		paint.FillShape(gtx.Ops, th.Colors.Border, tick.Op())
+		if o.ShowGrid && i > 0 {
+			grid := clip.Rect{Min: image.Pt(axis.plot.Min.X, y), Max: image.Pt(axis.plot.Max.X, y+line)}
+			paint.FillShape(gtx.Ops, th.Colors.Border, grid.Op())
+		}
+	}
+
+	// Axis lines, with the baseline at zero when the range includes it
+	base := axis.y(math.Min(math.Max(lo, 0), hi))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect{
+		Min: axis.plot.Min,
+		Max: image.Pt(axis.plot.Min.X+line, axis.plot.Max.Y),
+	}.Op())
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect{
+		Min: image.Pt(axis.plot.Min.X, base),
+		Max: image.Pt(axis.plot.Max.X, base+line),
+	}.Op())
+
+	draw(axis)
+
+	return layout.Dimensions{Size: size}
+}
+
+// niceStep rounds a raw tick interval up to 1, 2 or 5 times a power of ten.
+func niceStep(raw float64) float64 {
+	if raw <= 0 || math.IsNaN(raw) || math.IsInf(raw, 0) {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if step := m * magnitude; step >= raw {
+			return step
+		}
+	}
+	return 10 * magnitude
+}
+
+// formatTick formats a tick value with as many decimals as the step needs.
+func formatTick(v, step float64) string {
+	decimals := max(0, int(-math.Floor(math.Log10(step))))
+	return fmt.Sprintf("%.*f", decimals, v)
+}
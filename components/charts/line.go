@@ -0,0 +1,101 @@
+package charts
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// LineSeries is one line of a LineChart. Points are data coordinates: X runs
+// along the horizontal axis and Y is the plotted value. A zero Color uses the
+// theme's primary color and a zero Thickness draws a 2dp line.
+type LineSeries struct {
+	Points    []image.Point
+	Color     color.NRGBA
+	Thickness unit.Dp
+}
+
+// LineChart renders one polyline per series. The X axis spans the smallest to
+// the largest X of all points; the Y axis starts at zero unless a point is
+// negative.
+//
+// Example usage:.
+//
+//	chart := charts.NewLineChart([]charts.LineSeries{
+//		{Points: desktop, Color: th.Colors.Primary},
+//		{Points: mobile, Color: theme.PaletteBlue[theme.Shade400]},
+//	}, charts.WithGrid(true))
+//	dims := chart.Layout(gtx, th)
+type LineChart struct {
+	ChartOptions
+
+	Series []LineSeries
+}
+
+// NewLineChart creates a line chart for series.
+func NewLineChart(series []LineSeries, options ...Option) *LineChart {
+	return &LineChart{
+		ChartOptions: defaultOptions(options),
+		Series:       series,
+	}
+}
+
+// Layout renders the chart across the available width.
+func (l *LineChart) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	minX, maxX := math.MaxInt, math.MinInt
+	minY, maxY := 0, 0
+	for _, series := range l.Series {
+		for _, p := range series.Points {
+			minX, maxX = min(minX, p.X), max(maxX, p.X)
+			minY, maxY = min(minY, p.Y), max(maxY, p.Y)
+		}
+	}
+
+	return l.layoutFrame(gtx, th, float64(minY), float64(maxY), 0, func(axis yAxis) {
+		if minX > maxX {
+			return
+		}
+		x := func(v int) float32 {
+			if maxX == minX {
+				return float32(axis.plot.Min.X + axis.plot.Dx()/2)
+			}
+			return float32(axis.plot.Min.X) + float32(v-minX)/float32(maxX-minX)*float32(axis.plot.Dx())
+		}
+
+		for _, series := range l.Series {
+			if len(series.Points) < 2 {
+				continue
+			}
+			c := series.Color
+			if c == (color.NRGBA{}) {
+				c = th.Colors.Primary
+			}
+			thickness := series.Thickness
+			if thickness <= 0 {
+				thickness = unit.Dp(2)
+			}
+
+			var path clip.Path
+			path.Begin(gtx.Ops)
+			for i, p := range series.Points {
+				pt := f32.Pt(x(p.X), float32(axis.y(float64(p.Y))))
+				if i == 0 {
+					path.MoveTo(pt)
+				} else {
+					path.LineTo(pt)
+				}
+			}
+			paint.FillShape(gtx.Ops, c, clip.Stroke{
+				Path:  path.End(),
+				Width: float32(gtx.Dp(thickness)),
+			}.Op())
+		}
+	})
+}
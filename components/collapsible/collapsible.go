@@ -0,0 +1,243 @@
+/*
+Package collapsible provides a trigger and content pair that shows and hides
+the content for gio-shadcn applications.
+
+A Collapsible is the building block behind disclosure widgets: clicking the
+trigger widget expands or collapses the content with an animated height
+transition. Unlike an accordion it has a single section and draws nothing of
+its own, so the trigger and content can look like anything.
+
+# Quick Start
+
+Create a collapsible:
+
+	details := collapsible.NewCollapsible(
+		collapsible.WithOnOpenChange(func(open bool) {
+			log.Println("details open:", open)
+		}),
+	)
+
+Use in layout:
+
+	dims := details.Layout(gtx, th, summaryRow, detailsList)
+
+# Features
+
+• Animated expand and collapse of the content
+• Open, Close, SetOpen and Toggle for control from code
+• Progress for animating trigger decorations such as a chevron
+• Disabled state that ignores clicks on the trigger
+• Keyboard toggling with Space or Enter on a focused trigger
+*/
+package collapsible
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// DefaultDuration is the length of the expand and collapse animation.
+const DefaultDuration = 200 * time.Millisecond
+
+// Collapsible represents a shadcn/ui collapsible.
+//
+// Example usage:.
+//
+//	c := collapsible.NewCollapsible(collapsible.WithOpen(true))
+//	dims := c.Layout(gtx, th, trigger, content)
+type Collapsible struct {
+	// State
+	trigger  widget.Clickable
+	expand   animation.Animator
+	open     bool
+	laidOut  bool
+	progress float32
+
+	// Configuration
+	Disabled bool
+
+	// Callbacks
+	OnOpenChange func(open bool)
+}
+
+// Option is a functional option for configuring Collapsible components.
+type Option func(*Collapsible)
+
+// WithOpen sets the initial open state.
+func WithOpen(open bool) Option {
+	return func(c *Collapsible) {
+		c.open = open
+	}
+}
+
+// WithDisabled sets the disabled state.
+func WithDisabled(disabled bool) Option {
+	return func(c *Collapsible) {
+		c.Disabled = disabled
+	}
+}
+
+// WithDuration sets the length of the expand and collapse animation.
+func WithDuration(duration time.Duration) Option {
+	return func(c *Collapsible) {
+		c.expand.Duration = duration
+	}
+}
+
+// WithOnOpenChange sets the callback called when the user opens or closes
+// the content.
+func WithOnOpenChange(onOpenChange func(open bool)) Option {
+	return func(c *Collapsible) {
+		c.OnOpenChange = onOpenChange
+	}
+}
+
+// NewCollapsible creates a new closed Collapsible with the given options.
+func NewCollapsible(options ...Option) *Collapsible {
+	c := &Collapsible{}
+	c.expand.Duration = DefaultDuration
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Open expands the content.
+func (c *Collapsible) Open() {
+	c.open = true
+}
+
+// Close collapses the content.
+func (c *Collapsible) Close() {
+	c.open = false
+}
+
+// SetOpen expands or collapses the content without calling OnOpenChange.
+func (c *Collapsible) SetOpen(open bool) {
+	c.open = open
+}
+
+// IsOpen returns true if the content is expanded or expanding.
+func (c *Collapsible) IsOpen() bool {
+	return c.open
+}
+
+// Toggle flips the open state, as a click on the trigger does, and calls
+// OnOpenChange.
+func (c *Collapsible) Toggle() {
+	c.open = !c.open
+	if c.OnOpenChange != nil {
+		c.OnOpenChange(c.open)
+	}
+}
+
+// Progress returns how far the content is expanded, from 0 when collapsed to
+// 1 when open. It follows the animation as of the last Layout.
+func (c *Collapsible) Progress() float32 {
+	return c.progress
+}
+
+// Layout renders trigger, which toggles the content when clicked, and the
+// content below it while open.
+func (c *Collapsible) Layout(gtx layout.Context, th *theme.Theme, trigger, content layout.Widget) layout.Dimensions {
+	for c.trigger.Clicked(gtx) {
+		if !c.Disabled {
+			c.Toggle()
+		}
+	}
+
+	// The content starts in place, then animates on later changes unless
+	// motion is reduced
+	target := float32(0)
+	if c.open {
+		target = 1
+	}
+	if !c.laidOut || th.ReducedMotion() {
+		c.laidOut = true
+		c.expand.Set(target)
+	} else if c.expand.Target() != target {
+		c.expand.Animate(target)
+	}
+	progress := c.expand.Value(gtx)
+	c.progress = progress
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return c.trigger.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				if !c.Disabled {
+					pointer.CursorPointer.Add(gtx.Ops)
+				}
+				return trigger(gtx)
+			})
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if progress <= 0 || content == nil {
+				return layout.Dimensions{}
+			}
+			return layoutContent(gtx, content, progress)
+		}),
+	)
+}
+
+// layoutContent renders content clipped to progress of its full height.
+func layoutContent(gtx layout.Context, content layout.Widget, progress float32) layout.Dimensions {
+	gtx.Constraints.Min.Y = 0
+
+	macro := op.Record(gtx.Ops)
+	dims := content(gtx)
+	call := macro.Stop()
+
+	size := image.Pt(dims.Size.X, int(float32(dims.Size.Y)*progress))
+	defer clip.Rect{Max: size}.Push(gtx.Ops).Pop()
+	call.Add(gtx.Ops)
+
+	return layout.Dimensions{Size: size}
+}
+
+// Update returns the component state for Collapsible.
+func (c *Collapsible) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:   c.open,
+		hovered:  c.trigger.Hovered(),
+		pressed:  c.trigger.Pressed(),
+		disabled: c.Disabled,
+	}
+}
+
+// State implements ComponentState for Collapsible.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the content is open.
+func (cs *State) IsActive() bool {
+	return cs.active
+}
+
+// IsHovered returns true if the trigger is being hovered over.
+func (cs *State) IsHovered() bool {
+	return cs.hovered
+}
+
+// IsPressed returns true if the trigger is being pressed.
+func (cs *State) IsPressed() bool {
+	return cs.pressed
+}
+
+// IsDisabled returns true if the collapsible is disabled.
+func (cs *State) IsDisabled() bool {
+	return cs.disabled
+}
@@ -0,0 +1,240 @@
+/*
+Package confetti provides a celebration animation for gio-shadcn applications.
+
+Confetti bursts colored paper pieces from the top of its area that tumble down
+under gravity, for success states such as a completed form or an uploaded
+file. It draws over whatever is below it and never blocks pointer input.
+
+# Quick Start
+
+Create the confetti and stack it over the content it celebrates:
+
+	party := confetti.NewConfetti(confetti.WithParticleCount(150))
+
+	layout.Stack{}.Layout(gtx,
+		layout.Stacked(content),
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return party.Layout(gtx, th)
+		}),
+	)
+
+Start it when the user succeeds:
+
+	party.Explode()
+
+# Features
+
+• Gravity, drift and spin for every particle
+• Theme-based colors by default, or a custom set
+• Fades out at the end of its duration
+• Draws nothing and requests no frames when inactive
+• Skipped entirely under reduced motion
+*/
+package confetti
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Physics in dp and seconds.
+const (
+	gravity        = 900
+	maxFrameTime   = 50 * time.Millisecond
+	particleWidth  = unit.Dp(8)
+	particleHeight = unit.Dp(4)
+	// fadeFraction is the share of Duration spent fading out.
+	fadeFraction = 0.25
+)
+
+// particle is one piece of confetti. Positions and velocities are in pixels.
+type particle struct {
+	x, y, vx, vy              float32
+	rotation, angularVelocity float32
+	fill                      color.NRGBA
+}
+
+// Confetti is a burst of falling confetti. Set Active, or call Explode, to
+// start it; it deactivates itself after Duration.
+//
+// Example usage:.
+//
+//	party := confetti.NewConfetti(confetti.WithDuration(2 * time.Second))
+//	party.Explode()
+//	dims := party.Layout(gtx, th)
+type Confetti struct {
+	// Configuration
+	Duration      time.Duration
+	ParticleCount int
+	// Colors of the particles. When empty, the theme's primary color and a
+	// few bright palette shades are used.
+	Colors []color.NRGBA
+	Active bool
+
+	// State
+	particles []particle
+	spawned   bool
+	start     time.Time
+	last      time.Time
+}
+
+// Option is a functional option for configuring Confetti components.
+type Option func(*Confetti)
+
+// WithDuration sets how long the animation runs.
+func WithDuration(duration time.Duration) Option {
+	return func(c *Confetti) {
+		c.Duration = duration
+	}
+}
+
+// WithParticleCount sets the number of particles per burst.
+func WithParticleCount(count int) Option {
+	return func(c *Confetti) {
+		c.ParticleCount = count
+	}
+}
+
+// WithColors sets the particle colors.
+func WithColors(colors ...color.NRGBA) Option {
+	return func(c *Confetti) {
+		c.Colors = colors
+	}
+}
+
+// NewConfetti creates an inactive Confetti with the given options.
+func NewConfetti(options ...Option) *Confetti {
+	c := &Confetti{
+		Duration:      3 * time.Second,
+		ParticleCount: 120,
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// Explode starts, or restarts, the animation with a new burst.
+func (c *Confetti) Explode() {
+	c.Active = true
+	c.spawned = false
+}
+
+// Layout advances and draws the particles across the available area. Under
+// reduced motion a burst ends at once, without drawing anything.
+func (c *Confetti) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	dims := layout.Dimensions{Size: gtx.Constraints.Min}
+	if !c.Active || th.ReducedMotion() {
+		c.Active = false
+		c.particles = nil
+		c.spawned = false
+		return dims
+	}
+
+	bounds := gtx.Constraints.Max
+	if !c.spawned {
+		c.spawn(gtx, th, bounds)
+	}
+
+	elapsed := gtx.Now.Sub(c.start)
+	if elapsed >= c.Duration {
+		c.Active = false
+		c.particles = nil
+		c.spawned = false
+		return dims
+	}
+
+	c.step(gtx, bounds)
+	c.draw(gtx, elapsed)
+	gtx.Execute(op.InvalidateCmd{})
+
+	return dims
+}
+
+// spawn creates a burst of particles just above the top of bounds.
+//
+//nolint:gosec // Confetti doesn't need cryptographic randomness
+func (c *Confetti) spawn(gtx layout.Context, th *theme.Theme, bounds image.Point) {
+	colors := c.Colors
+	if len(colors) == 0 {
+		colors = []color.NRGBA{
+			th.Colors.Primary,
+			theme.PaletteRose[theme.Shade500],
+			theme.PaletteAmber[theme.Shade400],
+			theme.PaletteEmerald[theme.Shade500],
+			theme.PaletteSky[theme.Shade500],
+			theme.PaletteViolet[theme.Shade500],
+		}
+	}
+
+	dp := gtx.Metric.PxPerDp
+	c.particles = make([]particle, c.ParticleCount)
+	for i := range c.particles {
+		c.particles[i] = particle{
+			x:               rand.Float32() * float32(bounds.X),
+			y:               -rand.Float32() * 40 * dp,
+			vx:              (rand.Float32() - 0.5) * 300 * dp,
+			vy:              (rand.Float32()*250 - 150) * dp,
+			rotation:        rand.Float32() * 2 * math.Pi,
+			angularVelocity: (rand.Float32() - 0.5) * 4 * math.Pi,
+			fill:            colors[rand.IntN(len(colors))],
+		}
+	}
+
+	c.spawned = true
+	c.start = gtx.Now
+	c.last = gtx.Now
+}
+
+// step advances the physics by the time since the last frame and removes the
+// particles that left the bottom of bounds.
+func (c *Confetti) step(gtx layout.Context, bounds image.Point) {
+	dt := min(gtx.Now.Sub(c.last), maxFrameTime).Seconds()
+	c.last = gtx.Now
+	seconds := float32(dt)
+	g := gravity * gtx.Metric.PxPerDp
+	bottom := float32(bounds.Y + gtx.Dp(particleWidth))
+
+	kept := c.particles[:0]
+	for _, p := range c.particles {
+		p.vy += g * seconds
+		p.y += p.vy * seconds
+		p.x += p.vx * seconds
+		p.rotation += p.angularVelocity * seconds
+		if p.y < bottom {
+			kept = append(kept, p)
+		}
+	}
+	c.particles = kept
+}
+
+// draw paints every particle as a small rotated rectangle, fading out over
+// the end of the animation.
+func (c *Confetti) draw(gtx layout.Context, elapsed time.Duration) {
+	progress := float32(elapsed) / float32(c.Duration)
+	opacity := min((1-progress)/fadeFraction, 1)
+	fade := paint.PushOpacity(gtx.Ops, opacity)
+	defer fade.Pop()
+
+	w, h := gtx.Dp(particleWidth), gtx.Dp(particleHeight)
+	rect := clip.Rect{Min: image.Pt(-w/2, -h/2), Max: image.Pt(w-w/2, h-h/2)}
+	for _, p := range c.particles {
+		transform := f32.Affine2D{}.Rotate(f32.Point{}, p.rotation).Offset(f32.Pt(p.x, p.y))
+		stack := op.Affine(transform).Push(gtx.Ops)
+		paint.FillShape(gtx.Ops, p.fill, rect.Op())
+		stack.Pop()
+	}
+}
@@ -0,0 +1,352 @@
+/*
+Package dialog provides a modal dialog component for gio-shadcn applications.
+
+A Dialog is a centered panel drawn above a dimmed backdrop. While open it
+closes on Escape, on a press of the backdrop or on its close button, and the
+content beneath it receives no input. Header, Title, Description and Footer
+lay out the usual dialog sections.
+
+Dialogs are drawn by a Host laid out once around the whole window content.
+Any code holding the host can open a dialog, no matter where in the layout
+tree it runs, and the host keeps keyboard focus inside the topmost dialog by
+disabling everything beneath it.
+
+# Quick Start
+
+Wrap the window content in a host:
+
+	host := dialog.NewHost()
+
+	dims := host.Layout(gtx, th, page)
+
+Open a dialog from anywhere, such as a button handler deep in the page:
+
+	confirm := dialog.NewDialog(
+		dialog.WithOnClose(func() { log.Println("closed") }),
+	)
+	host.Open(confirm, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return dialog.NewHeader("Delete project?",
+					"This action cannot be undone.").Layout(gtx, th)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return dialog.NewFooter(cancel.Layout, remove.Layout).Layout(gtx, th)
+			}),
+		)
+	})
+
+# Features
+
+• Dimmed backdrop and fade in and out
+• Closes on Escape, backdrop presses and the close button
+• Focus trapped inside the topmost dialog while it is open
+• Dialogs stacked above one another through a Host
+• Content taller than the window scrolls inside the panel
+• Header, Title, Description and Footer sections
+*/
+package dialog
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/animation"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Dialog geometry.
+const (
+	// DefaultWidth matches shadcn's max-w-lg.
+	DefaultWidth = unit.Dp(512)
+	// margin is the minimum gap between the panel and the window edges.
+	margin        = unit.Dp(16)
+	closeIconSize = unit.Dp(16)
+	fadeDuration  = 150 * time.Millisecond
+)
+
+// closeIcon is the close button icon. The icon data is constant, so NewIcon
+// cannot fail.
+var closeIcon, _ = widget.NewIcon(icons.NavigationClose)
+
+// Dialog represents a shadcn/ui modal dialog.
+//
+// Example usage:.
+//
+//	d := dialog.NewDialog(dialog.WithWidth(unit.Dp(425)))
+//	d.Open()
+//	dims := d.Layout(gtx, th, content)
+type Dialog struct {
+	// State
+	open     bool
+	fade     animation.Animator
+	backdrop int
+	close    widget.Clickable
+	list     layout.List
+
+	// Configuration
+	Width           unit.Dp
+	ShowCloseButton bool
+	CloseOnBackdrop bool
+	CloseOnEscape   bool
+
+	// Callbacks
+	OnClose func()
+}
+
+// Option is a functional option for configuring Dialog components.
+type Option func(*Dialog)
+
+// WithWidth sets the maximum panel width. Narrow windows shrink the panel.
+func WithWidth(width unit.Dp) Option {
+	return func(d *Dialog) {
+		d.Width = width
+	}
+}
+
+// WithCloseButton shows or hides the close button in the top-right corner.
+func WithCloseButton(show bool) Option {
+	return func(d *Dialog) {
+		d.ShowCloseButton = show
+	}
+}
+
+// WithCloseOnBackdrop sets whether pressing the backdrop closes the dialog.
+func WithCloseOnBackdrop(closeOnBackdrop bool) Option {
+	return func(d *Dialog) {
+		d.CloseOnBackdrop = closeOnBackdrop
+	}
+}
+
+// WithCloseOnEscape sets whether the Escape key closes the dialog.
+func WithCloseOnEscape(closeOnEscape bool) Option {
+	return func(d *Dialog) {
+		d.CloseOnEscape = closeOnEscape
+	}
+}
+
+// WithOnClose sets the handler called once the dialog has closed.
+func WithOnClose(onClose func()) Option {
+	return func(d *Dialog) {
+		d.OnClose = onClose
+	}
+}
+
+// NewDialog creates a new closed Dialog with the given options.
+func NewDialog(options ...Option) *Dialog {
+	d := &Dialog{
+		Width:           DefaultWidth,
+		ShowCloseButton: true,
+		CloseOnBackdrop: true,
+		CloseOnEscape:   true,
+	}
+	d.fade.Duration = fadeDuration
+	d.list.Axis = layout.Vertical
+
+	for _, option := range options {
+		option(d)
+	}
+
+	return d
+}
+
+// Open fades the dialog in.
+func (d *Dialog) Open() {
+	d.open = true
+	d.fade.Animate(1)
+}
+
+// Close fades the dialog out and calls OnClose once it is hidden.
+func (d *Dialog) Close() {
+	d.fade.Animate(0)
+}
+
+// IsOpen returns true while the dialog is shown, including while it fades
+// out.
+func (d *Dialog) IsOpen() bool {
+	return d.open
+}
+
+// Layout renders the backdrop over the available space and the panel
+// centered on it. It is usually called by a Host; when laid out directly,
+// stack it above the page so it covers the window.
+func (d *Dialog) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	area := gtx.Constraints.Max
+	if !d.open {
+		return layout.Dimensions{Size: area}
+	}
+
+	d.processEvents(gtx)
+
+	if th.ReducedMotion() {
+		d.fade.Set(d.fade.Target())
+	}
+	progress := d.fade.Value(gtx)
+	if progress <= 0 && !d.fade.Running() {
+		d.open = false
+		if d.OnClose != nil {
+			d.OnClose()
+		}
+		return layout.Dimensions{Size: area}
+	}
+
+	backdrop := clip.Rect{Max: area}.Push(gtx.Ops)
+	paint.ColorOp{Color: color.NRGBA{A: uint8(0x80 * progress)}}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	event.Op(gtx.Ops, &d.backdrop)
+	backdrop.Pop()
+
+	inset := gtx.Dp(margin)
+	width := min(gtx.Dp(d.Width), area.X-2*inset)
+	maxHeight := area.Y - 2*inset
+	if width <= 0 || maxHeight <= 0 {
+		return layout.Dimensions{Size: area}
+	}
+
+	// Record the panel to center it once its height is known
+	macro := op.Record(gtx.Ops)
+	pgtx := gtx
+	pgtx.Constraints = layout.Constraints{
+		Min: image.Pt(width, 0),
+		Max: image.Pt(width, maxHeight),
+	}
+	size := d.layoutPanel(pgtx, th, content)
+	call := macro.Stop()
+
+	offset := op.Offset(image.Pt((area.X-size.X)/2, (area.Y-size.Y)/2)).Push(gtx.Ops)
+	opacity := paint.PushOpacity(gtx.Ops, progress)
+	call.Add(gtx.Ops)
+	opacity.Pop()
+	offset.Pop()
+
+	return layout.Dimensions{Size: area}
+}
+
+// layoutPanel draws the panel background, the content and the close button,
+// and returns the panel size.
+func (d *Dialog) layoutPanel(gtx layout.Context, th *theme.Theme, content layout.Widget) image.Point {
+	// Content is laid out first to size the background drawn beneath it
+	macro := op.Record(gtx.Ops)
+	dims := d.list.Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
+		return layout.UniformInset(th.Spacing.Space6).Layout(gtx, content)
+	})
+	call := macro.Stop()
+	size := dims.Size
+
+	radius := gtx.Dp(th.Radius.RadiusLG)
+	panel := clip.UniformRRect(image.Rectangle{Max: size}, radius)
+	defer panel.Push(gtx.Ops).Pop()
+
+	paint.Fill(gtx.Ops, th.Colors.Background)
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  panel.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	// Swallow pointer input over the panel so the backdrop doesn't see it
+	event.Op(gtx.Ops, d)
+
+	call.Add(gtx.Ops)
+
+	if d.ShowCloseButton {
+		d.layoutCloseButton(gtx, th, size.X)
+	}
+
+	return size
+}
+
+// layoutCloseButton draws the close icon in the top-right corner, within the
+// panel padding.
+func (d *Dialog) layoutCloseButton(gtx layout.Context, th *theme.Theme, width int) {
+	iconSize := gtx.Dp(closeIconSize)
+	corner := gtx.Dp(th.Spacing.Space4)
+
+	offset := op.Offset(image.Pt(width-corner-iconSize, corner)).Push(gtx.Ops)
+	defer offset.Pop()
+
+	gtx.Constraints = layout.Exact(image.Pt(iconSize, iconSize))
+	d.close.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		pointer.CursorPointer.Add(gtx.Ops)
+		iconColor := utils.ApplyOpacity(th.Colors.Foreground, 0.7)
+		if d.close.Hovered() || gtx.Focused(&d.close) {
+			iconColor = th.Colors.Foreground
+		}
+		return closeIcon.Layout(gtx, iconColor)
+	})
+}
+
+// processEvents handles the close button, backdrop presses and Escape.
+func (d *Dialog) processEvents(gtx layout.Context) {
+	if d.close.Clicked(gtx) {
+		d.Close()
+	}
+
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: &d.backdrop, Kinds: pointer.Press})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok && e.Kind == pointer.Press && d.CloseOnBackdrop {
+			d.Close()
+		}
+	}
+
+	for {
+		ev, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok && e.State == key.Press && d.CloseOnEscape {
+			d.Close()
+		}
+	}
+}
+
+// Update returns the component state for Dialog.
+func (d *Dialog) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:  d.open,
+		hovered: d.close.Hovered(),
+		pressed: d.close.Pressed(),
+	}
+}
+
+// State implements ComponentState for Dialog.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the dialog is open.
+func (ds *State) IsActive() bool {
+	return ds.active
+}
+
+// IsHovered returns true if the close button is being hovered over.
+func (ds *State) IsHovered() bool {
+	return ds.hovered
+}
+
+// IsPressed returns true if the close button is being pressed.
+func (ds *State) IsPressed() bool {
+	return ds.pressed
+}
+
+// IsDisabled returns false; dialogs cannot be disabled.
+func (ds *State) IsDisabled() bool {
+	return ds.disabled
+}
@@ -0,0 +1,107 @@
+package dialog
+
+import (
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Host draws open dialogs above the window content. It is the portal that
+// lets code anywhere in the layout tree open a dialog: the dialog is drawn
+// where the host is laid out rather than where Open was called.
+//
+// Example usage:.
+//
+//	host := dialog.NewHost()
+//	host.Open(d, content) // from any event handler
+//	dims := host.Layout(gtx, th, page)
+type Host struct {
+	// State
+	entries []hostEntry
+	opened  bool
+}
+
+// hostEntry is a dialog shown by a Host with its content.
+type hostEntry struct {
+	dialog  *Dialog
+	content layout.Widget
+}
+
+// NewHost creates a new Host without dialogs.
+func NewHost() *Host {
+	return &Host{}
+}
+
+// Open opens d with content above the dialogs already shown. Opening a dialog
+// that is already shown replaces its content and leaves its place unchanged.
+func (h *Host) Open(d *Dialog, content layout.Widget) {
+	d.Open()
+	h.opened = true
+	for i := range h.entries {
+		if h.entries[i].dialog == d {
+			h.entries[i].content = content
+			return
+		}
+	}
+	h.entries = append(h.entries, hostEntry{dialog: d, content: content})
+}
+
+// CloseAll closes every dialog shown by the host.
+func (h *Host) CloseAll() {
+	for _, entry := range h.entries {
+		entry.dialog.Close()
+	}
+}
+
+// HasOpen returns true while any dialog is shown.
+func (h *Host) HasOpen() bool {
+	for _, entry := range h.entries {
+		if entry.dialog.IsOpen() {
+			return true
+		}
+	}
+	return false
+}
+
+// Layout renders page and the open dialogs above it. Everything beneath the
+// topmost dialog is laid out disabled, so it gets no input and Tab only
+// moves focus between the topmost dialog's widgets.
+func (h *Host) Layout(gtx layout.Context, th *theme.Theme, page layout.Widget) layout.Dimensions {
+	h.prune()
+
+	// Move focus off the page so the next Tab lands inside the new dialog
+	if h.opened {
+		h.opened = false
+		gtx.Execute(key.FocusCmd{})
+	}
+
+	pageGtx := gtx
+	if len(h.entries) > 0 {
+		pageGtx = gtx.Disabled()
+	}
+	dims := page(pageGtx)
+
+	area := gtx
+	area.Constraints = layout.Exact(gtx.Constraints.Max)
+	for i, entry := range h.entries {
+		dgtx := area
+		if i < len(h.entries)-1 {
+			dgtx = area.Disabled()
+		}
+		entry.dialog.Layout(dgtx, th, entry.content)
+	}
+
+	return dims
+}
+
+// prune forgets dialogs that have closed.
+func (h *Host) prune() {
+	open := h.entries[:0]
+	for _, entry := range h.entries {
+		if entry.dialog.IsOpen() {
+			open = append(open, entry)
+		}
+	}
+	clear(h.entries[len(open):])
+	h.entries = open
+}
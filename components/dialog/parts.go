@@ -0,0 +1,122 @@
+package dialog
+
+import (
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Header is the top section of a dialog with its title and description. It
+// leaves a gap below itself for the content that follows.
+//
+// Example usage:.
+//
+//	header := dialog.NewHeader("Edit profile", "Make changes to your profile here.")
+//	dims := header.Layout(gtx, th)
+type Header struct {
+	Title       *Title
+	Description *Description
+}
+
+// NewHeader creates a header with the given title and description. An empty
+// description is left out.
+func NewHeader(title, description string) *Header {
+	h := &Header{Title: NewTitle(title)}
+	if description != "" {
+		h.Description = NewDescription(description)
+	}
+	return h
+}
+
+// Layout renders the title above the description.
+func (h *Header) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	var children []layout.FlexChild
+	if h.Title != nil {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return h.Title.Layout(gtx, th)
+		}))
+	}
+	if h.Description != nil {
+		children = append(children,
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return h.Description.Layout(gtx, th)
+			}),
+		)
+	}
+	children = append(children, layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout))
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// Title is the heading of a dialog.
+type Title struct {
+	Text string
+}
+
+// NewTitle creates a new dialog title.
+func NewTitle(text string) *Title {
+	return &Title{Text: text}
+}
+
+// Layout renders the title in large semibold text.
+func (t *Title) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	lbl := material.Label(material.NewTheme(), th.Typography.FontSizeLG, t.Text)
+	lbl.Color = th.Colors.Foreground
+	lbl.Font.Weight = font.SemiBold
+	return lbl.Layout(gtx)
+}
+
+// Description is the muted text below a dialog title.
+type Description struct {
+	Text string
+}
+
+// NewDescription creates a new dialog description.
+func NewDescription(text string) *Description {
+	return &Description{Text: text}
+}
+
+// Layout renders the description in small muted text.
+func (d *Description) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, d.Text)
+	lbl.Color = th.Colors.MutedFg
+	return lbl.Layout(gtx)
+}
+
+// Footer is the bottom section of a dialog holding its actions, aligned to
+// the right with the primary action usually last. It leaves a gap above
+// itself for the content before it.
+//
+// Example usage:.
+//
+//	footer := dialog.NewFooter(cancel.Layout, save.Layout)
+//	dims := footer.Layout(gtx, th)
+type Footer struct {
+	Actions []layout.Widget
+}
+
+// NewFooter creates a footer with the given actions.
+func NewFooter(actions ...layout.Widget) *Footer {
+	return &Footer{Actions: actions}
+}
+
+// Layout renders the actions in a row at the end of the available width.
+func (f *Footer) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	var children []layout.FlexChild
+	for i, action := range f.Actions {
+		if i > 0 {
+			children = append(children, layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout))
+		}
+		children = append(children, layout.Rigid(action))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Flex{Alignment: layout.Middle, Spacing: layout.SpaceStart}.Layout(gtx, children...)
+		}),
+	)
+}
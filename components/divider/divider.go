@@ -0,0 +1,151 @@
+/*
+Package divider provides gradient divider lines for gio-shadcn applications.
+
+Dividers separate sections of content like a separator line, but fill the line
+with a linear gradient instead of a solid color. A fade divider, which fades in
+from transparent to the border color and back out, gives section breaks a
+softer look than a hard rule.
+
+# Quick Start
+
+Create a fade divider using the theme's border color:
+
+	d := divider.FadeDivider(th)
+	dims := d.Layout(gtx)
+
+Create a custom two-color divider:
+
+	d := divider.NewGradientDivider(startColor, endColor)
+
+# Features
+
+• Horizontal (left to right) and vertical (top to bottom) gradients
+• Two-stop gradients and three-stop gradients through MidColor
+• Configurable thickness
+*/
+package divider
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// GradientDivider represents a divider line filled with a linear gradient.
+// When MidColor is set, the gradient runs from StartColor to MidColor over the
+// first half and from MidColor to EndColor over the second half.
+type GradientDivider struct {
+	// Configuration
+	StartColor color.NRGBA
+	MidColor   color.NRGBA
+	EndColor   color.NRGBA
+	Direction  layout.Axis
+	Thickness  unit.Dp
+}
+
+// Option is a functional option for configuring GradientDivider components.
+type Option func(*GradientDivider)
+
+// WithDirection sets the divider axis. Horizontal dividers run left to right,
+// vertical dividers top to bottom.
+func WithDirection(direction layout.Axis) Option {
+	return func(d *GradientDivider) {
+		d.Direction = direction
+	}
+}
+
+// WithThickness sets the divider thickness.
+func WithThickness(thickness unit.Dp) Option {
+	return func(d *GradientDivider) {
+		d.Thickness = thickness
+	}
+}
+
+// WithMidColor sets the color at the middle of a three-stop gradient.
+func WithMidColor(mid color.NRGBA) Option {
+	return func(d *GradientDivider) {
+		d.MidColor = mid
+	}
+}
+
+// NewGradientDivider creates a horizontal divider fading from start to end.
+func NewGradientDivider(start, end color.NRGBA, options ...Option) *GradientDivider {
+	d := &GradientDivider{
+		StartColor: start,
+		EndColor:   end,
+		Direction:  layout.Horizontal,
+		Thickness:  unit.Dp(1),
+	}
+
+	for _, option := range options {
+		option(d)
+	}
+
+	return d
+}
+
+// FadeDivider creates a horizontal divider that fades from transparent to the
+// theme's border color and back to transparent.
+func FadeDivider(th *theme.Theme, options ...Option) *GradientDivider {
+	transparent := th.Colors.Border
+	transparent.A = 0
+
+	options = append([]Option{WithMidColor(th.Colors.Border)}, options...)
+	return NewGradientDivider(transparent, transparent, options...)
+}
+
+// Layout renders the divider across the available length.
+func (d *GradientDivider) Layout(gtx layout.Context) layout.Dimensions {
+	thickness := gtx.Dp(d.Thickness)
+
+	var size image.Point
+	if d.Direction == layout.Horizontal {
+		size = image.Pt(gtx.Constraints.Max.X, thickness)
+	} else {
+		size = image.Pt(thickness, gtx.Constraints.Max.Y)
+	}
+
+	if d.MidColor.A == 0 {
+		d.fill(gtx, image.Rectangle{Max: size}, d.StartColor, d.EndColor)
+		return layout.Dimensions{Size: size}
+	}
+
+	// Three stops: two adjacent two-stop gradients meeting in the middle
+	first, second := image.Rectangle{Max: size}, image.Rectangle{Max: size}
+	if d.Direction == layout.Horizontal {
+		first.Max.X = size.X / 2
+		second.Min.X = first.Max.X
+	} else {
+		first.Max.Y = size.Y / 2
+		second.Min.Y = first.Max.Y
+	}
+	d.fill(gtx, first, d.StartColor, d.MidColor)
+	d.fill(gtx, second, d.MidColor, d.EndColor)
+
+	return layout.Dimensions{Size: size}
+}
+
+// fill paints rect with a gradient from one color to another along the divider axis.
+func (d *GradientDivider) fill(gtx layout.Context, rect image.Rectangle, from, to color.NRGBA) {
+	stop1 := f32.Pt(float32(rect.Min.X), float32(rect.Min.Y))
+	stop2 := f32.Pt(float32(rect.Max.X), float32(rect.Min.Y))
+	if d.Direction == layout.Vertical {
+		stop2 = f32.Pt(float32(rect.Min.X), float32(rect.Max.Y))
+	}
+
+	area := clip.Rect(rect).Push(gtx.Ops)
+	paint.LinearGradientOp{
+		Stop1:  stop1,
+		Color1: from,
+		Stop2:  stop2,
+		Color2: to,
+	}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	area.Pop()
+}
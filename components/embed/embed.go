@@ -0,0 +1,173 @@
+/*
+Package embed renders gio-shadcn components into native windows owned by
+another toolkit, such as an existing desktop application that wants to show
+a gio-shadcn card in one of its panels.
+
+A GioView draws into the window named by a native handle: an HWND on
+Windows, an NSView pointer on macOS and an X11 window ID on Linux and the
+BSDs. Gio can only attach its GPU context to windows it creates, so frames
+are rendered with Gio's headless renderer and presented to the host window
+with the platform drawing API. Input events are not forwarded; embedded
+content is display only.
+
+Hosts that present frames themselves, for example into a texture, can use
+OffscreenView directly.
+
+# Quick Start
+
+Create a view for the host window:
+
+	view, err := embed.NewGioView(uintptr(hwnd), image.Pt(400, 300))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer view.Release()
+
+Render a card whenever the host window needs repainting:
+
+	c := card.NewCard()
+	err = view.Render(func(gtx layout.Context) layout.Dimensions {
+		return c.Layout(gtx, th, content)
+	})
+
+See cmd/embed-demo for a complete X11 host.
+
+# Features
+
+• Rendering into HWND, NSView and X11 window handles
+• Offscreen rendering of any layout.Widget at a fixed pixel size
+• Scale factor for high-density displays
+• Resize without recreating the view
+*/
+package embed
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"time"
+
+	"gioui.org/gpu/headless"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+var (
+	// ErrInvalidSize is returned for views without a positive width and height.
+	ErrInvalidSize = errors.New("embed: view size must be positive")
+
+	// ErrInvalidHandle is returned by NewGioView for a zero native handle.
+	ErrInvalidHandle = errors.New("embed: native handle must not be zero")
+
+	// ErrNotMainThread is returned by GioView.Render on macOS when called
+	// from a thread other than the main thread.
+	ErrNotMainThread = errors.New("embed: views must be rendered on the main thread")
+
+	// ErrUnsupported is returned by NewGioView on platforms without a native
+	// presenter.
+	ErrUnsupported = errors.New("embed: native windows are not supported on this platform")
+)
+
+// OffscreenView renders Gio frames into an image for the host application to
+// draw in its own window.
+//
+// Example usage:.
+//
+//	view, err := embed.NewOffscreenView(image.Pt(320, 200))
+//	if err != nil {
+//		return err
+//	}
+//	err = view.Render(widget)
+//	pixels := view.Frame()
+type OffscreenView struct {
+	// Scale is the number of pixels per dp, 1 by default.
+	Scale float32
+
+	// State
+	size   image.Point
+	window *headless.Window
+	ops    op.Ops
+	frame  *image.RGBA
+}
+
+// NewOffscreenView creates a view that renders frames of size pixels. It
+// fails when no GPU context is available.
+func NewOffscreenView(size image.Point) (*OffscreenView, error) {
+	v := &OffscreenView{
+		Scale: 1,
+	}
+	if err := v.Resize(size); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Size returns the frame size in pixels.
+func (v *OffscreenView) Size() image.Point {
+	return v.size
+}
+
+// Resize changes the frame size, such as after the host window was resized.
+func (v *OffscreenView) Resize(size image.Point) error {
+	if size.X <= 0 || size.Y <= 0 {
+		return ErrInvalidSize
+	}
+	if size == v.size && v.window != nil {
+		return nil
+	}
+
+	window, err := headless.NewWindow(size.X, size.Y)
+	if err != nil {
+		return fmt.Errorf("embed: failed to create renderer: %w", err)
+	}
+	v.Release()
+	v.window = window
+	v.size = size
+	v.frame = image.NewRGBA(image.Rectangle{Max: size})
+	return nil
+}
+
+// Render lays out w to fill the view and draws one frame, available from
+// Frame afterwards.
+func (v *OffscreenView) Render(w layout.Widget) error {
+	if v.window == nil {
+		return errors.New("embed: view is released")
+	}
+
+	scale := v.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+
+	v.ops.Reset()
+	gtx := layout.Context{
+		Ops:         &v.ops,
+		Now:         time.Now(),
+		Metric:      unit.Metric{PxPerDp: scale, PxPerSp: scale},
+		Constraints: layout.Exact(v.size),
+	}
+	w(gtx)
+
+	if err := v.window.Frame(&v.ops); err != nil {
+		return fmt.Errorf("embed: failed to render frame: %w", err)
+	}
+	if err := v.window.Screenshot(v.frame); err != nil {
+		return fmt.Errorf("embed: failed to read frame: %w", err)
+	}
+	return nil
+}
+
+// Frame returns the pixels of the last rendered frame. The image is reused
+// by the next Render, so copy it before rendering again.
+func (v *OffscreenView) Frame() *image.RGBA {
+	return v.frame
+}
+
+// Release frees the GPU resources of the view.
+func (v *OffscreenView) Release() {
+	if v.window != nil {
+		v.window.Release()
+		v.window = nil
+	}
+}
@@ -0,0 +1,88 @@
+//go:build darwin
+
+package embed
+
+/*
+#cgo CFLAGS: -x objective-c -fobjc-arc
+#cgo LDFLAGS: -framework AppKit -framework QuartzCore -framework CoreGraphics
+
+#include <stdint.h>
+#import <AppKit/AppKit.h>
+#import <QuartzCore/QuartzCore.h>
+
+enum { embedSetOK, embedSetNotView, embedSetNotMainThread, embedSetFailed };
+
+// embed_set_contents makes the view layer-backed and shows an RGBA frame
+// as the layer contents. AppKit views may only be used from the main thread.
+static int embed_set_contents(uintptr_t handle, const uint8_t *rgba, int width, int height) {
+	if (![NSThread isMainThread]) {
+		return embedSetNotMainThread;
+	}
+	NSView *view = (__bridge NSView *)(void *)handle;
+	if (![view isKindOfClass:[NSView class]]) {
+		return embedSetNotView;
+	}
+	CFDataRef data = CFDataCreate(NULL, rgba, (CFIndex)width * height * 4);
+	CGDataProviderRef provider = CGDataProviderCreateWithCFData(data);
+	CGColorSpaceRef space = CGColorSpaceCreateWithName(kCGColorSpaceSRGB);
+	CGImageRef image = CGImageCreate(width, height, 8, 32, width * 4, space,
+		kCGImageAlphaPremultipliedLast | kCGBitmapByteOrderDefault,
+		provider, NULL, false, kCGRenderingIntentDefault);
+	CGColorSpaceRelease(space);
+	CGDataProviderRelease(provider);
+	CFRelease(data);
+	if (image == NULL) {
+		return embedSetFailed;
+	}
+
+	view.wantsLayer = YES;
+	[CATransaction begin];
+	[CATransaction setDisableActions:YES];
+	view.layer.contentsGravity = kCAGravityTopLeft;
+	view.layer.contents = (__bridge id)image;
+	[CATransaction commit];
+	CGImageRelease(image);
+	return embedSetOK;
+}
+
+static void embed_clear_contents(uintptr_t handle) {
+	NSView *view = (__bridge NSView *)(void *)handle;
+	dispatch_async(dispatch_get_main_queue(), ^{
+		view.layer.contents = nil;
+	});
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"image"
+	"unsafe"
+)
+
+// cocoaPresenter shows frames as the contents of an NSView's backing layer.
+type cocoaPresenter struct {
+	view C.uintptr_t
+}
+
+func newPresenter(handle uintptr) (presenter, error) {
+	return &cocoaPresenter{view: C.uintptr_t(handle)}, nil
+}
+
+func (p *cocoaPresenter) present(frame *image.RGBA) error {
+	size := frame.Bounds().Size()
+	pix := (*C.uint8_t)(unsafe.Pointer(&frame.Pix[0]))
+	switch C.embed_set_contents(p.view, pix, C.int(size.X), C.int(size.Y)) {
+	case C.embedSetNotMainThread:
+		return ErrNotMainThread
+	case C.embedSetNotView:
+		return errors.New("embed: handle is not an NSView")
+	case C.embedSetFailed:
+		return errors.New("embed: failed to create frame image")
+	}
+	return nil
+}
+
+func (p *cocoaPresenter) release() {
+	C.embed_clear_contents(p.view)
+}
@@ -0,0 +1,7 @@
+//go:build !windows && !darwin && !((linux || freebsd || openbsd) && !nox11)
+
+package embed
+
+func newPresenter(handle uintptr) (presenter, error) {
+	return nil, ErrUnsupported
+}
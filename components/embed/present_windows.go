@@ -0,0 +1,91 @@
+//go:build windows
+
+package embed
+
+import (
+	"errors"
+	"image"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	user32           = syscall.NewLazyDLL("user32.dll")
+	gdi32            = syscall.NewLazyDLL("gdi32.dll")
+	procGetDC        = user32.NewProc("GetDC")
+	procReleaseDC    = user32.NewProc("ReleaseDC")
+	procSetDIBits    = gdi32.NewProc("SetDIBitsToDevice")
+	procIsWindow     = user32.NewProc("IsWindow")
+	errPresentFailed = errors.New("embed: failed to draw into window")
+)
+
+// bitmapInfo mirrors BITMAPINFOHEADER for 32-bit BI_RGB bitmaps.
+type bitmapInfo struct {
+	size          uint32
+	width         int32
+	height        int32
+	planes        uint16
+	bitCount      uint16
+	compression   uint32
+	sizeImage     uint32
+	xPelsPerMeter int32
+	yPelsPerMeter int32
+	clrUsed       uint32
+	clrImportant  uint32
+}
+
+// win32Presenter draws frames into an HWND with GDI.
+type win32Presenter struct {
+	hwnd uintptr
+	bgra []byte
+}
+
+func newPresenter(handle uintptr) (presenter, error) {
+	if ok, _, _ := procIsWindow.Call(handle); ok == 0 {
+		return nil, errors.New("embed: handle is not a window")
+	}
+	return &win32Presenter{hwnd: handle}, nil
+}
+
+func (p *win32Presenter) present(frame *image.RGBA) error {
+	size := frame.Bounds().Size()
+	if n := len(frame.Pix); cap(p.bgra) < n {
+		p.bgra = make([]byte, n)
+	} else {
+		p.bgra = p.bgra[:n]
+	}
+	for i := 0; i+3 < len(frame.Pix); i += 4 {
+		p.bgra[i+0] = frame.Pix[i+2]
+		p.bgra[i+1] = frame.Pix[i+1]
+		p.bgra[i+2] = frame.Pix[i+0]
+		p.bgra[i+3] = frame.Pix[i+3]
+	}
+
+	hdc, _, _ := procGetDC.Call(p.hwnd)
+	if hdc == 0 {
+		return errPresentFailed
+	}
+	defer procReleaseDC.Call(p.hwnd, hdc)
+
+	info := bitmapInfo{
+		width:    int32(size.X),
+		height:   -int32(size.Y), // top-down rows
+		planes:   1,
+		bitCount: 32,
+	}
+	info.size = uint32(unsafe.Sizeof(info))
+	lines, _, _ := procSetDIBits.Call(
+		hdc,
+		0, 0, uintptr(size.X), uintptr(size.Y),
+		0, 0, 0, uintptr(size.Y),
+		uintptr(unsafe.Pointer(&p.bgra[0])),
+		uintptr(unsafe.Pointer(&info)),
+		0, // DIB_RGB_COLORS
+	)
+	if lines == 0 {
+		return errPresentFailed
+	}
+	return nil
+}
+
+func (p *win32Presenter) release() {}
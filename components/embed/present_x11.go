@@ -0,0 +1,131 @@
+//go:build (linux || freebsd || openbsd) && !nox11
+
+package embed
+
+/*
+#cgo openbsd CFLAGS: -I/usr/X11R6/include -I/usr/local/include
+#cgo openbsd LDFLAGS: -L/usr/X11R6/lib -L/usr/local/lib
+#cgo freebsd CFLAGS: -I/usr/local/include
+#cgo freebsd LDFLAGS: -L/usr/local/lib
+#cgo LDFLAGS: -lX11
+
+#include <stdint.h>
+#include <stdlib.h>
+#include <X11/Xlib.h>
+#include <X11/Xutil.h>
+
+enum { embedPutOK, embedPutBadVisual, embedPutFailed };
+
+static int embed_error_code;
+
+static int embed_record_error(Display *dpy, XErrorEvent *ev) {
+	embed_error_code = ev->error_code;
+	return 0;
+}
+
+// embed_begin_checked replaces Xlib's default error handler, which exits
+// the process, so errors for a foreign or destroyed window are recorded
+// instead.
+static int (*embed_begin_checked(Display *dpy))(Display *, XErrorEvent *) {
+	XSync(dpy, False);
+	embed_error_code = Success;
+	return XSetErrorHandler(embed_record_error);
+}
+
+// embed_end_checked restores the previous handler and reports whether an
+// error was recorded since embed_begin_checked.
+static int embed_end_checked(Display *dpy, int (*prev)(Display *, XErrorEvent *)) {
+	XSync(dpy, False);
+	XSetErrorHandler(prev);
+	return embed_error_code == Success;
+}
+
+// embed_check_window reports whether win is an existing window.
+static int embed_check_window(Display *dpy, Window win) {
+	int (*prev)(Display *, XErrorEvent *) = embed_begin_checked(dpy);
+	XWindowAttributes attrs;
+	Status ok = XGetWindowAttributes(dpy, win, &attrs);
+	return embed_end_checked(dpy, prev) && ok != 0;
+}
+
+// embed_put_rgba converts an RGBA frame to the 32-bit BGRX layout of
+// TrueColor visuals and draws it at the window origin. It returns
+// embedPutBadVisual for unsupported displays and embedPutFailed when the
+// image could not be created or drawn, such as after the window was
+// destroyed.
+static int embed_put_rgba(Display *dpy, Window win, GC gc, const uint8_t *rgba, int width, int height) {
+	Visual *visual = DefaultVisual(dpy, DefaultScreen(dpy));
+	int depth = DefaultDepth(dpy, DefaultScreen(dpy));
+	if (visual->class != TrueColor || depth < 24) {
+		return embedPutBadVisual;
+	}
+	char *data = malloc((size_t)width * height * 4);
+	if (data == NULL) {
+		return embedPutFailed;
+	}
+	XImage *img = XCreateImage(dpy, visual, depth, ZPixmap, 0, data, width, height, 32, 0);
+	if (img == NULL) {
+		free(data);
+		return embedPutFailed;
+	}
+	for (int y = 0; y < height; y++) {
+		for (int x = 0; x < width; x++) {
+			const uint8_t *p = rgba + ((size_t)y * width + x) * 4;
+			XPutPixel(img, x, y, ((unsigned long)p[0] << 16) | ((unsigned long)p[1] << 8) | p[2]);
+		}
+	}
+	int (*prev)(Display *, XErrorEvent *) = embed_begin_checked(dpy);
+	XPutImage(dpy, win, gc, img, 0, 0, 0, 0, width, height);
+	XDestroyImage(img);
+	return embed_end_checked(dpy, prev) ? embedPutOK : embedPutFailed;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"image"
+	"unsafe"
+)
+
+// x11Presenter draws frames into an X11 window through its own display
+// connection, so the host's connection is never shared across threads.
+type x11Presenter struct {
+	display *C.Display
+	window  C.Window
+	gc      C.GC
+}
+
+func newPresenter(handle uintptr) (presenter, error) {
+	display := C.XOpenDisplay(nil)
+	if display == nil {
+		return nil, errors.New("embed: failed to open X11 display")
+	}
+	window := C.Window(handle)
+	if C.embed_check_window(display, window) == 0 {
+		C.XCloseDisplay(display)
+		return nil, errors.New("embed: handle is not an X11 window")
+	}
+	return &x11Presenter{
+		display: display,
+		window:  window,
+		gc:      C.XCreateGC(display, C.Drawable(window), 0, nil),
+	}, nil
+}
+
+func (p *x11Presenter) present(frame *image.RGBA) error {
+	size := frame.Bounds().Size()
+	pix := (*C.uint8_t)(unsafe.Pointer(&frame.Pix[0]))
+	switch C.embed_put_rgba(p.display, p.window, p.gc, pix, C.int(size.X), C.int(size.Y)) {
+	case C.embedPutBadVisual:
+		return errors.New("embed: X11 display needs a 24-bit TrueColor visual")
+	case C.embedPutFailed:
+		return errors.New("embed: failed to draw into X11 window")
+	}
+	return nil
+}
+
+func (p *x11Presenter) release() {
+	C.XFreeGC(p.display, p.gc)
+	C.XCloseDisplay(p.display)
+}
@@ -0,0 +1,99 @@
+package embed
+
+import (
+	"image"
+
+	"gioui.org/layout"
+)
+
+// GioView renders Gio frames into a native window owned by the host
+// application.
+//
+// Example usage:.
+//
+//	view, err := embed.NewGioView(uintptr(xid), image.Pt(320, 200))
+//	if err != nil {
+//		return err
+//	}
+//	defer view.Release()
+//	err = view.Render(widget)
+type GioView struct {
+	// State
+	handle    uintptr
+	offscreen *OffscreenView
+	presenter presenter
+}
+
+// presenter copies rendered frames into a native window. Each platform
+// provides newPresenter.
+type presenter interface {
+	present(frame *image.RGBA) error
+	release()
+}
+
+// NewGioView creates a view that draws into the native window identified by
+// nativeHandle: an HWND on Windows, an NSView pointer on macOS or an X11
+// window ID on Linux and the BSDs. Frames are size pixels large and drawn at
+// the top-left corner of the window.
+func NewGioView(nativeHandle uintptr, size image.Point) (*GioView, error) {
+	if nativeHandle == 0 {
+		return nil, ErrInvalidHandle
+	}
+
+	p, err := newPresenter(nativeHandle)
+	if err != nil {
+		return nil, err
+	}
+	offscreen, err := NewOffscreenView(size)
+	if err != nil {
+		p.release()
+		return nil, err
+	}
+
+	return &GioView{
+		handle:    nativeHandle,
+		offscreen: offscreen,
+		presenter: p,
+	}, nil
+}
+
+// Handle returns the native window handle the view draws into.
+func (v *GioView) Handle() uintptr {
+	return v.handle
+}
+
+// SetScale sets the number of pixels per dp, such as the host window's
+// content scale on high-density displays.
+func (v *GioView) SetScale(scale float32) {
+	v.offscreen.Scale = scale
+}
+
+// Size returns the frame size in pixels.
+func (v *GioView) Size() image.Point {
+	return v.offscreen.Size()
+}
+
+// Resize changes the frame size, typically after the host window was resized.
+func (v *GioView) Resize(size image.Point) error {
+	return v.offscreen.Resize(size)
+}
+
+// Render lays out fn to fill the view and draws one frame into the native
+// window. On macOS it must be called from the main thread and returns
+// ErrNotMainThread otherwise.
+func (v *GioView) Render(fn layout.Widget) error {
+	if err := v.offscreen.Render(fn); err != nil {
+		return err
+	}
+	return v.presenter.present(v.offscreen.Frame())
+}
+
+// Release frees the GPU and native resources of the view. The host window
+// itself is left untouched.
+func (v *GioView) Release() {
+	v.offscreen.Release()
+	if v.presenter != nil {
+		v.presenter.release()
+		v.presenter = nil
+	}
+}
@@ -0,0 +1,16 @@
+package embed
+
+import (
+	"errors"
+	"image"
+	"testing"
+)
+
+func TestNewGioViewRejectsInvalidArguments(t *testing.T) {
+	if _, err := NewGioView(0, image.Pt(100, 100)); !errors.Is(err, ErrInvalidHandle) {
+		t.Errorf("zero handle: got %v, want %v", err, ErrInvalidHandle)
+	}
+	if _, err := NewOffscreenView(image.Pt(0, 100)); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("empty size: got %v, want %v", err, ErrInvalidSize)
+	}
+}
@@ -0,0 +1,158 @@
+package form
+
+import (
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/input"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Field is a labeled input with a help or error message below it. The error
+// message is the input's ErrorMsg, shown while its Error flag is set, such as
+// after a failed StepForm validation or a call to SetError.
+//
+// Example usage:.
+//
+//	email := form.NewField("Email", input.Email("jane@example.com"),
+//		form.WithRequired(true),
+//		form.WithHelpText("We never share your email."),
+//	)
+//	dims := email.Layout(gtx, th)
+type Field struct {
+	// Configuration
+	Label    string
+	Input    *input.Input
+	Required bool
+	// HelpText is shown below the input when there is no error. When empty,
+	// the input's Helper is used.
+	HelpText string
+
+	// err is the error set by SetError, translated at layout
+	err error
+}
+
+// Option is a functional option for configuring Field components.
+type Option func(*Field)
+
+// WithRequired marks the field as required with an asterisk after the label.
+func WithRequired(required bool) Option {
+	return func(f *Field) {
+		f.Required = required
+	}
+}
+
+// WithHelpText sets the text shown below the input when there is no error.
+func WithHelpText(text string) Option {
+	return func(f *Field) {
+		f.HelpText = text
+	}
+}
+
+// NewField creates a Field for in with the given label and options. An input
+// without a label takes this one, so FieldName keys it by the field label.
+func NewField(text string, in *input.Input, options ...Option) *Field {
+	f := &Field{
+		Label: text,
+		Input: in,
+	}
+
+	for _, option := range options {
+		option(f)
+	}
+
+	if in != nil {
+		if in.Label == "" {
+			in.Label = text
+		}
+		in.Required = in.Required || f.Required
+	}
+
+	return f
+}
+
+// SetError marks the input with err, or clears its error when err is nil.
+// A *ValidationError is shown in the theme's locale.
+//
+// Example usage:.
+//
+//	email.SetError(form.Email()(email.Input.Text()))
+func (f *Field) SetError(err error) {
+	f.err = err
+	if f.Input == nil {
+		return
+	}
+	if err == nil {
+		f.Input.Error = false
+		f.Input.ErrorMsg = ""
+		return
+	}
+	f.Input.WithError(err.Error())
+}
+
+// Layout renders the label, the input and the error or help message, one
+// above the other.
+func (f *Field) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if f.err != nil && f.Input != nil && f.Input.Error {
+		f.Input.ErrorMsg = errorMessage(th.Locale, f.err)
+	}
+
+	var children []layout.FlexChild
+
+	if f.Label != "" {
+		text := f.Label
+		if f.Required {
+			text += " *"
+		}
+		children = append(children,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				title := label.NewTypography(text, label.Small, "")
+				title.TextStyle = th.Typography.BodySmall(&th.Colors)
+				title.TextStyle.Weight = font.Medium
+				return title.Layout(gtx, th)
+			}),
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+		)
+	}
+
+	if f.Input != nil {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return f.Input.Layout(gtx, th)
+		}))
+	}
+
+	if message := f.message(); message != "" {
+		children = append(children,
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if f.hasError() {
+					lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, message)
+					lbl.Color = th.Colors.Destructive
+					return lbl.Layout(gtx)
+				}
+				return label.NewTypography(message, label.Muted, "").Layout(gtx, th)
+			}),
+		)
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// hasError reports whether the input shows an error message.
+func (f *Field) hasError() bool {
+	return f.Input != nil && f.Input.Error && f.Input.ErrorMsg != ""
+}
+
+// message returns the error message, or the help text when there is no
+// error.
+func (f *Field) message() string {
+	if f.hasError() {
+		return f.Input.ErrorMsg
+	}
+	if f.HelpText != "" || f.Input == nil {
+		return f.HelpText
+	}
+	return f.Input.Helper
+}
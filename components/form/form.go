@@ -0,0 +1,125 @@
+/*
+Package form provides form helpers for gio-shadcn applications.
+
+# Quick Start
+
+Create a two-step wizard:
+
+	name := input.Text("Jane Doe").WithLabel("Name")
+	email := input.Email("jane@example.com").WithLabel("Email")
+
+	wizard := form.NewStepForm(
+		form.FormStep{
+			Title:  "Profile",
+			Fields: []*input.Input{name},
+			Validators: map[string][]form.ValidatorFunc{
+				"Name": {form.Required()},
+			},
+		},
+		form.FormStep{
+			Title:  "Contact",
+			Fields: []*input.Input{email},
+			Validators: map[string][]form.ValidatorFunc{
+				"Email": {form.Required(), form.Email()},
+			},
+		},
+	)
+	wizard.OnComplete = func(values map[string]string) { save(values) }
+
+Use in layout:
+
+	dims := wizard.Layout(gtx, th)
+
+Label a single input, with a help or error message below it:
+
+	field := form.NewField("Email", email, form.WithRequired(true))
+	dims := field.Layout(gtx, th)
+
+# Features
+
+• Multi-step forms validated one step at a time
+• Step progress indicator
+• Built-in validators (Required, MinLength, Email) with localized messages
+• Validation errors shown on the failing inputs
+• Field component composing a label, an input and a help or error message
+*/
+package form
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// ValidatorFunc validates a field value, returning an error describing the
+// problem or nil if the value is valid. Return a *ValidationError for messages
+// that should follow the theme's locale.
+type ValidatorFunc func(value string) error
+
+// ValidationError is a validation failure described by an i18n key, so it can
+// be shown in the theme's locale. Keys with a format take Args.
+type ValidationError struct {
+	Key  string
+	Args []any
+}
+
+// Error returns the English message.
+func (e *ValidationError) Error() string {
+	return e.Message(i18n.LocaleEN)
+}
+
+// Message returns the message translated by locale.
+func (e *ValidationError) Message(locale *i18n.Locale) string {
+	if len(e.Args) == 0 {
+		return locale.T(e.Key)
+	}
+	return fmt.Sprintf(locale.T(e.Key), e.Args...)
+}
+
+// errorMessage returns the message of err in locale, translating
+// ValidationErrors.
+func errorMessage(locale *i18n.Locale, err error) string {
+	var validation *ValidationError
+	if errors.As(err, &validation) {
+		return validation.Message(locale)
+	}
+	return err.Error()
+}
+
+// Required reports an error for empty or whitespace-only values.
+func Required() ValidatorFunc {
+	return func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return &ValidationError{Key: i18n.KeyRequired}
+		}
+		return nil
+	}
+}
+
+// MinLength reports an error for values shorter than n characters.
+func MinLength(n int) ValidatorFunc {
+	return func(value string) error {
+		if utf8.RuneCountInString(value) < n {
+			return &ValidationError{Key: i18n.KeyMinLength, Args: []any{n}}
+		}
+		return nil
+	}
+}
+
+// Email reports an error for non-empty values that are not email addresses.
+// Combine with Required to reject empty values.
+func Email() ValidatorFunc {
+	return func(value string) error {
+		if value == "" {
+			return nil
+		}
+		if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+			return &ValidationError{Key: i18n.KeyInvalidEmail}
+		}
+		return nil
+	}
+}
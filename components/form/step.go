@@ -0,0 +1,237 @@
+package form
+
+import (
+	"fmt"
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/components/input"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// FormStep is one page of a StepForm. Validators are keyed by field name, which
+// is the input's Label, or its Placeholder when it has no label.
+//
+//nolint:revive // FormStep reads better than Step next to StepForm
+type FormStep struct {
+	Title      string
+	Fields     []*input.Input
+	Validators map[string][]ValidatorFunc
+}
+
+// StepForm is a multi-step form that validates each step before advancing.
+// The last step shows a Submit button that validates it and calls OnComplete
+// with the values of every field, keyed by field name.
+//
+// Example usage:.
+//
+//	wizard := form.NewStepForm(profileStep, contactStep)
+//	wizard.OnComplete = func(values map[string]string) { save(values) }
+//	dims := wizard.Layout(gtx, th)
+type StepForm struct {
+	Steps       []FormStep
+	CurrentStep int
+	OnComplete  func(map[string]string)
+
+	previous *button.Button
+	next     *button.Button
+	submit   *button.Button
+	// errs holds the failed validation of each input, translated at layout
+	errs map[*input.Input]error
+}
+
+// NewStepForm creates a step form starting at the first step.
+func NewStepForm(steps ...FormStep) *StepForm {
+	f := &StepForm{Steps: steps}
+	f.previous = button.NewButton(
+		button.WithVariant(theme.VariantOutline),
+		button.WithOnClick(f.Previous),
+	)
+	f.next = button.NewButton(
+		button.WithOnClick(func() { f.Next() }),
+	)
+	f.submit = button.NewButton(
+		button.WithOnClick(func() { f.Submit() }),
+	)
+	return f
+}
+
+// FieldName returns the name used to key validators and values for field.
+func FieldName(field *input.Input) string {
+	if field.Label != "" {
+		return field.Label
+	}
+	return field.Placeholder
+}
+
+// ValidateStep validates the fields of the current step, marking failing
+// inputs with the first error message, and reports whether all passed.
+func (f *StepForm) ValidateStep() bool {
+	if f.CurrentStep < 0 || f.CurrentStep >= len(f.Steps) {
+		return false
+	}
+
+	if f.errs == nil {
+		f.errs = make(map[*input.Input]error)
+	}
+
+	step := f.Steps[f.CurrentStep]
+	valid := true
+	for _, field := range step.Fields {
+		field.Error = false
+		field.ErrorMsg = ""
+		delete(f.errs, field)
+		for _, validate := range step.Validators[FieldName(field)] {
+			if err := validate(field.Text()); err != nil {
+				field.WithError(err.Error())
+				f.errs[field] = err
+				valid = false
+				break
+			}
+		}
+	}
+	return valid
+}
+
+// Next validates the current step and advances to the next one if it passed.
+// It returns whether the step was valid.
+func (f *StepForm) Next() bool {
+	if !f.ValidateStep() {
+		return false
+	}
+	if f.CurrentStep < len(f.Steps)-1 {
+		f.CurrentStep++
+	}
+	return true
+}
+
+// Previous goes back one step without validation.
+func (f *StepForm) Previous() {
+	if f.CurrentStep > 0 {
+		f.CurrentStep--
+	}
+}
+
+// Submit validates the last step and calls OnComplete with all field values.
+// It returns whether the form was submitted.
+func (f *StepForm) Submit() bool {
+	if f.CurrentStep != len(f.Steps)-1 || !f.ValidateStep() {
+		return false
+	}
+	if f.OnComplete != nil {
+		f.OnComplete(f.Values())
+	}
+	return true
+}
+
+// Values returns the values of the fields of every step, keyed by field name.
+func (f *StepForm) Values() map[string]string {
+	values := make(map[string]string)
+	for _, step := range f.Steps {
+		for _, field := range step.Fields {
+			values[FieldName(field)] = field.Text()
+		}
+	}
+	return values
+}
+
+// Layout renders the step progress, the current step's fields and the
+// navigation buttons.
+func (f *StepForm) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if len(f.Steps) == 0 {
+		return layout.Dimensions{}
+	}
+	f.CurrentStep = max(0, min(f.CurrentStep, len(f.Steps)-1))
+	step := f.Steps[f.CurrentStep]
+
+	fields := make([]layout.Widget, len(step.Fields))
+	for idx, field := range step.Fields {
+		if err, ok := f.errs[field]; ok && field.Error {
+			field.ErrorMsg = errorMessage(th.Locale, err)
+		}
+		fields[idx] = func(gtx layout.Context) layout.Dimensions {
+			return field.Layout(gtx, th)
+		}
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return f.layoutProgress(gtx, th)
+		}),
+		layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+		utils.FlexColumn(th.Spacing.Space4, fields...),
+		layout.Rigid(layout.Spacer{Height: th.Spacing.Space6}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return f.layoutButtons(gtx, th)
+		}),
+	)
+}
+
+// layoutProgress renders the step counter, the step title and one bar per
+// step, filled up to the current step.
+func (f *StepForm) layoutProgress(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	counter := fmt.Sprintf(th.Locale.T(i18n.KeyStepOf), f.CurrentStep+1, len(f.Steps))
+
+	bars := make([]layout.FlexChild, 0, 2*len(f.Steps))
+	for idx := range f.Steps {
+		if idx > 0 {
+			bars = append(bars, layout.Rigid(layout.Spacer{Width: th.Spacing.Space1}.Layout))
+		}
+		c := th.Colors.Muted
+		if idx <= f.CurrentStep {
+			c = th.Colors.Primary
+		}
+		bars = append(bars, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(unit.Dp(4)))
+			rr := clip.UniformRRect(image.Rectangle{Max: size}, size.Y/2)
+			paint.FillShape(gtx.Ops, c, rr.Op(gtx.Ops))
+			return layout.Dimensions{Size: size}
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return label.NewTypography(counter, label.Muted, "").Layout(gtx, th)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return label.NewTypography(f.Steps[f.CurrentStep].Title, label.H4, "").Layout(gtx, th)
+		}),
+		layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, bars...)
+		}),
+	)
+}
+
+// layoutButtons renders Previous on all but the first step, and Next or, on
+// the last step, Submit.
+func (f *StepForm) layoutButtons(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	f.previous.SetText(th.Locale.T(i18n.KeyPrevious))
+	f.next.SetText(th.Locale.T(i18n.KeyNext))
+	f.submit.SetText(th.Locale.T(i18n.KeySubmit))
+
+	var widgets []layout.Widget
+	if f.CurrentStep > 0 {
+		widgets = append(widgets, func(gtx layout.Context) layout.Dimensions {
+			return f.previous.Layout(gtx, th)
+		})
+	}
+	action := f.next
+	if f.CurrentStep == len(f.Steps)-1 {
+		action = f.submit
+	}
+	widgets = append(widgets, func(gtx layout.Context) layout.Dimensions {
+		return action.Layout(gtx, th)
+	})
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		utils.FlexRow(th.Spacing.Space2, widgets...),
+	)
+}
@@ -0,0 +1,102 @@
+package input
+
+import (
+	"sync"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
+)
+
+// clipboardRequest is a clipboard read or write running off the UI
+// goroutine, and its result once it finishes.
+type clipboardRequest struct {
+	action int  // menuCut, menuCopy or menuPaste
+	button bool // started by a suffix button rather than the context menu
+	text   string
+	err    error
+}
+
+// clipboardState tracks the clipboard request in flight. System clipboard
+// access runs external tools, so it never happens during a frame.
+type clipboardState struct {
+	// pending is only used on the UI goroutine
+	pending bool
+
+	mu     sync.Mutex
+	done   bool
+	result clipboardRequest
+}
+
+// startClipboard writes req.text to cb, or reads cb for a paste, in a
+// goroutine. Requests made while one is running are dropped.
+func (i *Input) startClipboard(cb clipboardutil.Interface, req clipboardRequest) {
+	s := &i.clip
+	if s.pending {
+		return
+	}
+	s.pending = true
+
+	go func() {
+		if req.action == menuPaste {
+			req.text, req.err = cb.Read()
+		} else {
+			req.err = cb.Write(req.text)
+		}
+
+		s.mu.Lock()
+		s.done = true
+		s.result = req
+		s.mu.Unlock()
+	}()
+}
+
+// deliverClipboard applies the result of a finished clipboard request and
+// redraws until a pending one finishes. Clipboard failures leave the text
+// unchanged.
+func (i *Input) deliverClipboard(gtx layout.Context) {
+	s := &i.clip
+	if !s.pending {
+		return
+	}
+
+	s.mu.Lock()
+	done, req := s.done, s.result
+	s.done, s.result = false, clipboardRequest{}
+	s.mu.Unlock()
+
+	if !done {
+		gtx.Execute(op.InvalidateCmd{})
+		return
+	}
+	s.pending = false
+	if req.err != nil {
+		return
+	}
+
+	switch req.action {
+	case menuCut:
+		// Only cut the text that was copied, in case the selection moved
+		if i.editor.SelectedText() == req.text {
+			i.editor.Delete(1)
+			if i.OnCut != nil {
+				i.OnCut(req.text)
+			}
+		}
+	case menuCopy:
+		if req.button {
+			i.suffix.copiedAt = gtx.Now
+		}
+		if i.OnCopy != nil {
+			i.OnCopy(req.text)
+		}
+	case menuPaste:
+		sanitized := filterText(req.text, i.editor.Filter)
+		if i.OnPaste != nil {
+			sanitized = i.OnPaste(req.text, sanitized)
+		}
+		i.editor.Insert(sanitized)
+		gtx.Execute(key.FocusCmd{Tag: &i.editor})
+	}
+}
@@ -0,0 +1,212 @@
+package input
+
+import (
+	"image"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+	"github.com/bnema/gio-shadcn/utils/overlay"
+)
+
+// contextMenuWidth is the width of the clipboard context menu.
+const contextMenuWidth = unit.Dp(160)
+
+// contextMenuResolver opens the context menu down and to the right of the
+// pointer, flipping it above when it would clip.
+var contextMenuResolver = popover.PositionResolver{AutoFlip: true, Align: popover.AlignStart}
+
+// Context menu actions, in display order.
+const (
+	menuCut = iota
+	menuCopy
+	menuPaste
+	menuSelectAll
+	menuItemCount
+)
+
+// menuLabels are the i18n keys of the context menu actions.
+var menuLabels = [menuItemCount]string{
+	menuCut:       i18n.KeyCut,
+	menuCopy:      i18n.KeyCopy,
+	menuPaste:     i18n.KeyPaste,
+	menuSelectAll: i18n.KeySelectAll,
+}
+
+// contextMenu is the right-click clipboard menu of an input.
+type contextMenu struct {
+	open    bool
+	pos     image.Point
+	items   [menuItemCount]widget.Clickable
+	dismiss int
+	// panel covers the menu so presses on it don't dismiss it
+	panel int
+	// origin and window place the menu inside the window, see SetViewport
+	origin image.Point
+	window image.Point
+}
+
+// SetViewport sets the input's position in the window and the window size,
+// both in pixels, used to keep the context menu inside the window.
+func (i *Input) SetViewport(origin, window image.Point) {
+	i.menu.origin = origin
+	i.menu.window = window
+}
+
+// layoutMenuArea renders content inside a pointer area that opens the context
+// menu on right-click. The area encloses content so the editor still gets
+// pointer events.
+func (i *Input) layoutMenuArea(gtx layout.Context, content layout.Widget) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := content(gtx)
+	call := macro.Stop()
+
+	area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+	event.Op(gtx.Ops, &i.menu)
+	call.Add(gtx.Ops)
+	area.Pop()
+
+	return dims
+}
+
+// processContextMenu opens the menu on right-click, closes it on clicks
+// elsewhere, and runs the clicked action.
+func (i *Input) processContextMenu(gtx layout.Context) {
+	m := &i.menu
+
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: m, Kinds: pointer.Press})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok && e.Buttons.Contain(pointer.ButtonSecondary) && !i.Disabled {
+			m.open = true
+			m.pos = e.Position.Round()
+		}
+	}
+
+	if overlay.Dismissed(gtx, &m.dismiss) {
+		m.open = false
+	}
+
+	for action := range m.items {
+		if m.items[action].Clicked(gtx) && i.menuEnabled(action) {
+			i.runMenuAction(gtx, action)
+			m.open = false
+		}
+	}
+}
+
+// menuEnabled reports whether a context menu action applies to the current
+// selection.
+func (i *Input) menuEnabled(action int) bool {
+	switch action {
+	case menuCut:
+		return i.editor.SelectedText() != "" && !i.editor.ReadOnly
+	case menuCopy:
+		return i.editor.SelectedText() != ""
+	case menuPaste:
+		return !i.editor.ReadOnly
+	default:
+		return i.editor.Len() > 0
+	}
+}
+
+// runMenuAction performs a context menu action. Cut, copy and paste go
+// through i.Clipboard off the UI goroutine and take effect on a later frame.
+func (i *Input) runMenuAction(gtx layout.Context, action int) {
+	switch action {
+	case menuCut, menuCopy:
+		i.startClipboard(i.Clipboard, clipboardRequest{action: action, text: i.editor.SelectedText()})
+	case menuPaste:
+		i.startClipboard(i.Clipboard, clipboardRequest{action: menuPaste})
+	case menuSelectAll:
+		i.editor.SetCaret(i.editor.Len(), 0)
+	}
+
+	gtx.Execute(key.FocusCmd{Tag: &i.editor})
+}
+
+// layoutContextMenu defers the context menu at the right-click position,
+// flipped or shifted to stay inside the window.
+func (i *Input) layoutContextMenu(gtx layout.Context, th *theme.Theme) {
+	m := &i.menu
+
+	window := m.window
+	if window == (image.Point{}) {
+		window = m.origin.Add(gtx.Constraints.Max)
+	}
+
+	macro := op.Record(gtx.Ops)
+	gtx.Constraints = layout.Exact(image.Pt(gtx.Dp(contextMenuWidth), 0))
+	gtx.Constraints.Max.Y = 1 << 20
+
+	panel := op.Record(gtx.Ops)
+	dims := layout.UniformInset(th.Spacing.Space1).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		children := make([]layout.FlexChild, 0, menuItemCount)
+		for action := range m.items {
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return i.layoutMenuItem(gtx, th, action)
+			}))
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+	content := panel.Stop()
+
+	overlay.Block(gtx, &m.panel, dims.Size)
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+	content.Add(gtx.Ops)
+	call := macro.Stop()
+
+	pos, _ := contextMenuResolver.Resolve(image.Rectangle{Min: m.pos, Max: m.pos}.Add(m.origin), dims.Size, popover.PlacementBottom, window)
+	overlay.Defer(gtx, pos.Sub(m.origin), &m.dismiss, call)
+}
+
+// layoutMenuItem renders one full-width menu action, muted when it doesn't
+// apply.
+func (i *Input) layoutMenuItem(gtx layout.Context, th *theme.Theme, action int) layout.Dimensions {
+	click := &i.menu.items[action]
+	enabled := i.menuEnabled(action)
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		inner := op.Record(gtx.Ops)
+		dims := layout.Inset{
+			Top:    th.Spacing.Space1,
+			Bottom: th.Spacing.Space1,
+			Left:   th.Spacing.Space2,
+			Right:  th.Spacing.Space2,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, th.Locale.T(menuLabels[action]))
+			lbl.Color = th.Colors.PopoverFg
+			if !enabled {
+				lbl.Color = th.Colors.MutedFg
+			}
+			return lbl.Layout(gtx)
+		})
+		content := inner.Stop()
+
+		if enabled && click.Hovered() {
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+		}
+		content.Add(gtx.Ops)
+
+		return dims
+	})
+}
@@ -0,0 +1,47 @@
+package input
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// WithMaxLength limits the text to maxLength characters. Zero means no limit.
+func WithMaxLength(maxLength int) Option {
+	return func(i *Input) {
+		i.MaxLength = maxLength
+	}
+}
+
+// WithCharacterCount shows the number of characters below the input, out of
+// MaxLength when it is set.
+func WithCharacterCount(show bool) Option {
+	return func(i *Input) {
+		i.ShowCharacterCount = show
+	}
+}
+
+// layoutCounter renders the character count at the end of the line, in the
+// destructive color once the text reaches MaxLength.
+func (i *Input) layoutCounter(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	count := utf8.RuneCountInString(i.editor.Text())
+	counter := fmt.Sprint(count)
+	if i.MaxLength > 0 {
+		counter = fmt.Sprintf("%d/%d", count, i.MaxLength)
+	}
+
+	lbl := material.Label(material.NewTheme(), th.Typography.FontSizeXS, counter)
+	lbl.Color = th.Colors.MutedFg
+	if i.MaxLength > 0 && count >= i.MaxLength {
+		lbl.Color = th.Colors.Destructive
+	}
+	lbl.Alignment = text.End
+	lbl.MaxLines = 1
+
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return lbl.Layout(gtx)
+}
@@ -0,0 +1,110 @@
+package input
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+)
+
+// InputGroup renders prefix and suffix widgets inside the border box of an
+// Input, so the group looks like a single input. The Before and After widgets
+// keep their natural size while the input takes the remaining width.
+//
+// Example usage:.
+//
+//	price := &input.InputGroup{
+//		Before: []layout.Widget{currencySymbol},
+//		Input:  input.Number("0"),
+//		After:  []layout.Widget{cents},
+//	}
+//	dims := price.Layout(gtx, th)
+//
+//nolint:revive // InputGroup mirrors the shadcn/ui component name
+type InputGroup struct {
+	Before []layout.Widget
+	Input  *Input
+	After  []layout.Widget
+}
+
+// NewInputGroup creates a new InputGroup around the given input.
+func NewInputGroup(in *Input, before []layout.Widget, after []layout.Widget) *InputGroup {
+	return &InputGroup{
+		Before: before,
+		Input:  in,
+		After:  after,
+	}
+}
+
+// Layout renders the group with a single border drawn around all elements.
+func (g *InputGroup) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	padding := unit.Dp(12)
+
+	children := make([]layout.FlexChild, 0, len(g.Before)+len(g.After)+1)
+	for _, w := range g.Before {
+		children = append(children, layout.Rigid(g.addon(w, layout.Inset{Left: padding})))
+	}
+	children = append(children, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+		// The group draws the border, so the inner input renders borderless
+		style := g.Input.BorderStyle
+		g.Input.BorderStyle = theme.BorderNone
+		defer func() { g.Input.BorderStyle = style }()
+
+		return g.Input.Layout(gtx, th)
+	}))
+	for _, w := range g.After {
+		children = append(children, layout.Rigid(g.addon(w, layout.Inset{Right: padding})))
+	}
+
+	// Record the content to size the border box around it
+	macro := op.Record(gtx.Ops)
+	dims := layout.Flex{
+		Axis:      layout.Horizontal,
+		Alignment: layout.Middle,
+	}.Layout(gtx, children...)
+	call := macro.Stop()
+
+	bounds := image.Rectangle{Max: dims.Size}
+	rr := clip.UniformRRect(bounds, gtx.Dp(6))
+
+	// Background
+	paint.FillShape(gtx.Ops, g.Input.getBackgroundColor(th), rr.Op(gtx.Ops))
+
+	// Content
+	call.Add(gtx.Ops)
+
+	// Border around the whole group, following the input's focus and error state
+	borderWidth := unit.Dp(1)
+	if g.Input.focused {
+		borderWidth = unit.Dp(2)
+	}
+	switch g.Input.BorderStyle {
+	case theme.BorderNone:
+		// No border
+	case theme.BorderDashed:
+		utils.DrawDashedBorder(gtx, bounds, unit.Dp(6), borderWidth, g.Input.getBorderColor(th), unit.Dp(6), unit.Dp(4))
+	case theme.BorderDotted:
+		utils.DrawDashedBorder(gtx, bounds, unit.Dp(6), borderWidth, g.Input.getBorderColor(th), unit.Dp(2), unit.Dp(2))
+	default:
+		paint.FillShape(gtx.Ops, g.Input.getBorderColor(th),
+			clip.Stroke{
+				Path:  rr.Path(gtx.Ops),
+				Width: float32(gtx.Dp(borderWidth)),
+			}.Op())
+	}
+
+	return dims
+}
+
+// addon lays out a prefix or suffix widget at its natural size with the given inset.
+func (g *InputGroup) addon(w layout.Widget, inset layout.Inset) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = 0
+		return inset.Layout(gtx, w)
+	}
+}
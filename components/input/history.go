@@ -0,0 +1,118 @@
+package input
+
+import (
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+)
+
+// maxUndoEntries bounds the undo stack.
+const maxUndoEntries = 100
+
+// history is the input's undo and redo stacks of previous texts.
+type history struct {
+	undo []string
+	redo []string
+}
+
+// record pushes text as an undo entry and clears the redo stack.
+func (h *history) record(text string) {
+	h.undo = append(h.undo, text)
+	if len(h.undo) > maxUndoEntries {
+		h.undo = h.undo[len(h.undo)-maxUndoEntries:]
+	}
+	h.redo = h.redo[:0]
+}
+
+// CanUndo returns true if there is a change to undo.
+func (i *Input) CanUndo() bool {
+	return len(i.history.undo) > 0
+}
+
+// CanRedo returns true if there is an undone change to redo.
+func (i *Input) CanRedo() bool {
+	return len(i.history.redo) > 0
+}
+
+// Undo reverts the last change, including changes made with SetText, and
+// calls OnUndo with the restored text. It returns false if there is nothing
+// to undo.
+func (i *Input) Undo() bool {
+	h := &i.history
+	if len(h.undo) == 0 {
+		return false
+	}
+
+	text := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, i.editor.Text())
+	i.restoreText(text)
+
+	if i.OnUndo != nil {
+		i.OnUndo(text)
+	}
+	return true
+}
+
+// Redo reapplies the last undone change and calls OnRedo with the restored
+// text. It returns false if there is nothing to redo.
+func (i *Input) Redo() bool {
+	h := &i.history
+	if len(h.redo) == 0 {
+		return false
+	}
+
+	text := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, i.editor.Text())
+	i.restoreText(text)
+
+	if i.OnRedo != nil {
+		i.OnRedo(text)
+	}
+	return true
+}
+
+// restoreText replaces the text without recording history, placing the caret
+// at the end.
+func (i *Input) restoreText(text string) {
+	i.editor.SetText(text)
+	i.Value = text
+	i.lastValue = text
+	end := i.editor.Len()
+	i.editor.SetCaret(end, end)
+
+	i.notifyChange(text)
+}
+
+// processHistoryKeys intercepts the undo and redo shortcuts when OnUndo or
+// OnRedo is set: Ctrl+Z undoes, Ctrl+Shift+Z and Ctrl+Y redo. The input's own
+// history is used instead of the editor's, so programmatic changes made with
+// SetText are undoable too.
+func (i *Input) processHistoryKeys(gtx layout.Context) {
+	if i.OnUndo == nil && i.OnRedo == nil {
+		return
+	}
+
+	filters := []event.Filter{
+		key.Filter{Focus: &i.editor, Name: "Z", Required: key.ModShortcut, Optional: key.ModShift},
+		key.Filter{Focus: &i.editor, Name: "Y", Required: key.ModShortcut},
+	}
+
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press || i.editor.ReadOnly {
+			continue
+		}
+
+		if e.Name == "Z" && !e.Modifiers.Contain(key.ModShift) {
+			i.Undo()
+		} else {
+			i.Redo()
+		}
+	}
+}
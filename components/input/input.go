@@ -50,7 +50,16 @@ Available sizes:
 • Theme integration with automatic color adaptation
 • Keyboard event handling
 • Focus state management
-• Change and submit callbacks
+• Auto-focus on first layout, and Focus and Blur methods
+• Change and submit callbacks, with optional debouncing or throttling
+• Input groups with inline prefix and suffix addons
+• Password strength indicator
+• Autocomplete suggestions dropdown with keyboard navigation
+• Undo and redo history, including programmatic changes
+• Right-click Cut, Copy, Paste and Select All menu backed by a custom clipboard
+• Read-only mode, a Suffix slot, and copy and paste suffix buttons
+• Textarea with auto-growing height up to MaxRows, scrolling beyond it
+• Maximum length and a character counter
 
 # Examples
 
@@ -75,6 +84,20 @@ Email input with validation:
 		},
 	})
 
+Bio textarea limited to 280 characters:
+
+	bio := input.Textarea("Tell us about yourself")
+	bio.MaxLength = 280
+	bio.ShowCharacterCount = true
+
+Read-only API key with a copy button:
+
+	apiKey := input.NewInput(
+		input.WithReadOnly(true),
+		input.WithCopyButton(true),
+	)
+	apiKey.SetText(key)
+
 Password input:
 
 	passwordInput := input.New(input.Config{
@@ -90,9 +113,15 @@ package input
 import (
 	"image"
 	"image/color"
+	"io"
+	"strings"
+	"time"
 
+	"gioui.org/io/clipboard"
+	"gioui.org/io/event"
 	"gioui.org/io/key"
 	"gioui.org/layout"
+	"gioui.org/op"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/unit"
@@ -100,6 +129,8 @@ import (
 	"gioui.org/widget/material"
 
 	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
 )
 
 // Type represents the type of input field.
@@ -150,6 +181,21 @@ type Input struct {
 	Label       string
 	Helper      string
 	ErrorMsg    string
+	BorderStyle theme.BorderStyle
+
+	// Multiline configuration
+	Multiline  bool
+	Rows       int
+	MaxRows    int
+	AutoResize bool
+
+	// ShowStrengthIndicator renders a strength meter below password inputs
+	ShowStrengthIndicator bool
+
+	// Autocomplete configuration. When Suggestions is non-nil, matching
+	// suggestions are shown in a dropdown below the focused input.
+	Suggestions    []string
+	MaxSuggestions int
 
 	// Callbacks
 	OnChange func(string)
@@ -157,9 +203,55 @@ type Input struct {
 	OnBlur   func()
 	OnSubmit func()
 
+	// Clipboard callbacks. OnPaste receives the pasted text and the text after
+	// applying the input's character filter, and returns the text to insert
+	// as is, without filtering it again.
+	OnPaste func(original, sanitized string) string
+	OnCut   func(string)
+	OnCopy  func(string)
+
+	// History callbacks, called with the restored text after an undo or redo.
+	OnUndo func(text string)
+	OnRedo func(text string)
+
+	// Clipboard, when set, backs a right-click menu with Cut, Copy, Paste and
+	// Select All. The clipboard callbacks above also fire for menu actions.
+	Clipboard clipboardutil.Interface
+
+	// AutoFocus focuses the input the first time it is laid out.
+	AutoFocus bool
+
+	// ReadOnly lets the text be selected and copied but not edited.
+	ReadOnly bool
+
+	// Suffix is shown at the end of the field, inside its border, before the
+	// copy and paste buttons.
+	Suffix layout.Widget
+
+	// ShowCopyButton adds a suffix button that copies the text to Clipboard,
+	// or to the system clipboard when Clipboard is nil. ShowPasteButton adds
+	// one that pastes at the caret of writable inputs.
+	ShowCopyButton  bool
+	ShowPasteButton bool
+
+	// MaxLength limits the text to this many characters when positive.
+	// ShowCharacterCount shows the count below the input.
+	MaxLength          int
+	ShowCharacterCount bool
+
+	// Rate-limited change callbacks, set with WithDebounce and WithThrottle
+	debounce *utils.Debouncer[string]
+	throttle *utils.Throttler[string]
+
 	// Internal
+	laidOut   bool
 	lastValue string
 	focused   bool
+	suggest   suggestionState
+	history   history
+	menu      contextMenu
+	suffix    suffixButtons
+	clip      clipboardState
 }
 
 // Option is a functional option for configuring Input components.
@@ -221,6 +313,56 @@ func WithInputDisabled(disabled bool) Option {
 	}
 }
 
+// WithBorderStyle sets the border line style (solid, dashed, dotted or none).
+func WithBorderStyle(style theme.BorderStyle) Option {
+	return func(i *Input) {
+		i.BorderStyle = style
+	}
+}
+
+// WithStrengthIndicator shows a password strength meter below password inputs.
+func WithStrengthIndicator(show bool) Option {
+	return func(i *Input) {
+		i.ShowStrengthIndicator = show
+	}
+}
+
+// WithSuggestions enables autocomplete with the given suggestions.
+func WithSuggestions(suggestions []string) Option {
+	return func(i *Input) {
+		i.Suggestions = suggestions
+	}
+}
+
+// WithMaxSuggestions sets the maximum number of suggestions shown at once.
+func WithMaxSuggestions(maxSuggestions int) Option {
+	return func(i *Input) {
+		i.MaxSuggestions = maxSuggestions
+	}
+}
+
+// WithMultiline enables multiline mode with the given number of visible rows.
+func WithMultiline(rows int) Option {
+	return func(i *Input) {
+		i.Multiline = true
+		i.Rows = rows
+	}
+}
+
+// WithMaxRows sets the maximum number of rows an auto-resizing input grows to.
+func WithMaxRows(maxRows int) Option {
+	return func(i *Input) {
+		i.MaxRows = maxRows
+	}
+}
+
+// WithAutoResize sets whether a multiline input grows with its content.
+func WithAutoResize(autoResize bool) Option {
+	return func(i *Input) {
+		i.AutoResize = autoResize
+	}
+}
+
 // WithOnChange sets the change callback.
 func WithOnChange(onChange func(string)) Option {
 	return func(i *Input) {
@@ -228,6 +370,23 @@ func WithOnChange(onChange func(string)) Option {
 	}
 }
 
+// WithDebounce calls onChange once the text stops changing for delay, such
+// as to search as the user types. onChange runs on a timer goroutine, not
+// during Layout.
+func WithDebounce(delay time.Duration, onChange func(string)) Option {
+	return func(i *Input) {
+		i.debounce = utils.NewDebouncer(delay, onChange)
+	}
+}
+
+// WithThrottle calls onChange at most once per period with the latest text.
+// Like WithDebounce, onChange may run on a timer goroutine.
+func WithThrottle(period time.Duration, onChange func(string)) Option {
+	return func(i *Input) {
+		i.throttle = utils.NewThrottler(period, onChange)
+	}
+}
+
 // WithOnSubmit sets the submit callback.
 func WithOnSubmit(onSubmit func()) Option {
 	return func(i *Input) {
@@ -235,6 +394,61 @@ func WithOnSubmit(onSubmit func()) Option {
 	}
 }
 
+// WithOnPaste sets the paste callback.
+// The callback receives the pasted text and the filtered text, and returns the
+// text actually inserted. Gio has no paste event, so any insertion of more
+// than one character in a single frame is treated as a paste.
+func WithOnPaste(onPaste func(original, sanitized string) string) Option {
+	return func(i *Input) {
+		i.OnPaste = onPaste
+	}
+}
+
+// WithOnCut sets the cut callback, called with the text removed to the clipboard.
+func WithOnCut(onCut func(string)) Option {
+	return func(i *Input) {
+		i.OnCut = onCut
+	}
+}
+
+// WithOnCopy sets the copy callback, called with the text copied to the clipboard.
+func WithOnCopy(onCopy func(string)) Option {
+	return func(i *Input) {
+		i.OnCopy = onCopy
+	}
+}
+
+// WithClipboard enables the right-click clipboard menu, reading and writing
+// through clipboard.
+func WithClipboard(clipboard clipboardutil.Interface) Option {
+	return func(i *Input) {
+		i.Clipboard = clipboard
+	}
+}
+
+// WithAutoFocus focuses the input the first time it is laid out, such as
+// the first field of a dialog.
+func WithAutoFocus(autoFocus bool) Option {
+	return func(i *Input) {
+		i.AutoFocus = autoFocus
+	}
+}
+
+// WithOnUndo sets the undo callback, called with the text restored by Ctrl+Z.
+func WithOnUndo(onUndo func(text string)) Option {
+	return func(i *Input) {
+		i.OnUndo = onUndo
+	}
+}
+
+// WithOnRedo sets the redo callback, called with the text restored by
+// Ctrl+Shift+Z or Ctrl+Y.
+func WithOnRedo(onRedo func(text string)) Option {
+	return func(i *Input) {
+		i.OnRedo = onRedo
+	}
+}
+
 // NewInput creates a new Input with the given options.
 func NewInput(options ...Option) *Input {
 	i := &Input{
@@ -242,6 +456,7 @@ func NewInput(options ...Option) *Input {
 		Variant: InputDefault,
 		Size:    InputSizeMedium,
 		editor:  widget.Editor{},
+		suggest: suggestionState{highlighted: -1},
 	}
 
 	for _, option := range options {
@@ -251,8 +466,11 @@ func NewInput(options ...Option) *Input {
 	return i
 }
 
-// SetText sets the text content of the input.
+// SetText sets the text content of the input. The change can be undone.
 func (i *Input) SetText(text string) {
+	if current := i.editor.Text(); current != text {
+		i.history.record(current)
+	}
 	i.editor.SetText(text)
 	i.Value = text
 	i.lastValue = text
@@ -263,12 +481,113 @@ func (i *Input) Text() string {
 	return i.editor.Text()
 }
 
+// SetValue sets the text content of the input if it differs from the current
+// text, leaving the caret untouched otherwise. It lets Input be used with
+// controlled.Controlled.
+func (i *Input) SetValue(value string) {
+	if i.editor.Text() != value {
+		i.SetText(value)
+	}
+}
+
+// GetValue returns the current text content of the input.
+func (i *Input) GetValue() string {
+	return i.editor.Text()
+}
+
+// notifyChange reports a text change to OnChange and the rate-limited
+// callbacks.
+func (i *Input) notifyChange(text string) {
+	if i.OnChange != nil {
+		i.OnChange(text)
+	}
+	if i.debounce != nil {
+		i.debounce.Call(text)
+	}
+	if i.throttle != nil {
+		i.throttle.Call(text)
+	}
+}
+
+// Focus moves keyboard focus to the input.
+func (i *Input) Focus(gtx layout.Context) {
+	gtx.Execute(key.FocusCmd{Tag: &i.editor})
+}
+
+// Blur removes keyboard focus from the input, if it has it.
+func (i *Input) Blur(gtx layout.Context) {
+	if gtx.Focused(&i.editor) {
+		gtx.Execute(key.FocusCmd{})
+	}
+}
+
 // Layout renders the input component.
 func (i *Input) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if !i.laidOut {
+		i.laidOut = true
+		if i.AutoFocus {
+			i.Focus(gtx)
+		}
+	}
+
+	showStrength := i.Type == InputPassword && i.ShowStrengthIndicator && i.editor.Len() > 0
+	if !showStrength && !i.ShowCharacterCount {
+		return i.layoutField(gtx, th)
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return i.layoutField(gtx, th)
+		}),
+	}
+	if showStrength {
+		children = append(children,
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return i.layoutStrengthIndicator(gtx, th)
+			}),
+		)
+	}
+	if i.ShowCharacterCount {
+		children = append(children,
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space1}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return i.layoutCounter(gtx, th)
+			}),
+		)
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// layoutField renders the bordered editor.
+func (i *Input) layoutField(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	// Configure editor based on type
 	i.configureEditor()
 
+	// Handle copy and cut shortcuts before the editor so callbacks observe them
+	i.processClipboardKeys(gtx)
+
+	// Handle undo and redo before the editor's own history
+	i.processHistoryKeys(gtx)
+
+	// Let the suggestion dropdown take arrow keys before the editor
+	if i.Suggestions != nil {
+		i.processSuggestionKeys(gtx)
+	}
+
+	// Gio does not report pastes separately, so when OnPaste is set the editor
+	// runs unfiltered for this frame and the inserted text is inspected below.
+	var before string
+	filter := i.editor.Filter
+	if i.OnPaste != nil {
+		before = i.editor.Text()
+		i.editor.Filter = ""
+	}
+
 	// Process editor events (this handles all keyboard input automatically)
+	changed := false
 	for {
 		event, ok := i.editor.Update(gtx)
 		if !ok {
@@ -280,10 +599,24 @@ func (i *Input) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 				i.OnSubmit()
 			}
 		case widget.ChangeEvent:
-			// Handle change events if needed
+			changed = true
 		}
 	}
 
+	if i.OnPaste != nil {
+		i.editor.Filter = filter
+		if changed {
+			i.processPaste(before, filter)
+		}
+	}
+
+	// Menu actions edit the text after the editor's own changes are processed
+	i.deliverClipboard(gtx)
+	if i.Clipboard != nil {
+		i.processContextMenu(gtx)
+	}
+	i.processSuffixButtons(gtx)
+
 	// Handle focus events separately for UI state tracking
 	for {
 		event, ok := gtx.Event(key.FocusFilter{Target: &i.editor})
@@ -303,11 +636,10 @@ func (i *Input) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	// Check for text changes
 	currentText := i.editor.Text()
 	if currentText != i.lastValue {
+		i.history.record(i.lastValue)
 		i.lastValue = currentText
 		i.Value = currentText
-		if i.OnChange != nil {
-			i.OnChange(currentText)
-		}
+		i.notifyChange(currentText)
 	}
 
 	// Create editor style
@@ -317,16 +649,57 @@ func (i *Input) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	editor.HintColor = th.Colors.MutedFg
 	editor.TextSize = unit.Sp(14)
 
-	// Calculate input dimensions based on size
-	inputHeight := i.getInputHeight()
 	padding := unit.Dp(12)
 
+	// Calculate input dimensions based on size or content. Single-line inputs
+	// keep the editor's default line height so the text fits the field.
+	minHeight, maxHeight := gtx.Dp(i.getInputHeight()), 0
+	if i.Multiline {
+		editor.LineHeightScale = th.Typography.LineHeightNormal
+		minHeight, maxHeight = i.getMultilineHeight(gtx, editor, padding)
+	}
+
 	// Set minimum height for the context
-	minHeight := gtx.Dp(inputHeight)
 	gtx.Constraints.Min.Y = minHeight
+	if i.Multiline {
+		// Constrain the editor so content beyond the height scrolls. The
+		// padding inset doesn't shrink the minimum, so leave room for it.
+		gtx.Constraints.Max.Y = maxHeight
+		gtx.Constraints.Min.Y = max(0, minHeight-2*gtx.Dp(padding))
+	}
+
+	// Layout the editor with padding first, recorded so the background and
+	// border can be drawn behind it at the height it took
+	field := func(gtx layout.Context) layout.Dimensions {
+		if !i.hasSuffix() {
+			return layout.UniformInset(padding).Layout(gtx, editor.Layout)
+		}
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return layout.UniformInset(padding).Layout(gtx, editor.Layout)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return i.layoutSuffix(gtx, th)
+			}),
+		)
+	}
+	macro := op.Record(gtx.Ops)
+	var dims layout.Dimensions
+	if i.Clipboard != nil {
+		dims = i.layoutMenuArea(gtx, field)
+	} else {
+		dims = field(gtx)
+	}
+	content := macro.Stop()
+
+	// An auto-resizing input follows its content, within its row limits
+	height := minHeight
+	if i.Multiline && i.AutoResize {
+		height = min(max(dims.Size.Y, minHeight), gtx.Constraints.Max.Y)
+	}
 
 	// Calculate the bounds for background/border
-	bounds := image.Rectangle{Max: image.Point{X: gtx.Constraints.Max.X, Y: minHeight}}
+	bounds := image.Rectangle{Max: image.Point{X: gtx.Constraints.Max.X, Y: height}}
 
 	// Draw background FIRST (behind the text)
 	paint.FillShape(gtx.Ops, i.getBackgroundColor(th),
@@ -339,23 +712,170 @@ func (i *Input) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	}
 
 	// Draw border SECOND (behind the text)
-	paint.FillShape(gtx.Ops, i.getBorderColor(th),
-		clip.Stroke{
-			Path:  clip.UniformRRect(bounds, gtx.Metric.Dp(6)).Path(gtx.Ops),
-			Width: float32(gtx.Metric.Dp(borderWidth)),
-		}.Op())
+	switch i.BorderStyle {
+	case theme.BorderNone:
+		// No border
+	case theme.BorderDashed:
+		utils.DrawDashedBorder(gtx, bounds, unit.Dp(6), borderWidth, i.getBorderColor(th), unit.Dp(6), unit.Dp(4))
+	case theme.BorderDotted:
+		utils.DrawDashedBorder(gtx, bounds, unit.Dp(6), borderWidth, i.getBorderColor(th), unit.Dp(2), unit.Dp(2))
+	default:
+		paint.FillShape(gtx.Ops, i.getBorderColor(th),
+			clip.Stroke{
+				Path:  clip.UniformRRect(bounds, gtx.Metric.Dp(6)).Path(gtx.Ops),
+				Width: float32(gtx.Metric.Dp(borderWidth)),
+			}.Op())
+	}
+
+	// Text LAST (in front of background)
+	content.Add(gtx.Ops)
 
-	// Layout the editor with padding LAST (in front of background)
-	dims := layout.UniformInset(padding).Layout(gtx, editor.Layout)
+	// Ensure the final dimensions match the field height
+	if dims.Size.Y < height {
+		dims.Size.Y = height
+	}
 
-	// Ensure the final dimensions match our minimum height
-	if dims.Size.Y < minHeight {
-		dims.Size.Y = minHeight
+	if i.Suggestions != nil {
+		i.layoutSuggestions(gtx, th, dims.Size)
+	}
+	if i.menu.open {
+		i.layoutContextMenu(gtx, th)
 	}
 
 	return dims
 }
 
+// processClipboardKeys intercepts the copy and cut shortcuts when OnCopy or
+// OnCut is set. It performs the same clipboard write as widget.Editor, which
+// otherwise handles these shortcuts without reporting them.
+func (i *Input) processClipboardKeys(gtx layout.Context) {
+	var filters []event.Filter
+	if i.OnCopy != nil {
+		filters = append(filters, key.Filter{Focus: &i.editor, Name: "C", Required: key.ModShortcut})
+	}
+	if i.OnCut != nil {
+		filters = append(filters, key.Filter{Focus: &i.editor, Name: "X", Required: key.ModShortcut})
+	}
+	if len(filters) == 0 {
+		return
+	}
+
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press {
+			continue
+		}
+
+		// Like the editor, ignore the shortcut when nothing is selected
+		text := i.editor.SelectedText()
+		if text == "" {
+			continue
+		}
+		gtx.Execute(clipboard.WriteCmd{Type: "application/text", Data: io.NopCloser(strings.NewReader(text))})
+
+		switch e.Name {
+		case "C":
+			i.OnCopy(text)
+		case "X":
+			if i.editor.ReadOnly {
+				continue
+			}
+			i.editor.Delete(1)
+			i.OnCut(text)
+		}
+	}
+}
+
+// processPaste inspects the text inserted by the editor this frame.
+//
+// Limitation: Gio exposes no paste event, so an insertion of more than one
+// character in a single frame is treated as a paste. Fast typing or IME
+// composition can therefore also trigger OnPaste. Single characters are only
+// filtered, as the editor itself would have done.
+func (i *Input) processPaste(before, filter string) {
+	start, end := insertedRange([]rune(before), []rune(i.editor.Text()))
+	if end <= start {
+		return
+	}
+
+	inserted := string([]rune(i.editor.Text())[start:end])
+	sanitized := filterText(inserted, filter)
+	result := sanitized
+	if end-start > 1 {
+		result = i.OnPaste(inserted, sanitized)
+	}
+
+	// The editor drops characters outside its filter, which would strip the
+	// formatting OnPaste may add
+	if result != inserted {
+		i.editor.Filter = ""
+		i.editor.SetCaret(start, end)
+		i.editor.Insert(result)
+		i.editor.Filter = filter
+	}
+}
+
+// insertedRange returns the rune range of after that differs from before,
+// found by trimming their common prefix and suffix.
+func insertedRange(before, after []rune) (start, end int) {
+	for start < len(before) && start < len(after) && before[start] == after[start] {
+		start++
+	}
+
+	end = len(after)
+	for tail := len(before); end > start && tail > start && before[tail-1] == after[end-1]; tail-- {
+		end--
+	}
+
+	return start, end
+}
+
+// filterText removes the characters not present in filter. An empty filter
+// allows every character.
+func filterText(text, filter string) string {
+	if filter == "" {
+		return text
+	}
+
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(filter, r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+// getMultilineHeight returns the minimum and maximum height of a multiline
+// input in pixels. Without AutoResize both are Rows lines. With AutoResize the
+// input grows from Rows lines up to MaxRows lines, or up to the available
+// height when MaxRows is not set; the editor is laid out once within these
+// bounds and the field takes the height it used.
+func (i *Input) getMultilineHeight(gtx layout.Context, editor material.EditorStyle, padding unit.Dp) (int, int) {
+	rows := i.Rows
+	if rows <= 0 {
+		rows = 3
+	}
+
+	lineHeight := float32(gtx.Sp(editor.TextSize)) * editor.LineHeightScale
+	inset := 2 * gtx.Dp(padding)
+	minHeight := int(float32(rows)*lineHeight) + inset
+
+	if !i.AutoResize {
+		return minHeight, minHeight
+	}
+
+	maxHeight := gtx.Constraints.Max.Y
+	if i.MaxRows > 0 {
+		maxHeight = int(float32(i.MaxRows)*lineHeight) + inset
+	}
+
+	return minHeight, max(minHeight, maxHeight)
+}
+
 // Update returns the component state for Input.
 func (i *Input) Update(_ layout.Context) theme.ComponentState {
 	return &State{
@@ -407,8 +927,9 @@ func (i *Input) configureEditor() {
 		i.editor.Filter = ""
 	}
 
-	i.editor.SingleLine = true
-	i.editor.ReadOnly = i.Disabled
+	i.editor.SingleLine = !i.Multiline
+	i.editor.ReadOnly = i.Disabled || i.ReadOnly
+	i.editor.MaxLen = max(0, i.MaxLength)
 }
 
 func (i *Input) getBackgroundColor(th *theme.Theme) color.NRGBA {
@@ -474,6 +995,7 @@ func Password(placeholder string) *Input {
 	return NewInput(
 		WithPlaceholder(placeholder),
 		WithInputType(InputPassword),
+		WithStrengthIndicator(true),
 	)
 }
 
@@ -493,6 +1015,17 @@ func Email(placeholder string) *Input {
 	)
 }
 
+// Textarea creates a multiline input with the given placeholder. It starts
+// at three rows, grows with its content up to ten and scrolls beyond that.
+func Textarea(placeholder string) *Input {
+	return NewInput(
+		WithPlaceholder(placeholder),
+		WithMultiline(3),
+		WithMaxRows(10),
+		WithAutoResize(true),
+	)
+}
+
 // WithVariant sets the input variant.
 func (i *Input) WithVariant(variant Variant) *Input {
 	i.Variant = variant
@@ -559,3 +1092,21 @@ func (i *Input) WithOnSubmit(fn func()) *Input {
 	i.OnSubmit = fn
 	return i
 }
+
+// WithOnPaste sets the input paste callback.
+func (i *Input) WithOnPaste(fn func(original, sanitized string) string) *Input {
+	i.OnPaste = fn
+	return i
+}
+
+// WithOnCut sets the input cut callback.
+func (i *Input) WithOnCut(fn func(string)) *Input {
+	i.OnCut = fn
+	return i
+}
+
+// WithOnCopy sets the input copy callback.
+func (i *Input) WithOnCopy(fn func(string)) *Input {
+	i.OnCopy = fn
+	return i
+}
@@ -0,0 +1,157 @@
+package input
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+func TestAutoFocusFirstFrameOnly(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	in := NewInput(WithAutoFocus(true))
+
+	frame := func(before func(gtx layout.Context)) {
+		ops := new(op.Ops)
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Constraints: layout.Exact(image.Pt(300, 100)),
+		}
+		if before != nil {
+			before(gtx)
+		}
+		in.Layout(gtx, th)
+		r.Frame(ops)
+	}
+
+	frame(nil)
+	if !r.Source().Focused(&in.editor) {
+		t.Fatal("input not focused after the first frame")
+	}
+
+	// Focus moves elsewhere; later frames must not take it back
+	frame(func(gtx layout.Context) {
+		gtx.Execute(key.FocusCmd{})
+	})
+	frame(nil)
+	if r.Source().Focused(&in.editor) {
+		t.Error("input focused again after the first frame")
+	}
+}
+
+// memClipboard is an in-memory clipboard whose reads block until release is
+// closed.
+type memClipboard struct {
+	text    string
+	release chan struct{}
+}
+
+func (c *memClipboard) Read() (string, error) {
+	<-c.release
+	return c.text, nil
+}
+
+func (c *memClipboard) Write(s string) error {
+	c.text = s
+	return nil
+}
+
+func TestPasteAppliedOnLaterFrame(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	cb := &memClipboard{text: "pasted", release: make(chan struct{})}
+	in := NewInput(WithClipboard(cb))
+
+	frame := func() {
+		ops := new(op.Ops)
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Constraints: layout.Exact(image.Pt(300, 100)),
+		}
+		in.Layout(gtx, th)
+		r.Frame(ops)
+	}
+
+	frame()
+	ops := new(op.Ops)
+	in.runMenuAction(layout.Context{Ops: ops, Source: r.Source()}, menuPaste)
+
+	// The read is blocked, so frames must go on without the text
+	frame()
+	if got := in.editor.Text(); got != "" {
+		t.Fatalf("text = %q while the clipboard read is pending, want empty", got)
+	}
+
+	close(cb.release)
+	deadline := time.Now().Add(time.Second)
+	for in.editor.Text() == "" && time.Now().Before(deadline) {
+		frame()
+		time.Sleep(time.Millisecond)
+	}
+	if got := in.editor.Text(); got != "pasted" {
+		t.Errorf("text = %q after the clipboard read finished, want %q", got, "pasted")
+	}
+}
+
+func TestPasteResultIsNotFiltered(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	in := NewInput(
+		WithInputType(InputNumber),
+		WithAutoFocus(true),
+		WithOnPaste(func(_, sanitized string) string {
+			return "(" + sanitized[:3] + ") " + sanitized[3:6] + "-" + sanitized[6:]
+		}),
+	)
+
+	frame := func() {
+		ops := new(op.Ops)
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Constraints: layout.Exact(image.Pt(300, 100)),
+		}
+		in.Layout(gtx, th)
+		r.Frame(ops)
+	}
+
+	frame()
+	r.Queue(key.EditEvent{Text: "555 123 4567"})
+	frame()
+
+	want := "(555) 123-4567"
+	if got := in.editor.Text(); got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
+
+func TestAutoResizeFollowsContent(t *testing.T) {
+	th := theme.TestTheme()
+	in := NewInput(WithMultiline(2), WithMaxRows(4), WithAutoResize(true))
+
+	height := func(text string) int {
+		in.SetText(text)
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Constraints{Max: image.Pt(300, 1000)},
+		}
+		return in.Layout(gtx, th).Size.Y
+	}
+
+	short, three := height("one"), height("one\ntwo\nthree")
+	long, longer := height("1\n2\n3\n4\n5\n6"), height("1\n2\n3\n4\n5\n6\n7\n8\n9")
+	if short >= three {
+		t.Errorf("height with three lines = %d, want more than %d for one line", three, short)
+	}
+	if long <= three || long != longer {
+		t.Errorf("heights past MaxRows = %d and %d, want both capped above %d", long, longer, three)
+	}
+}
@@ -0,0 +1,112 @@
+package input
+
+import (
+	"image"
+	"image/color"
+	"unicode"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// strengthSegments is the number of bars in the password strength indicator.
+const strengthSegments = 4
+
+// maxStrengthScore is the highest score measurePasswordStrength can return.
+const maxStrengthScore = 7
+
+// measurePasswordStrength scores a password from 0 to 7 and returns the i18n
+// key of the score's label.
+// Length of at least 8 adds 1 and of at least 12 adds 2 more; uppercase,
+// lowercase, digit and special characters add 1 each.
+func measurePasswordStrength(s string) (score int, labelKey string) {
+	length := len([]rune(s))
+	if length >= 8 {
+		score++
+	}
+	if length >= 12 {
+		score += 2
+	}
+
+	var upper, lower, digit, special bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			special = true
+		}
+	}
+	for _, has := range []bool{upper, lower, digit, special} {
+		if has {
+			score++
+		}
+	}
+
+	switch {
+	case score <= 2:
+		return score, i18n.KeyStrengthWeak
+	case score <= 4:
+		return score, i18n.KeyStrengthFair
+	case score <= 6:
+		return score, i18n.KeyStrengthStrong
+	default:
+		return score, i18n.KeyStrengthVeryStrong
+	}
+}
+
+// strengthColor returns the indicator color for a strength score.
+func strengthColor(score int) color.NRGBA {
+	switch {
+	case score <= 2:
+		return theme.PaletteRed[theme.Shade500]
+	case score <= 4:
+		return theme.PaletteAmber[theme.Shade500]
+	case score <= 6:
+		return theme.PaletteGreen[theme.Shade500]
+	default:
+		return theme.PaletteEmerald[theme.Shade500]
+	}
+}
+
+// layoutStrengthIndicator renders segment bars filled in proportion to the
+// password strength, followed by the strength label.
+func (i *Input) layoutStrengthIndicator(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	score, key := measurePasswordStrength(i.editor.Text())
+	text := th.Locale.T(key)
+	barColor := strengthColor(score)
+	filled := (score*strengthSegments + maxStrengthScore - 1) / maxStrengthScore
+
+	gap := th.Spacing.Space1
+	children := make([]layout.FlexChild, 0, 2*strengthSegments+1)
+	for n := 0; n < strengthSegments; n++ {
+		segmentColor := th.Colors.Muted
+		if n < filled {
+			segmentColor = barColor
+		}
+		if n > 0 {
+			children = append(children, layout.Rigid(layout.Spacer{Width: gap}.Layout))
+		}
+		children = append(children, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(unit.Dp(1)))
+			paint.FillShape(gtx.Ops, segmentColor, clip.Rect{Max: size}.Op())
+			return layout.Dimensions{Size: size}
+		}))
+	}
+	children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+		return layout.Inset{Left: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return label.NewTypography(text, label.Small, "").Layout(gtx, th)
+		})
+	}))
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, children...)
+}
@@ -0,0 +1,152 @@
+package input
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/theme"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// copiedFeedback is how long the copy button shows a checkmark after copying.
+const copiedFeedback = 2 * time.Second
+
+// suffixButtons holds the copy and paste buttons of an input, created on
+// first use.
+type suffixButtons struct {
+	copy     *button.Button
+	paste    *button.Button
+	copiedAt time.Time
+	// system is the clipboard used when Input.Clipboard is nil
+	system clipboardutil.Interface
+}
+
+// Suffix icons. The icon data is constant, so NewIcon cannot fail.
+var (
+	copyIcon, _   = widget.NewIcon(icons.ContentContentCopy)
+	copiedIcon, _ = widget.NewIcon(icons.ActionDone)
+	pasteIcon, _  = widget.NewIcon(icons.ContentContentPaste)
+)
+
+// WithSuffix sets a widget shown at the end of the field, inside its border.
+func WithSuffix(suffix layout.Widget) Option {
+	return func(i *Input) {
+		i.Suffix = suffix
+	}
+}
+
+// WithReadOnly makes the text selectable and copyable but not editable.
+func WithReadOnly(readOnly bool) Option {
+	return func(i *Input) {
+		i.ReadOnly = readOnly
+	}
+}
+
+// WithCopyButton shows a button that copies the text to the clipboard, such
+// as for a read-only API key or generated password.
+func WithCopyButton(show bool) Option {
+	return func(i *Input) {
+		i.ShowCopyButton = show
+	}
+}
+
+// WithPasteButton shows a button that pastes the clipboard text at the caret
+// of a writable input, such as for URLs and tokens.
+func WithPasteButton(show bool) Option {
+	return func(i *Input) {
+		i.ShowPasteButton = show
+	}
+}
+
+// hasSuffix reports whether the field has anything to show after the editor.
+func (i *Input) hasSuffix() bool {
+	return i.Suffix != nil || i.ShowCopyButton || i.showPaste()
+}
+
+// showPaste reports whether the paste button is shown. Read-only and disabled
+// inputs have none.
+func (i *Input) showPaste() bool {
+	return i.ShowPasteButton && !i.ReadOnly && !i.Disabled
+}
+
+// clipboard returns Clipboard, or the system clipboard when it is nil.
+func (i *Input) clipboard() clipboardutil.Interface {
+	if i.Clipboard != nil {
+		return i.Clipboard
+	}
+	if i.suffix.system == nil {
+		i.suffix.system = clipboardutil.NewSystem()
+	}
+	return i.suffix.system
+}
+
+// processSuffixButtons starts a copy or paste for the buttons clicked since
+// the last frame.
+func (i *Input) processSuffixButtons(gtx layout.Context) {
+	s := &i.suffix
+
+	if i.ShowCopyButton && s.copy != nil && s.copy.Clicked(gtx) {
+		i.startClipboard(i.clipboard(), clipboardRequest{action: menuCopy, button: true, text: i.editor.Text()})
+	}
+
+	if i.showPaste() && s.paste != nil && s.paste.Clicked(gtx) {
+		i.startClipboard(i.clipboard(), clipboardRequest{action: menuPaste, button: true})
+	}
+}
+
+// layoutSuffix renders Suffix followed by the copy and paste buttons.
+func (i *Input) layoutSuffix(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	s := &i.suffix
+	gtx.Constraints.Min = image.Point{}
+
+	var children []layout.FlexChild
+	if i.Suffix != nil {
+		children = append(children, layout.Rigid(i.Suffix))
+	}
+
+	if i.ShowCopyButton {
+		if s.copy == nil {
+			s.copy = newSuffixButton(copyIcon)
+		}
+		icon := copyIcon
+		if !s.copiedAt.IsZero() {
+			if until := s.copiedAt.Add(copiedFeedback); gtx.Now.Before(until) {
+				icon = copiedIcon
+				gtx.Execute(op.InvalidateCmd{At: until})
+			} else {
+				s.copiedAt = time.Time{}
+			}
+		}
+		s.copy.SetIcon(icon)
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return s.copy.Layout(gtx, th)
+		}))
+	}
+
+	if i.showPaste() {
+		if s.paste == nil {
+			s.paste = newSuffixButton(pasteIcon)
+		}
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return s.paste.Layout(gtx, th)
+		}))
+	}
+
+	return layout.Inset{Right: th.Spacing.Space1}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, children...)
+	})
+}
+
+// newSuffixButton creates a ghost icon button for the suffix slot.
+func newSuffixButton(icon *widget.Icon) *button.Button {
+	return button.NewButton(
+		button.WithIcon(icon),
+		button.WithVariant(theme.VariantGhost),
+		button.WithSize(theme.SizeIcon),
+	)
+}
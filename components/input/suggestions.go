@@ -0,0 +1,273 @@
+package input
+
+import (
+	"image"
+	"slices"
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// DefaultMaxSuggestions is the number of suggestions shown when MaxSuggestions is zero.
+const DefaultMaxSuggestions = 5
+
+// suggestionState holds the autocomplete dropdown state.
+type suggestionState struct {
+	clicks      []widget.Clickable
+	matches     []string
+	highlighted int
+	dismissed   bool
+	dismissedAt string
+}
+
+// SetSuggestions replaces the autocomplete suggestions. A nil slice disables
+// autocomplete.
+func (i *Input) SetSuggestions(suggestions []string) {
+	i.Suggestions = suggestions
+	i.suggest.highlighted = -1
+}
+
+// matchSuggestions returns the suggestions containing text, case-insensitively,
+// limited to MaxSuggestions. A suggestion equal to text is not offered.
+func (i *Input) matchSuggestions(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	limit := i.MaxSuggestions
+	if limit <= 0 {
+		limit = DefaultMaxSuggestions
+	}
+
+	query := strings.ToLower(text)
+	var matches []string
+	for _, s := range i.Suggestions {
+		lower := strings.ToLower(s)
+		if lower == query || !strings.Contains(lower, query) {
+			continue
+		}
+		matches = append(matches, s)
+		if len(matches) == limit {
+			break
+		}
+	}
+	return matches
+}
+
+// processSuggestionKeys handles keyboard navigation of the dropdown: Down and
+// Up move the highlight, Enter picks the highlighted suggestion and Escape
+// dismisses the dropdown until the text changes.
+func (i *Input) processSuggestionKeys(gtx layout.Context) {
+	s := &i.suggest
+	if len(s.matches) == 0 {
+		return
+	}
+
+	filters := []event.Filter{
+		key.Filter{Focus: &i.editor, Name: key.NameDownArrow},
+		key.Filter{Focus: &i.editor, Name: key.NameUpArrow},
+		key.Filter{Focus: &i.editor, Name: key.NameEscape},
+	}
+	if s.highlighted >= 0 {
+		filters = append(filters,
+			key.Filter{Focus: &i.editor, Name: key.NameReturn},
+			key.Filter{Focus: &i.editor, Name: key.NameEnter},
+		)
+	}
+
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press {
+			continue
+		}
+
+		switch e.Name {
+		case key.NameDownArrow:
+			s.highlighted = (s.highlighted + 1) % len(s.matches)
+		case key.NameUpArrow:
+			if s.highlighted <= 0 {
+				s.highlighted = len(s.matches)
+			}
+			s.highlighted--
+		case key.NameEscape:
+			i.dismissSuggestions()
+		case key.NameReturn, key.NameEnter:
+			if s.highlighted < len(s.matches) {
+				i.selectSuggestion(gtx, s.matches[s.highlighted])
+			}
+		}
+	}
+}
+
+// selectSuggestion fills the input with suggestion and calls OnChange.
+func (i *Input) selectSuggestion(gtx layout.Context, suggestion string) {
+	i.SetText(suggestion)
+	end := i.editor.Len()
+	i.editor.SetCaret(end, end)
+	i.dismissSuggestions()
+	gtx.Execute(key.FocusCmd{Tag: &i.editor})
+
+	i.notifyChange(suggestion)
+}
+
+// dismissSuggestions hides the dropdown until the text changes.
+func (i *Input) dismissSuggestions() {
+	i.suggest.dismissed = true
+	i.suggest.dismissedAt = i.editor.Text()
+	i.suggest.highlighted = -1
+	i.suggest.matches = nil
+}
+
+// layoutSuggestions updates the suggestion matches and, when there are any,
+// defers the dropdown below a field of the given size.
+func (i *Input) layoutSuggestions(gtx layout.Context, th *theme.Theme, field image.Point) {
+	s := &i.suggest
+
+	text := i.editor.Text()
+	if s.dismissed && text != s.dismissedAt {
+		s.dismissed = false
+	}
+
+	// Keep the dropdown open while a suggestion is being clicked, since the
+	// press may take focus away from the editor.
+	interacting := false
+	for idx := range s.clicks {
+		if s.clicks[idx].Hovered() || s.clicks[idx].Pressed() {
+			interacting = true
+		}
+	}
+
+	previous := s.matches
+	s.matches = nil
+	if !s.dismissed && (i.focused || interacting) {
+		s.matches = i.matchSuggestions(text)
+	}
+	if !slices.Equal(previous, s.matches) {
+		s.highlighted = -1
+	}
+	if len(s.matches) == 0 {
+		return
+	}
+
+	if len(s.clicks) < len(s.matches) {
+		s.clicks = make([]widget.Clickable, len(s.matches))
+	}
+	for idx, match := range s.matches {
+		if s.clicks[idx].Clicked(gtx) {
+			i.selectSuggestion(gtx, match)
+			return
+		}
+	}
+
+	macro := op.Record(gtx.Ops)
+	offset := op.Offset(image.Pt(0, field.Y+gtx.Dp(unit.Dp(4)))).Push(gtx.Ops)
+	gtx.Constraints = layout.Exact(image.Pt(field.X, gtx.Constraints.Max.Y))
+	gtx.Constraints.Min.Y = 0
+	i.layoutSuggestionPanel(gtx, th, text)
+	offset.Pop()
+	op.Defer(gtx.Ops, macro.Stop())
+}
+
+// layoutSuggestionPanel renders the dropdown panel with one row per match.
+func (i *Input) layoutSuggestionPanel(gtx layout.Context, th *theme.Theme, query string) layout.Dimensions {
+	s := &i.suggest
+
+	macro := op.Record(gtx.Ops)
+	children := make([]layout.FlexChild, len(s.matches))
+	for idx, match := range s.matches {
+		children[idx] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return i.layoutSuggestionRow(gtx, th, idx, match, query)
+		})
+	}
+	dims := layout.UniformInset(th.Spacing.Space1).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+	call := macro.Stop()
+
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+	call.Add(gtx.Ops)
+
+	return dims
+}
+
+// layoutSuggestionRow renders a suggestion with the matched text in bold.
+func (i *Input) layoutSuggestionRow(gtx layout.Context, th *theme.Theme, idx int, suggestion, query string) layout.Dimensions {
+	click := &i.suggest.clicks[idx]
+	highlighted := idx == i.suggest.highlighted || click.Hovered()
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		macro := op.Record(gtx.Ops)
+		dims := layout.Inset{
+			Top:    th.Spacing.Space2,
+			Bottom: th.Spacing.Space2,
+			Left:   th.Spacing.Space2,
+			Right:  th.Spacing.Space2,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layoutHighlightedText(gtx, th, suggestion, query)
+		})
+		call := macro.Stop()
+
+		if highlighted {
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+		}
+		call.Add(gtx.Ops)
+
+		return dims
+	})
+}
+
+// layoutHighlightedText renders text with the first case-insensitive match of
+// query in bold.
+func layoutHighlightedText(gtx layout.Context, th *theme.Theme, text, query string) layout.Dimensions {
+	start := strings.Index(strings.ToLower(text), strings.ToLower(query))
+	// Lowercasing can change byte lengths for some scripts; skip bolding then
+	if start < 0 || len(strings.ToLower(text)) != len(text) {
+		return layoutSpan(gtx, th, text, font.Normal)
+	}
+	end := start + len(query)
+
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutSpan(gtx, th, text[:start], font.Normal)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutSpan(gtx, th, text[start:end], font.Bold)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutSpan(gtx, th, text[end:], font.Normal)
+		}),
+	)
+}
+
+// layoutSpan renders a run of suggestion text with the given weight.
+func layoutSpan(gtx layout.Context, th *theme.Theme, text string, weight font.Weight) layout.Dimensions {
+	if text == "" {
+		return layout.Dimensions{}
+	}
+	lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, text)
+	lbl.Color = th.Colors.PopoverFg
+	lbl.Font.Weight = weight
+	lbl.MaxLines = 1
+	return lbl.Layout(gtx)
+}
@@ -0,0 +1,80 @@
+package label
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/theme"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
+)
+
+// copiedDuration is how long the checkmark is shown after copying.
+const copiedDuration = 2 * time.Second
+
+// Glyphs shown on the copy button.
+const (
+	copyGlyph   = "⧉"
+	copiedGlyph = "✓"
+)
+
+// layoutCopyable renders the text with a copy button to its right. The button
+// is shown while the pointer hovers over the row and shows a checkmark for
+// two seconds after copying.
+func (t *Typography) layoutCopyable(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if t.copyButton == nil {
+		t.copyButton = button.NewButton(
+			button.WithText(copyGlyph),
+			button.WithVariant(theme.VariantGhost),
+			button.WithSize(theme.SizeIcon),
+		)
+	}
+
+	if t.copyButton.Clicked(gtx) {
+		t.copiedAt = gtx.Now
+		if t.Clipboard == nil {
+			t.Clipboard = clipboardutil.NewSystem()
+		}
+		text, cb := t.Text, t.Clipboard
+		go func() { _ = cb.Write(text) }()
+	}
+
+	copied := !t.copiedAt.IsZero() && gtx.Now.Sub(t.copiedAt) < copiedDuration
+	if copied {
+		t.copyButton.SetText(copiedGlyph)
+		gtx.Execute(op.InvalidateCmd{At: t.copiedAt.Add(copiedDuration)})
+	} else {
+		t.copyButton.SetText(copyGlyph)
+	}
+
+	hovered := t.hover.Update(gtx.Source)
+	visible := hovered || copied
+
+	macro := op.Record(gtx.Ops)
+	dims := layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return t.layoutText(gtx, th)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			// Reserve the button space so the text doesn't reflow on hover
+			btnMacro := op.Record(gtx.Ops)
+			btnDims := t.copyButton.Layout(gtx, th)
+			btnCall := btnMacro.Stop()
+			if visible {
+				btnCall.Add(gtx.Ops)
+			}
+			return btnDims
+		}),
+	)
+	call := macro.Stop()
+
+	area := clip.Rect(image.Rectangle{Max: dims.Size}).Push(gtx.Ops)
+	t.hover.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	area.Pop()
+
+	return dims
+}
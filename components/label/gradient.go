@@ -0,0 +1,95 @@
+package label
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// GradientText renders text filled with a linear gradient from StartColor to
+// EndColor. Direction is the gradient direction vector; the zero value runs
+// left to right. Text size and weight follow Element.
+//
+// Example usage:.
+//
+//	title := label.NewGradientText("Build faster", startColor, endColor, label.H1)
+//	title.Direction = image.Pt(1, 1) // Diagonal
+//	dims := title.Layout(gtx, th)
+type GradientText struct {
+	Text       string
+	StartColor color.NRGBA
+	EndColor   color.NRGBA
+	Direction  image.Point
+	Element    TypographyElement
+}
+
+// NewGradientText creates a left-to-right gradient text.
+func NewGradientText(text string, start, end color.NRGBA, element TypographyElement) *GradientText {
+	return &GradientText{
+		Text:       text,
+		StartColor: start,
+		EndColor:   end,
+		Direction:  image.Pt(1, 0),
+		Element:    element,
+	}
+}
+
+// Layout renders the text. It is shaped once to measure its bounds, then
+// drawn with a gradient material spanning them.
+func (g *GradientText) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	style := (&Typography{Element: g.Element}).getTextStyleForElement(th)
+	shaper := material.NewTheme().Shaper
+	fnt := font.Font{Weight: style.Weight, Style: style.Style}
+	lbl := widget.Label{Alignment: style.Alignment}
+
+	// Measure with a throwaway material
+	measure := op.Record(gtx.Ops)
+	dims := lbl.Layout(gtx, shaper, fnt, style.Size, g.Text, op.CallOp{})
+	measure.Stop()
+
+	gradient := op.Record(gtx.Ops)
+	start, end := gradientStops(image.Rectangle{Max: dims.Size}, g.Direction)
+	paint.LinearGradientOp{
+		Stop1:  start,
+		Color1: g.StartColor,
+		Stop2:  end,
+		Color2: g.EndColor,
+	}.Add(gtx.Ops)
+	fill := gradient.Stop()
+
+	return lbl.Layout(gtx, shaper, fnt, style.Size, g.Text, fill)
+}
+
+// gradientStops returns the stops of a gradient along dir that spans bounds,
+// so the start and end colors land on opposite edges or corners.
+func gradientStops(bounds image.Rectangle, dir image.Point) (f32.Point, f32.Point) {
+	if dir == (image.Point{}) {
+		dir = image.Pt(1, 0)
+	}
+	length := float32(math.Hypot(float64(dir.X), float64(dir.Y)))
+	ux, uy := float32(dir.X)/length, float32(dir.Y)/length
+
+	// Half the extent of bounds projected onto the direction
+	w, h := float32(bounds.Dx()), float32(bounds.Dy())
+	half := (abs(ux)*w + abs(uy)*h) / 2
+
+	center := f32.Pt(float32(bounds.Min.X)+w/2, float32(bounds.Min.Y)+h/2)
+	offset := f32.Pt(ux*half, uy*half)
+	return center.Sub(offset), center.Add(offset)
+}
+
+func abs(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,146 @@
+package label
+
+import (
+	"image"
+	"os/exec"
+	"runtime"
+
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+)
+
+// Interactive represents a clickable label, such as an inline hyperlink.
+// It wraps a Label with click and hover handling, renders its text in the
+// primary color, and draws an underline when Underline is set or while the
+// pointer hovers over it. When Href is set and OnClick is nil, clicking opens
+// the URL with the platform's default handler.
+//
+// Example usage:.
+//
+//	link := label.NewLink("Read the docs", "https://gioui.org")
+//	dims := link.Layout(gtx, th)
+type Interactive struct {
+	// State
+	clickable widget.Clickable
+
+	// Configuration
+	Label     *Label
+	OnClick   func()
+	Href      string
+	Underline bool
+}
+
+// NewLink creates a new Interactive label that opens href when clicked.
+// The options configure the wrapped Label.
+func NewLink(text, href string, options ...Option) *Interactive {
+	return &Interactive{
+		Label: NewLabel(append([]Option{WithLabelText(text)}, options...)...),
+		Href:  href,
+	}
+}
+
+// NewInteractive creates a new Interactive label that calls onClick when clicked.
+// The options configure the wrapped Label.
+func NewInteractive(text string, onClick func(), options ...Option) *Interactive {
+	return &Interactive{
+		Label:   NewLabel(append([]Option{WithLabelText(text)}, options...)...),
+		OnClick: onClick,
+	}
+}
+
+// Layout renders the interactive label and handles clicks.
+func (il *Interactive) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if il.clickable.Clicked(gtx) {
+		switch {
+		case il.OnClick != nil:
+			il.OnClick()
+		case il.Href != "":
+			_ = openURL(il.Href)
+		}
+	}
+
+	// Determine current state color
+	textColor := th.Colors.Primary
+	switch {
+	case il.clickable.Pressed():
+		textColor = colorutil.Darken(th.Colors.Primary, 0.2)
+	case il.clickable.Hovered():
+		textColor = colorutil.Darken(th.Colors.Primary, 0.1)
+	}
+
+	return il.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		pointer.CursorPointer.Add(gtx.Ops)
+
+		// Render the wrapped label with the state color
+		lbl := *il.Label
+		style := lbl.TextStyle
+		if style == (theme.TextStyle{}) {
+			style = lbl.getDefaultTextStyle(th)
+		}
+		style.Color = &theme.ColorScheme{Foreground: textColor}
+		lbl.TextStyle = style
+
+		dims := lbl.Layout(gtx, th)
+
+		// Reserve space for the underline so hovering doesn't shift the layout
+		thickness := gtx.Dp(unit.Dp(1))
+		if il.Underline || il.clickable.Hovered() {
+			underline := clip.Rect{
+				Min: image.Pt(0, dims.Size.Y),
+				Max: image.Pt(dims.Size.X, dims.Size.Y+thickness),
+			}
+			paint.FillShape(gtx.Ops, textColor, underline.Op())
+		}
+		dims.Size.Y += thickness
+
+		return dims
+	})
+}
+
+// Update returns the component state for Interactive.
+func (il *Interactive) Update(gtx layout.Context) theme.ComponentState {
+	return &State{
+		active:  il.clickable.Clicked(gtx),
+		hovered: il.clickable.Hovered(),
+		pressed: il.clickable.Pressed(),
+	}
+}
+
+// SetOnClick sets the click handler.
+func (il *Interactive) SetOnClick(onClick func()) {
+	il.OnClick = onClick
+}
+
+// SetHref sets the URL opened when the label is clicked without an OnClick handler.
+func (il *Interactive) SetHref(href string) {
+	il.Href = href
+}
+
+// openURL opens a URL with the platform's default handler.
+//
+//nolint:gosec // URL is provided by the application, not by end users
+func openURL(url string) error {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// Reap the handler so it does not linger as a zombie process
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
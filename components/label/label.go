@@ -57,6 +57,10 @@ Available sizes:
 • Variant-based color schemes
 • Size-based font scaling
 • CSS-like class utilities support
+• Copy-to-clipboard button for Typography (Copyable)
+• Selectable text with a right-click copy menu (Selectable)
+• Opacity for dimmed text (Opacity)
+• GradientText for text filled with a linear gradient
 
 # Examples
 
@@ -96,11 +100,16 @@ package label
 
 import (
 	"image/color"
+	"time"
 
+	"gioui.org/gesture"
 	"gioui.org/layout"
+	"gioui.org/widget"
 	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/button"
 	"github.com/bnema/gio-shadcn/theme"
 	"github.com/bnema/gio-shadcn/utils"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
 )
 
 // Label represents a shadcn/ui label component.
@@ -285,11 +294,32 @@ func (l *Label) SetTextStyle(style theme.TextStyle) {
 }
 
 // Typography component for various text elements.
+// When Copyable is set, a copy-to-clipboard button appears next to the text
+// on hover. When Selectable is set, the text can be selected with the pointer
+// and copied with the keyboard shortcut or a right-click menu.
 type Typography struct {
-	Text      string
-	Element   TypographyElement
-	Classes   string
-	TextStyle theme.TextStyle
+	// State
+	hover      gesture.Hover
+	copyButton *button.Button
+	copiedAt   time.Time
+	selectable widget.Selectable
+	menu       contextMenu
+
+	// Configuration
+	Text       string
+	Element    TypographyElement
+	Classes    string
+	TextStyle  theme.TextStyle
+	Copyable   bool
+	Selectable bool
+
+	// Clipboard receives the text copied by the copy button. The system
+	// clipboard is used when nil.
+	Clipboard clipboardutil.Interface
+
+	// Opacity dims the text color by multiplying its alpha. Zero leaves it
+	// unchanged.
+	Opacity float32
 }
 
 // TypographyElement represents different typography elements.
@@ -327,6 +357,14 @@ func NewTypography(text string, element TypographyElement, classes string) *Typo
 
 // Layout renders the typography component.
 func (t *Typography) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if t.Copyable {
+		return t.layoutCopyable(gtx, th)
+	}
+	return t.layoutText(gtx, th)
+}
+
+// layoutText renders the typography text.
+func (t *Typography) layoutText(gtx layout.Context, th *theme.Theme) layout.Dimensions {
 	// Parse additional classes
 	styles := utils.ParseClasses(t.Classes)
 
@@ -358,6 +396,14 @@ func (t *Typography) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensio
 		label.Color = styles.Background
 	}
 
+	if t.Opacity > 0 {
+		label.Color = utils.ApplyOpacity(label.Color, t.Opacity)
+	}
+
+	if t.Selectable {
+		return t.layoutSelectable(gtx, th, label)
+	}
+
 	return label.Layout(gtx)
 }
 
@@ -409,6 +455,26 @@ func (t *Typography) SetText(text string) {
 	t.Text = text
 }
 
+// SetCopyable enables or disables the copy-to-clipboard button.
+func (t *Typography) SetCopyable(copyable bool) {
+	t.Copyable = copyable
+}
+
+// SetSelectable enables or disables text selection.
+func (t *Typography) SetSelectable(selectable bool) {
+	t.Selectable = selectable
+}
+
+// SelectedText returns the currently selected text of a selectable typography.
+func (t *Typography) SelectedText() string {
+	return t.selectable.SelectedText()
+}
+
+// SetOpacity dims the text color by opacity (0-1).
+func (t *Typography) SetOpacity(opacity float32) {
+	t.Opacity = opacity
+}
+
 // SetElement sets the typography element.
 func (t *Typography) SetElement(element TypographyElement) {
 	t.Element = element
@@ -0,0 +1,156 @@
+package label
+
+import (
+	"image"
+	"io"
+	"strings"
+
+	"gioui.org/io/clipboard"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/theme"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+	"github.com/bnema/gio-shadcn/utils/overlay"
+)
+
+// selectionAlpha is the opacity of the selection highlight (30%).
+const selectionAlpha = 77
+
+// contextMenu is the right-click menu of a selectable typography.
+type contextMenu struct {
+	open    bool
+	pos     image.Point
+	copy    widget.Clickable
+	dismiss int
+	// panel covers the menu so presses on it don't dismiss it
+	panel int
+	// origin and window place the menu inside the window, see SetViewport
+	origin image.Point
+	window image.Point
+}
+
+// contextMenuResolver opens the context menu down and to the right of the
+// pointer, flipping it above when it would clip.
+var contextMenuResolver = popover.PositionResolver{AutoFlip: true, Align: popover.AlignStart}
+
+// SetViewport sets the typography's position in the window and the window
+// size, both in pixels, used to keep the context menu of selectable text
+// inside the window.
+func (t *Typography) SetViewport(origin, window image.Point) {
+	t.menu.origin = origin
+	t.menu.window = window
+}
+
+// layoutSelectable renders label as selectable text with a right-click menu.
+func (t *Typography) layoutSelectable(gtx layout.Context, th *theme.Theme, label material.LabelStyle) layout.Dimensions {
+	t.processContextMenu(gtx)
+
+	label.State = &t.selectable
+	label.SelectionColor = colorutil.WithAlpha(th.Colors.Primary, selectionAlpha)
+
+	macro := op.Record(gtx.Ops)
+	dims := label.Layout(gtx)
+	call := macro.Stop()
+
+	// The menu area encloses the label so selection still gets pointer events
+	area := clip.Rect(image.Rectangle{Max: dims.Size}).Push(gtx.Ops)
+	event.Op(gtx.Ops, &t.menu)
+	pointer.CursorText.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	area.Pop()
+
+	if t.menu.open {
+		t.layoutContextMenu(gtx, th)
+	}
+
+	return dims
+}
+
+// processContextMenu opens the menu on right-click, closes it on clicks
+// elsewhere, and copies the selection when "Copy" is clicked.
+func (t *Typography) processContextMenu(gtx layout.Context) {
+	m := &t.menu
+
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: m, Kinds: pointer.Press})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok && e.Buttons.Contain(pointer.ButtonSecondary) {
+			m.open = true
+			m.pos = e.Position.Round()
+		}
+	}
+
+	if overlay.Dismissed(gtx, &m.dismiss) {
+		m.open = false
+	}
+
+	if m.copy.Clicked(gtx) {
+		text := t.selectable.SelectedText()
+		if text == "" {
+			text = t.selectable.Text()
+		}
+		gtx.Execute(clipboard.WriteCmd{Type: "application/text", Data: io.NopCloser(strings.NewReader(text))})
+		m.open = false
+	}
+}
+
+// layoutContextMenu defers the context menu at the right-click position,
+// flipped or shifted to stay inside the window.
+func (t *Typography) layoutContextMenu(gtx layout.Context, th *theme.Theme) {
+	m := &t.menu
+
+	window := m.window
+	if window == (image.Point{}) {
+		window = m.origin.Add(gtx.Constraints.Max)
+	}
+
+	macro := op.Record(gtx.Ops)
+	gtx.Constraints.Min = image.Point{}
+	dims := m.copy.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		inner := op.Record(gtx.Ops)
+		dims := layout.Inset{
+			Top:    th.Spacing.Space1,
+			Bottom: th.Spacing.Space1,
+			Left:   th.Spacing.Space3,
+			Right:  th.Spacing.Space3,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, th.Locale.T(i18n.KeyCopy))
+			lbl.Color = th.Colors.PopoverFg
+			return lbl.Layout(gtx)
+		})
+		content := inner.Stop()
+
+		bg := th.Colors.Popover
+		if m.copy.Hovered() {
+			bg = th.Colors.Accent
+		}
+		rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+		paint.FillShape(gtx.Ops, bg, rr.Op(gtx.Ops))
+		paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(1))),
+		}.Op())
+		content.Add(gtx.Ops)
+
+		return dims
+	})
+	call := macro.Stop()
+
+	panel := op.Record(gtx.Ops)
+	overlay.Block(gtx, &m.panel, dims.Size)
+	call.Add(gtx.Ops)
+
+	pos, _ := contextMenuResolver.Resolve(image.Rectangle{Min: m.pos, Max: m.pos}.Add(m.origin), dims.Size, popover.PlacementBottom, window)
+	overlay.Defer(gtx, pos.Sub(m.origin), &m.dismiss, panel.Stop())
+}
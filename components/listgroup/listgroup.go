@@ -0,0 +1,255 @@
+/*
+Package listgroup provides a sectioned, scrollable list for gio-shadcn applications.
+
+A ListGroup renders items grouped under section headers. Headers are not
+selectable; clicking an item selects it and reports its value through OnSelect.
+With sticky headers enabled, the header of the section at the top of the
+visible area stays pinned while its items scroll underneath.
+
+# Quick Start
+
+Create a list group:
+
+	lg := listgroup.NewListGroup([]listgroup.ListGroupSection{
+		{Header: "Fruits", Items: []listgroup.ListItem{
+			{Value: "apple", Label: "Apple"},
+			{Value: "banana", Label: "Banana"},
+		}},
+		{Header: "Vegetables", Items: []listgroup.ListItem{
+			{Value: "carrot", Label: "Carrot"},
+		}},
+	}, listgroup.WithStickyHeaders(true), listgroup.WithOnSelect(func(value string) {
+		log.Println("selected", value)
+	}))
+
+Use in layout:
+
+	dims := lg.Layout(gtx, th)
+*/
+package listgroup
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// ListItem represents a selectable entry in a section.
+type ListItem struct {
+	Value    string
+	Label    string
+	Disabled bool
+}
+
+// ListGroupSection is a titled group of items.
+//
+//nolint:revive // ListGroupSection is the documented public name
+type ListGroupSection struct {
+	Header string
+	Items  []ListItem
+}
+
+// ListGroup represents a list of items grouped in sections.
+//
+//nolint:revive // ListGroup mirrors the component name
+type ListGroup struct {
+	// State
+	list   widget.List
+	clicks [][]widget.Clickable
+
+	// Configuration
+	Groups        []ListGroupSection
+	StickyHeaders bool
+	OnSelect      func(string)
+	Selected      string
+}
+
+// Option is a functional option for configuring ListGroup components.
+type Option func(*ListGroup)
+
+// WithStickyHeaders pins the current section header at the top while scrolling.
+func WithStickyHeaders(sticky bool) Option {
+	return func(lg *ListGroup) {
+		lg.StickyHeaders = sticky
+	}
+}
+
+// WithOnSelect sets the selection handler, called with the item value.
+func WithOnSelect(onSelect func(string)) Option {
+	return func(lg *ListGroup) {
+		lg.OnSelect = onSelect
+	}
+}
+
+// WithSelected sets the initially selected value.
+func WithSelected(value string) Option {
+	return func(lg *ListGroup) {
+		lg.Selected = value
+	}
+}
+
+// NewListGroup creates a new ListGroup with the given sections and options.
+func NewListGroup(groups []ListGroupSection, opts ...Option) *ListGroup {
+	lg := &ListGroup{
+		Groups: groups,
+	}
+	lg.list.Axis = layout.Vertical
+
+	for _, opt := range opts {
+		opt(lg)
+	}
+
+	return lg
+}
+
+// row addresses a header (item < 0) or an item within a section.
+type row struct {
+	section int
+	item    int
+}
+
+// Layout renders the list and, when enabled, the sticky header overlay.
+func (lg *ListGroup) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	lg.syncClickables()
+	lg.processClicks(gtx)
+
+	rows := lg.rows()
+
+	return layout.Stack{}.Layout(gtx,
+		// Content
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return lg.list.Layout(gtx, len(rows), func(gtx layout.Context, index int) layout.Dimensions {
+				r := rows[index]
+				if r.item < 0 {
+					return lg.layoutHeader(gtx, th, lg.Groups[r.section].Header)
+				}
+				return lg.layoutItem(gtx, th, r)
+			})
+		}),
+
+		// Sticky header overlay
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			if !lg.StickyHeaders || len(rows) == 0 {
+				return layout.Dimensions{}
+			}
+
+			// The section of the first visible row owns the pinned header. Skip
+			// pinning while that section's own header is fully in view.
+			first := lg.list.Position.First
+			if first >= len(rows) || (rows[first].item < 0 && lg.list.Position.Offset == 0) {
+				return layout.Dimensions{}
+			}
+
+			return lg.layoutStickyHeader(gtx, th, lg.Groups[rows[first].section].Header)
+		}),
+	)
+}
+
+// rows flattens the sections into headers followed by their items.
+func (lg *ListGroup) rows() []row {
+	var rows []row
+	for s, group := range lg.Groups {
+		rows = append(rows, row{section: s, item: -1})
+		for i := range group.Items {
+			rows = append(rows, row{section: s, item: i})
+		}
+	}
+	return rows
+}
+
+// syncClickables keeps one clickable per item as groups change.
+func (lg *ListGroup) syncClickables() {
+	if len(lg.clicks) != len(lg.Groups) {
+		lg.clicks = make([][]widget.Clickable, len(lg.Groups))
+	}
+	for s, group := range lg.Groups {
+		if len(lg.clicks[s]) != len(group.Items) {
+			lg.clicks[s] = make([]widget.Clickable, len(group.Items))
+		}
+	}
+}
+
+func (lg *ListGroup) processClicks(gtx layout.Context) {
+	for s, group := range lg.Groups {
+		for i, item := range group.Items {
+			if lg.clicks[s][i].Clicked(gtx) && !item.Disabled {
+				lg.Selected = item.Value
+				if lg.OnSelect != nil {
+					lg.OnSelect(item.Value)
+				}
+			}
+		}
+	}
+}
+
+func (lg *ListGroup) layoutHeader(gtx layout.Context, th *theme.Theme, header string) layout.Dimensions {
+	return layout.Inset{
+		Top:    th.Spacing.Space3,
+		Bottom: th.Spacing.Space1,
+		Left:   th.Spacing.Space2,
+		Right:  th.Spacing.Space2,
+	}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return label.NewTypography(header, label.Muted, "").Layout(gtx, th)
+	})
+}
+
+// layoutStickyHeader draws the pinned header over the content with a subtle
+// bottom shadow.
+func (lg *ListGroup) layoutStickyHeader(gtx layout.Context, th *theme.Theme, header string) layout.Dimensions {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	macro := op.Record(gtx.Ops)
+	dims := lg.layoutHeader(gtx, th, header)
+	call := macro.Stop()
+
+	paint.FillShape(gtx.Ops, th.Colors.Background, clip.Rect{Max: dims.Size}.Op())
+	call.Add(gtx.Ops)
+
+	// Shadow fading out below the header
+	shadow := gtx.Dp(unit.Dp(3))
+	for i := 0; i < shadow; i++ {
+		alpha := uint8(0x18 * (shadow - i) / shadow)
+		line := clip.Rect{
+			Min: image.Pt(0, dims.Size.Y+i),
+			Max: image.Pt(dims.Size.X, dims.Size.Y+i+1),
+		}
+		paint.FillShape(gtx.Ops, color.NRGBA{A: alpha}, line.Op())
+	}
+
+	return dims
+}
+
+func (lg *ListGroup) layoutItem(gtx layout.Context, th *theme.Theme, r row) layout.Dimensions {
+	item := lg.Groups[r.section].Items[r.item]
+	click := &lg.clicks[r.section][r.item]
+
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+		macro := op.Record(gtx.Ops)
+		dims := layout.UniformInset(th.Spacing.Space2).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			element := label.Small
+			if item.Disabled {
+				element = label.Muted
+			}
+			return label.NewTypography(item.Label, element, "").Layout(gtx, th)
+		})
+		call := macro.Stop()
+
+		if !item.Disabled && (item.Value == lg.Selected || click.Hovered()) {
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+		}
+		call.Add(gtx.Ops)
+
+		return dims
+	})
+}
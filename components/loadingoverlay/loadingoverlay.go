@@ -0,0 +1,310 @@
+/*
+Package loadingoverlay provides a full-area loading overlay for gio-shadcn applications.
+
+The loading overlay renders on top of existing content while an asynchronous
+operation blocks the UI. It dims the content with an optional backdrop, shows an
+indeterminate spinner with an optional message in the center, and swallows pointer
+input so the content underneath cannot be interacted with.
+
+# Quick Start
+
+Create an overlay and wrap your content:
+
+	overlay := loadingoverlay.NewLoadingOverlay(
+		loadingoverlay.WithBackdrop(true),
+	)
+
+	dims := overlay.Layout(gtx, th, content)
+
+Show and hide it around an operation. The overlay belongs to the UI goroutine,
+so a background operation hands its result back to the frame loop, which
+hides the overlay:
+
+	overlay.Show("Saving changes...")
+	saved := make(chan error, 1)
+	go func() {
+		saved <- save()
+		w.Invalidate()
+	}()
+
+	// In the frame loop, before laying out the overlay
+	select {
+	case err := <-saved:
+		overlay.Hide()
+		handleSaveError(err)
+	default:
+	}
+
+# Sizes
+
+Available spinner sizes:
+• OverlaySizeSM - Small spinner for compact areas
+• OverlaySizeMD - Standard spinner for most use cases
+• OverlaySizeLG - Large spinner for full-window overlays
+
+# Features
+
+• Semi-transparent backdrop over the wrapped content
+• Indeterminate spinner with optional message
+• Smooth fade-in and fade-out
+• Blocks pointer and keyboard input to the content underneath
+*/
+package loadingoverlay
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/spinner"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// OverlaySize represents the size of the overlay spinner.
+type OverlaySize string
+
+// Overlay sizes.
+const (
+	OverlaySizeSM OverlaySize = "sm"
+	OverlaySizeMD OverlaySize = "md"
+	OverlaySizeLG OverlaySize = "lg"
+)
+
+// backdropAlpha is the alpha of the backdrop fill when Backdrop is enabled.
+const backdropAlpha = 0x80
+
+// LoadingOverlay represents a loading overlay component. Like Layout, its
+// methods and fields must only be used from the UI goroutine.
+type LoadingOverlay struct {
+	// Configuration
+	Visible  bool
+	Message  string
+	Backdrop bool
+	Size     OverlaySize
+
+	// Internal
+	fade animation.Animator
+}
+
+// Option is a functional option for configuring LoadingOverlay components.
+type Option func(*LoadingOverlay)
+
+// WithMessage sets the message shown below the spinner.
+func WithMessage(message string) Option {
+	return func(lo *LoadingOverlay) {
+		lo.Message = message
+	}
+}
+
+// WithBackdrop sets whether the overlay dims the content underneath.
+func WithBackdrop(backdrop bool) Option {
+	return func(lo *LoadingOverlay) {
+		lo.Backdrop = backdrop
+	}
+}
+
+// WithSize sets the spinner size.
+func WithSize(size OverlaySize) Option {
+	return func(lo *LoadingOverlay) {
+		lo.Size = size
+	}
+}
+
+// WithVisible sets the initial visibility.
+func WithVisible(visible bool) Option {
+	return func(lo *LoadingOverlay) {
+		lo.Visible = visible
+	}
+}
+
+// NewLoadingOverlay creates a new LoadingOverlay with the given options.
+func NewLoadingOverlay(options ...Option) *LoadingOverlay {
+	lo := &LoadingOverlay{
+		Backdrop: true,
+		Size:     OverlaySizeMD,
+	}
+	lo.fade.Duration = 200 * time.Millisecond
+
+	for _, option := range options {
+		option(lo)
+	}
+
+	if lo.Visible {
+		lo.fade.Set(1)
+	}
+
+	return lo
+}
+
+// Show makes the overlay visible with the given message. Call it from the UI
+// goroutine.
+func (lo *LoadingOverlay) Show(message string) {
+	lo.Message = message
+	lo.Visible = true
+}
+
+// Hide fades the overlay out. Call it from the UI goroutine; background work
+// should pass its result back to the frame loop first.
+func (lo *LoadingOverlay) Hide() {
+	lo.Visible = false
+}
+
+// Layout renders content and, when visible, the overlay on top of it.
+// While the overlay shows, content is laid out disabled so it receives
+// neither pointer nor key events.
+func (lo *LoadingOverlay) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	if lo.Visible {
+		lo.fade.Animate(1)
+	} else {
+		lo.fade.Animate(0)
+	}
+	if th.ReducedMotion() {
+		lo.fade.Set(lo.fade.Target())
+	}
+	opacity := lo.fade.Value(gtx)
+
+	// A focused widget underneath would still get Tab, Enter and Space
+	contentGtx := gtx
+	if lo.Visible || opacity > 0 {
+		contentGtx = gtx.Disabled()
+	}
+	dims := content(contentGtx)
+
+	if opacity <= 0 {
+		return dims
+	}
+
+	lo.layoutOverlay(gtx, th, opacity)
+
+	return dims
+}
+
+func (lo *LoadingOverlay) layoutOverlay(gtx layout.Context, th *theme.Theme, opacity float32) {
+	defer paint.PushOpacity(gtx.Ops, opacity).Pop()
+
+	area := clip.Rect{Max: gtx.Constraints.Max}.Push(gtx.Ops)
+
+	// Swallow pointer input so the content underneath stays inert
+	for {
+		_, ok := gtx.Event(pointer.Filter{
+			Target: lo,
+			Kinds:  pointer.Press | pointer.Release | pointer.Move | pointer.Drag | pointer.Scroll,
+		})
+		if !ok {
+			break
+		}
+	}
+	event.Op(gtx.Ops, lo)
+
+	var alpha uint8
+	if lo.Backdrop {
+		alpha = backdropAlpha
+	}
+	paint.ColorOp{Color: color.NRGBA{A: alpha}}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	area.Pop()
+
+	gtx.Constraints.Min = gtx.Constraints.Max
+	layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return lo.layoutPanel(gtx, th)
+	})
+}
+
+func (lo *LoadingOverlay) layoutPanel(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	gtx.Constraints.Min = image.Point{}
+
+	macro := op.Record(gtx.Ops)
+	dims := layout.UniformInset(th.Spacing.Space6).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		children := []layout.FlexChild{
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return lo.layoutSpinner(gtx, th)
+			}),
+		}
+
+		if lo.Message != "" {
+			children = append(children,
+				layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return label.NewTypography(lo.Message, label.P, "").Layout(gtx, th)
+				}),
+			)
+		}
+
+		return layout.Flex{
+			Axis:      layout.Vertical,
+			Alignment: layout.Middle,
+		}.Layout(gtx, children...)
+	})
+	call := macro.Stop()
+
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusLG))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+	call.Add(gtx.Ops)
+
+	return dims
+}
+
+// layoutSpinner draws an indeterminate spinning arc.
+func (lo *LoadingOverlay) layoutSpinner(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	size := lo.getSpinnerSize()
+	return spinner.NewSpinner(size, spinner.WithThickness(size/10)).Layout(gtx, th)
+}
+
+func (lo *LoadingOverlay) getSpinnerSize() unit.Dp {
+	switch lo.Size {
+	case OverlaySizeSM:
+		return unit.Dp(24)
+	case OverlaySizeLG:
+		return unit.Dp(56)
+	default: // OverlaySizeMD
+		return unit.Dp(40)
+	}
+}
+
+// Update returns the component state for LoadingOverlay.
+func (lo *LoadingOverlay) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active: lo.Visible,
+	}
+}
+
+// State implements ComponentState for LoadingOverlay.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the overlay is visible.
+func (ls *State) IsActive() bool {
+	return ls.active
+}
+
+// IsHovered returns true if the overlay is being hovered over.
+func (ls *State) IsHovered() bool {
+	return ls.hovered
+}
+
+// IsPressed returns true if the overlay is being pressed.
+func (ls *State) IsPressed() bool {
+	return ls.pressed
+}
+
+// IsDisabled returns true if the overlay is disabled.
+func (ls *State) IsDisabled() bool {
+	return ls.disabled
+}
@@ -0,0 +1,173 @@
+package menubar
+
+import (
+	"image"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// ParseAccelerator returns label without its accelerator marker and the
+// accelerator character in upper case. The accelerator is the character
+// following a "&" marker ("&&" is a literal ampersand), or the first upper case
+// letter when there is no marker. The accelerator is 0 if none is found.
+//
+// Example:.
+//
+//	clean, accel := menubar.ParseAccelerator("Save &As...") // "Save As...", 'A'
+func ParseAccelerator(text string) (cleanLabel string, accel rune) {
+	clean, index := parseAccelerator(text)
+	if index < 0 {
+		return clean, 0
+	}
+	r, _ := utf8.DecodeRuneInString(clean[index:])
+	return clean, unicode.ToUpper(r)
+}
+
+// parseAccelerator returns the clean label and the byte index of the
+// accelerator character in it, or -1.
+func parseAccelerator(text string) (string, int) {
+	if strings.Contains(text, "&") {
+		var sb strings.Builder
+		index := -1
+		for i := 0; i < len(text); i++ {
+			if text[i] != '&' || i == len(text)-1 {
+				sb.WriteByte(text[i])
+				continue
+			}
+			i++
+			if text[i] != '&' && index < 0 {
+				index = sb.Len()
+			}
+			sb.WriteByte(text[i])
+		}
+		if index >= 0 {
+			return sb.String(), index
+		}
+		// Only literal ampersands, so there is no marker
+		text = sb.String()
+	}
+
+	for i, r := range text {
+		if unicode.IsUpper(r) {
+			return text, i
+		}
+	}
+	return text, -1
+}
+
+// acceleratorName returns the key name for an accelerator character.
+func acceleratorName(accel rune) key.Name {
+	return key.Name(string(unicode.ToUpper(accel)))
+}
+
+// processAccelerators opens menus on Alt+letter and, while a menu is open,
+// selects the item whose accelerator letter is pressed.
+func (mb *MenuBar) processAccelerators(gtx layout.Context) {
+	for i := range mb.Menus {
+		_, accel := ParseAccelerator(mb.Menus[i].Label)
+		if accel == 0 {
+			continue
+		}
+		filter := key.Filter{Name: acceleratorName(accel), Required: key.ModAlt}
+		for {
+			ev, ok := gtx.Event(filter)
+			if !ok {
+				break
+			}
+			if e, ok := ev.(key.Event); ok && e.State == key.Press {
+				mb.open = i
+			}
+		}
+	}
+
+	if mb.open < 0 || mb.open >= len(mb.Menus) {
+		return
+	}
+
+	menu := &mb.Menus[mb.open]
+	var filters []event.Filter
+	for i := range menu.Items {
+		_, accel := ParseAccelerator(menu.Items[i].Label)
+		if accel != 0 && !menu.Items[i].Separator {
+			filters = append(filters, key.Filter{Name: acceleratorName(accel), Optional: key.ModShift})
+		}
+	}
+	if len(filters) == 0 {
+		return
+	}
+
+	for {
+		ev, ok := gtx.Event(filters...)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press {
+			continue
+		}
+		for i := range menu.Items {
+			item := &menu.Items[i]
+			_, accel := ParseAccelerator(item.Label)
+			if item.Separator || item.Disabled || acceleratorName(accel) != e.Name {
+				continue
+			}
+			mb.open = -1
+			if item.OnSelect != nil {
+				item.OnSelect()
+			}
+			return
+		}
+	}
+}
+
+// layoutAcceleratedLabel renders a menu label with its accelerator underlined.
+func layoutAcceleratedLabel(gtx layout.Context, th *theme.Theme, text string, element label.TypographyElement) layout.Dimensions {
+	clean, index := parseAccelerator(text)
+	if index < 0 {
+		return label.NewTypography(clean, element, "").Layout(gtx, th)
+	}
+	_, size := utf8.DecodeRuneInString(clean[index:])
+	before, accel, after := clean[:index], clean[index:index+size], clean[index+size:]
+
+	lineColor := th.Colors.Foreground
+	if element == label.Muted {
+		lineColor = th.Colors.MutedFg
+	}
+
+	gtx.Constraints.Min.X = 0
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if before == "" {
+				return layout.Dimensions{}
+			}
+			return label.NewTypography(before, element, "").Layout(gtx, th)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			dims := label.NewTypography(accel, element, "").Layout(gtx, th)
+
+			thickness := gtx.Dp(unit.Dp(1))
+			underline := clip.Rect{
+				Min: image.Pt(0, dims.Size.Y-thickness),
+				Max: image.Pt(dims.Size.X, dims.Size.Y),
+			}
+			paint.FillShape(gtx.Ops, lineColor, underline.Op())
+			return dims
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if after == "" {
+				return layout.Dimensions{}
+			}
+			return label.NewTypography(after, element, "").Layout(gtx, th)
+		}),
+	)
+}
@@ -0,0 +1,455 @@
+/*
+Package menubar provides an application menu bar component for gio-shadcn applications.
+
+The menu bar renders a horizontal strip of menu triggers. Clicking a trigger opens
+a dropdown with the menu's items; selecting an item runs its handler and closes
+the menu. Items can declare a keyboard shortcut such as "Ctrl+S", which is both
+displayed next to the item and registered as a global keyboard accelerator.
+
+On macOS the native application menu bar is preferred. This component is intended
+for cross-platform frameless windows, typically placed inside a titlebar with
+titlebar.WithMenuBar.
+
+# Quick Start
+
+Create a menu bar:
+
+	mb := menubar.NewMenuBar(
+		menubar.Menu{
+			Label: "File",
+			Items: []menubar.MenuItem{
+				{Label: "New", Shortcut: "Ctrl+N", OnSelect: newFile},
+				{Label: "Open...", Shortcut: "Ctrl+O", OnSelect: openFile},
+				{Separator: true},
+				{Label: "Quit", Shortcut: "Ctrl+Q", OnSelect: quit},
+			},
+		},
+	)
+
+Use in layout:
+
+	dims := mb.Layout(gtx, th)
+
+Dropdowns open below their trigger and flip above it or shift sideways to stay
+inside the window. Give the bar's window position to use the whole window:
+
+	mb.SetViewport(barOrigin, windowSize)
+
+# Shortcuts
+
+Shortcuts are written as modifier names joined with "+" followed by a key name:
+• Ctrl, Shift, Alt, Cmd, Super - Modifier keys
+• Mod - Cmd on macOS and Ctrl elsewhere
+• A-Z, 0-9, F1-F12 - Key names
+
+Shortcuts are displayed as keycaps with the platform's modifier symbols, using
+the shortcut package.
+
+# Accelerators
+
+Each menu and item has an accelerator letter, shown underlined. Mark it with
+"&" ("&File", "Save &As..."), or the first upper case letter is used. Alt plus
+a menu's letter opens that menu; while a menu is open, pressing an item's
+letter selects it.
+
+# Features
+
+• Horizontal menu triggers with hover and open states
+• Dropdown menus rendered above other content
+• Separators and disabled items
+• Keyboard shortcuts displayed and registered as accelerators
+• Keyboard accelerators: Alt+letter opens a menu, a letter picks an item
+• Close on Escape, outside click, or item selection
+*/
+package menubar
+
+import (
+	"image"
+	"strings"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/components/shortcut"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/overlay"
+)
+
+// dropdownMinWidth is the minimum width of an open menu.
+const dropdownMinWidth = unit.Dp(200)
+
+// MenuItem represents a single entry in a menu.
+type MenuItem struct {
+	Label     string
+	Shortcut  string
+	OnSelect  func()
+	Disabled  bool
+	Separator bool
+
+	// Internal
+	clickable widget.Clickable
+}
+
+// Menu represents a top-level menu with its items.
+type Menu struct {
+	Label string
+	Items []MenuItem
+
+	// Internal
+	clickable widget.Clickable
+	offset    int
+	width     int
+}
+
+// MenuBar represents an application menu bar.
+type MenuBar struct {
+	// Configuration
+	Menus []Menu
+
+	// Internal
+	open    int
+	dismiss int
+	origin  image.Point
+	window  image.Point
+}
+
+// NewMenuBar creates a new MenuBar with the given menus.
+func NewMenuBar(menus ...Menu) *MenuBar {
+	return &MenuBar{
+		Menus: menus,
+		open:  -1,
+	}
+}
+
+// IsOpen returns true if one of the menus is open.
+func (mb *MenuBar) IsOpen() bool {
+	return mb.open >= 0
+}
+
+// Close closes the open menu, if any.
+func (mb *MenuBar) Close() {
+	mb.open = -1
+}
+
+// SetViewport sets the menu bar's position in the window and the window size,
+// both in pixels, used to keep dropdowns inside the window.
+func (mb *MenuBar) SetViewport(origin, window image.Point) {
+	mb.origin = origin
+	mb.window = window
+}
+
+// Layout renders the menu bar and, when open, the active menu's dropdown.
+func (mb *MenuBar) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	mb.processShortcuts(gtx)
+	mb.processAccelerators(gtx)
+	mb.processKeys(gtx)
+
+	// Toggle menus from their triggers
+	for i := range mb.Menus {
+		if mb.Menus[i].clickable.Clicked(gtx) {
+			if mb.open == i {
+				mb.open = -1
+			} else {
+				mb.open = i
+			}
+		}
+	}
+
+	// Triggers are laid out left to right without gaps, so each trigger's
+	// offset is the running width of the triggers before it.
+	x := 0
+	children := make([]layout.FlexChild, 0, len(mb.Menus))
+	for i := range mb.Menus {
+		index := i
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			mb.Menus[index].offset = x
+			dims := mb.layoutTrigger(gtx, th, index)
+			mb.Menus[index].width = dims.Size.X
+			x += dims.Size.X
+			return dims
+		}))
+	}
+
+	dims := layout.Flex{
+		Axis:      layout.Horizontal,
+		Alignment: layout.Middle,
+	}.Layout(gtx, children...)
+
+	if mb.open >= 0 && mb.open < len(mb.Menus) {
+		window := mb.window
+		if window == (image.Point{}) {
+			window = mb.origin.Add(gtx.Constraints.Max)
+		}
+		mb.deferDropdown(gtx, th, dims.Size.Y, window)
+	}
+
+	return dims
+}
+
+func (mb *MenuBar) layoutTrigger(gtx layout.Context, th *theme.Theme, index int) layout.Dimensions {
+	menu := &mb.Menus[index]
+
+	return menu.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		macro := op.Record(gtx.Ops)
+		dims := layout.Inset{
+			Top:    th.Spacing.Space1,
+			Bottom: th.Spacing.Space1,
+			Left:   th.Spacing.Space3,
+			Right:  th.Spacing.Space3,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layoutAcceleratedLabel(gtx, th, menu.Label, label.Small)
+		})
+		call := macro.Stop()
+
+		if mb.open == index || menu.clickable.Hovered() {
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+		}
+		call.Add(gtx.Ops)
+
+		return dims
+	})
+}
+
+// deferDropdown records the open menu, positions it below its trigger and
+// defers it so it draws above other content.
+func (mb *MenuBar) deferDropdown(gtx layout.Context, th *theme.Theme, barHeight int, window image.Point) {
+	menu := &mb.Menus[mb.open]
+
+	// Close when clicking outside the dropdown
+	if overlay.Dismissed(gtx, &mb.dismiss) {
+		mb.open = -1
+		return
+	}
+
+	// Select items
+	for i := range menu.Items {
+		item := &menu.Items[i]
+		if item.clickable.Clicked(gtx) && !item.Disabled && !item.Separator {
+			mb.open = -1
+			if item.OnSelect != nil {
+				item.OnSelect()
+			}
+			return
+		}
+	}
+
+	macro := op.Record(gtx.Ops)
+	gtx.Constraints.Min = image.Pt(gtx.Dp(dropdownMinWidth), 0)
+	gtx.Constraints.Max.X = max(gtx.Constraints.Min.X, window.X)
+	// The dropdown takes its natural height
+	gtx.Constraints.Max.Y = 1 << 20
+	size := mb.layoutDropdown(gtx, th, menu).Size
+	call := macro.Stop()
+
+	trigger := image.Rect(menu.offset, 0, menu.offset+menu.width, barHeight)
+	resolver := popover.PositionResolver{AutoFlip: true, Align: popover.AlignStart}
+	pos, _ := resolver.Resolve(trigger.Add(mb.origin), size, popover.PlacementBottom, window)
+	overlay.Defer(gtx, pos.Sub(mb.origin), &mb.dismiss, call)
+}
+
+func (mb *MenuBar) layoutDropdown(gtx layout.Context, th *theme.Theme, menu *Menu) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := layout.UniformInset(th.Spacing.Space1).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		children := make([]layout.FlexChild, 0, len(menu.Items))
+		for i := range menu.Items {
+			item := &menu.Items[i]
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return mb.layoutItem(gtx, th, item)
+			}))
+		}
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+	call := macro.Stop()
+
+	overlay.Block(gtx, menu, dims.Size)
+
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+	call.Add(gtx.Ops)
+
+	return dims
+}
+
+func (mb *MenuBar) layoutItem(gtx layout.Context, th *theme.Theme, item *MenuItem) layout.Dimensions {
+	if item.Separator {
+		height := gtx.Dp(unit.Dp(1))
+		margin := gtx.Dp(th.Spacing.Space1)
+		rect := clip.Rect{
+			Min: image.Pt(0, margin),
+			Max: image.Pt(gtx.Constraints.Min.X, margin+height),
+		}
+		paint.FillShape(gtx.Ops, th.Colors.Border, rect.Op())
+		return layout.Dimensions{Size: image.Pt(gtx.Constraints.Min.X, 2*margin+height)}
+	}
+
+	return item.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		macro := op.Record(gtx.Ops)
+		dims := layout.Inset{
+			Top:    th.Spacing.Space2,
+			Bottom: th.Spacing.Space2,
+			Left:   th.Spacing.Space2,
+			Right:  th.Spacing.Space2,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			element := label.Small
+			if item.Disabled {
+				element = label.Muted
+			}
+
+			return layout.Flex{
+				Axis:      layout.Horizontal,
+				Alignment: layout.Middle,
+			}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return layoutAcceleratedLabel(gtx, th, item.Label, element)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if item.Shortcut == "" {
+						return layout.Dimensions{}
+					}
+					return layout.Inset{Left: th.Spacing.Space6}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return shortcut.Parse(item.Shortcut).Layout(gtx, th)
+					})
+				}),
+			)
+		})
+		call := macro.Stop()
+
+		if item.clickable.Hovered() && !item.Disabled {
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+		}
+		call.Add(gtx.Ops)
+
+		return dims
+	})
+}
+
+// processKeys closes the open menu on Escape. Escape is only claimed while a
+// menu is open, so it still reaches widgets laid out after a closed menu bar.
+func (mb *MenuBar) processKeys(gtx layout.Context) {
+	if mb.open < 0 {
+		return
+	}
+	for {
+		ev, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok && e.State == key.Press {
+			mb.open = -1
+		}
+	}
+}
+
+// processShortcuts runs the items whose keyboard shortcut was pressed.
+func (mb *MenuBar) processShortcuts(gtx layout.Context) {
+	for i := range mb.Menus {
+		for j := range mb.Menus[i].Items {
+			item := &mb.Menus[i].Items[j]
+			if item.Shortcut == "" || item.Disabled || item.OnSelect == nil {
+				continue
+			}
+
+			filter, ok := shortcutFilter(item.Shortcut)
+			if !ok {
+				continue
+			}
+
+			for {
+				ev, ok := gtx.Event(filter)
+				if !ok {
+					break
+				}
+				if e, ok := ev.(key.Event); ok && e.State == key.Press {
+					mb.open = -1
+					item.OnSelect()
+				}
+			}
+		}
+	}
+}
+
+// shortcutFilter converts a shortcut such as "Ctrl+Shift+S" into a key filter.
+func shortcutFilter(shortcut string) (key.Filter, bool) {
+	parts := strings.Split(shortcut, "+")
+	name := strings.TrimSpace(parts[len(parts)-1])
+	if name == "" {
+		return key.Filter{}, false
+	}
+
+	var mods key.Modifiers
+	for _, part := range parts[:len(parts)-1] {
+		switch strings.ToLower(strings.TrimSpace(part)) {
+		case "mod":
+			mods |= key.ModShortcut
+		case "ctrl", "control":
+			mods |= key.ModCtrl
+		case "shift":
+			mods |= key.ModShift
+		case "alt", "option":
+			mods |= key.ModAlt
+		case "cmd", "command":
+			mods |= key.ModCommand
+		case "super", "win":
+			mods |= key.ModSuper
+		default:
+			return key.Filter{}, false
+		}
+	}
+
+	// Letters use their upper case form as key name
+	if len(name) == 1 {
+		name = strings.ToUpper(name)
+	}
+
+	return key.Filter{
+		Name:     key.Name(name),
+		Required: mods,
+	}, true
+}
+
+// Update returns the component state for MenuBar.
+func (mb *MenuBar) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active: mb.open >= 0,
+	}
+}
+
+// State implements ComponentState for MenuBar.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if a menu is open.
+func (ms *State) IsActive() bool {
+	return ms.active
+}
+
+// IsHovered returns true if the menu bar is being hovered over.
+func (ms *State) IsHovered() bool {
+	return ms.hovered
+}
+
+// IsPressed returns true if the menu bar is being pressed.
+func (ms *State) IsPressed() bool {
+	return ms.pressed
+}
+
+// IsDisabled returns true if the menu bar is disabled.
+func (ms *State) IsDisabled() bool {
+	return ms.disabled
+}
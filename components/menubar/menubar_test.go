@@ -0,0 +1,98 @@
+package menubar
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+func TestParseAccelerator(t *testing.T) {
+	tests := []struct {
+		text      string
+		wantLabel string
+		wantAccel rune
+	}{
+		{"&File", "File", 'F'},
+		{"Save &As...", "Save As...", 'A'},
+		{"e&xit", "exit", 'X'},
+		{"Open Recent", "Open Recent", 'O'},
+		{"Fish && Chips", "Fish & Chips", 'F'},
+		{"Edit&", "Edit&", 'E'},
+		{"&über", "über", 'Ü'},
+		{"quit", "quit", 0},
+		{"", "", 0},
+	}
+
+	for _, tt := range tests {
+		label, accel := ParseAccelerator(tt.text)
+		if label != tt.wantLabel || accel != tt.wantAccel {
+			t.Errorf("ParseAccelerator(%q) = %q, %q, want %q, %q", tt.text, label, accel, tt.wantLabel, tt.wantAccel)
+		}
+	}
+}
+
+func TestShortcutFilter(t *testing.T) {
+	tests := []struct {
+		shortcut string
+		want     key.Filter
+		wantOK   bool
+	}{
+		{"Ctrl+S", key.Filter{Name: "S", Required: key.ModCtrl}, true},
+		{"Ctrl+Shift+s", key.Filter{Name: "S", Required: key.ModCtrl | key.ModShift}, true},
+		{"Mod + O", key.Filter{Name: "O", Required: key.ModShortcut}, true},
+		{"Cmd+Option+F5", key.Filter{Name: "F5", Required: key.ModCommand | key.ModAlt}, true},
+		{"F1", key.Filter{Name: "F1"}, true},
+		{"Hyper+X", key.Filter{}, false},
+		{"Ctrl+", key.Filter{}, false},
+		{"", key.Filter{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := shortcutFilter(tt.shortcut)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("shortcutFilter(%q) = %+v, %v, want %+v, %v", tt.shortcut, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestClosedMenuBarLeavesEscape(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	mb := NewMenuBar(Menu{Label: "&File", Items: []MenuItem{{Label: "Open"}}})
+	escape := key.Filter{Name: key.NameEscape}
+
+	frame := func() bool {
+		ops := new(op.Ops)
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Constraints: layout.Exact(image.Pt(400, 40)),
+		}
+		mb.Layout(gtx, th)
+
+		// A widget laid out after the menu bar, such as a dialog
+		got := false
+		for {
+			ev, ok := gtx.Event(escape)
+			if !ok {
+				break
+			}
+			if e, ok := ev.(key.Event); ok && e.State == key.Press {
+				got = true
+			}
+		}
+		r.Frame(ops)
+		return got
+	}
+
+	frame()
+	r.Queue(key.Event{Name: key.NameEscape, State: key.Press})
+	if !frame() {
+		t.Error("closed menu bar consumed Escape")
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Package notification provides notification UI components for gio-shadcn
+applications.
+
+# Quick Start
+
+Create a bell that rings when new notifications arrive:
+
+	bell := notification.NewBell(
+		notification.WithAnimate(true),
+		notification.WithOnClick(func() { showNotifications() }),
+	)
+	bell.SetUnreadCount(3)
+
+Place it in the titlebar:
+
+	tb := titlebar.NewTitleBar(
+		titlebar.WithTitle("My App"),
+		titlebar.WithNotificationBell(bell),
+	)
+
+# Features
+
+• Bell icon drawn with vector paths, no icon font required
+• Unread count badge, capped at "99+"
+• Optional ringing animation when the unread count changes
+• Theme variants for the hover and icon colors
+*/
+package notification
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/badge"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// Bell geometry.
+const (
+	bellSize     = unit.Dp(20)
+	bellPadding  = unit.Dp(6)
+	badgeOverlap = unit.Dp(4)
+)
+
+// Ringing animation.
+const (
+	ringDuration = 700 * time.Millisecond
+	ringSwings   = 3
+	ringAngle    = 15 * math.Pi / 180
+)
+
+// Bell is a notification bell button with an unread count badge.
+//
+// Example usage:.
+//
+//	bell := notification.NewBell(notification.WithOnClick(openInbox))
+//	bell.SetUnreadCount(len(unread))
+//	dims := bell.Layout(gtx, th)
+type Bell struct {
+	// State
+	clickable widget.Clickable
+	ring      animation.Animator
+	lastCount int
+
+	// Configuration
+	UnreadCount int
+	OnClick     func()
+	Variant     theme.Variant
+	Animate     bool
+}
+
+// Option is a functional option for configuring Bell components.
+type Option func(*Bell)
+
+// WithUnreadCount sets the initial unread count.
+func WithUnreadCount(count int) Option {
+	return func(b *Bell) {
+		b.UnreadCount = count
+		b.lastCount = count
+	}
+}
+
+// WithOnClick sets the click handler.
+func WithOnClick(onClick func()) Option {
+	return func(b *Bell) {
+		b.OnClick = onClick
+	}
+}
+
+// WithVariant sets the button variant used for the bell colors.
+func WithVariant(variant theme.Variant) Option {
+	return func(b *Bell) {
+		b.Variant = variant
+	}
+}
+
+// WithAnimate makes the bell ring when the unread count changes.
+func WithAnimate(animate bool) Option {
+	return func(b *Bell) {
+		b.Animate = animate
+	}
+}
+
+// NewBell creates a new Bell with the given options.
+func NewBell(options ...Option) *Bell {
+	b := &Bell{
+		Variant: theme.VariantGhost,
+	}
+	b.ring.Configure(animation.Config{Duration: ringDuration, Easing: animation.Linear})
+	b.ring.Set(1)
+
+	for _, option := range options {
+		option(b)
+	}
+
+	return b
+}
+
+// SetUnreadCount sets the unread count. The badge is hidden at zero.
+func (b *Bell) SetUnreadCount(count int) {
+	b.UnreadCount = count
+}
+
+// Layout renders the bell and its badge, and handles clicks.
+func (b *Bell) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if b.clickable.Clicked(gtx) && b.OnClick != nil {
+		b.OnClick()
+	}
+
+	if b.UnreadCount != b.lastCount {
+		b.lastCount = b.UnreadCount
+		if b.Animate && !th.ReducedMotion() {
+			b.ring.Set(0)
+			b.ring.Animate(1)
+		}
+	}
+
+	return layout.Stack{Alignment: layout.NE}.Layout(gtx,
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return layout.Inset{Top: badgeOverlap, Right: badgeOverlap}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return b.layoutButton(gtx, th)
+			})
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			switch {
+			case b.UnreadCount <= 0:
+				return layout.Dimensions{}
+			case b.UnreadCount > 99:
+				return badge.NewBadge("99+", badge.WithVariant(theme.VariantDestructive)).Layout(gtx, th)
+			default:
+				return badge.NewBadge(strconv.Itoa(b.UnreadCount), badge.WithVariant(theme.VariantDestructive)).Layout(gtx, th)
+			}
+		}),
+	)
+}
+
+// layoutButton renders the clickable bell with its hover background.
+func (b *Bell) layoutButton(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	variant := th.ButtonVariant(b.Variant)
+	fg := variant.Foreground
+	if b.clickable.Hovered() {
+		fg = variant.HoverFg
+	}
+
+	return b.clickable.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		pad := gtx.Dp(bellPadding)
+		size := gtx.Dp(bellSize) + 2*pad
+		rect := image.Rectangle{Max: image.Pt(size, size)}
+
+		bg := variant.Background
+		if b.clickable.Hovered() {
+			bg = variant.HoverBg
+		}
+		rr := clip.UniformRRect(rect, gtx.Dp(th.Radius.RadiusMD))
+		paint.FillShape(gtx.Ops, bg, rr.Op(gtx.Ops))
+
+		offset := op.Offset(image.Pt(pad, pad)).Push(gtx.Ops)
+		b.drawBell(gtx, fg)
+		offset.Pop()
+
+		return layout.Dimensions{Size: rect.Max}
+	})
+}
+
+// drawBell draws the bell icon, swinging around its top while ringing.
+func (b *Bell) drawBell(gtx layout.Context, fg color.NRGBA) {
+	scale := float32(gtx.Dp(bellSize)) / 20
+	pt := func(x, y float32) f32.Point { return f32.Pt(x*scale, y*scale) }
+
+	// Damped swing: a few oscillations that fade out
+	progress := b.ring.Value(gtx)
+	angle := float32(ringAngle * math.Sin(float64(progress)*ringSwings*2*math.Pi) * float64(1-progress))
+	rotation := op.Affine(f32.Affine2D{}.Rotate(pt(10, 2), angle)).Push(gtx.Ops)
+	defer rotation.Pop()
+
+	// Dome and rim
+	var body clip.Path
+	body.Begin(gtx.Ops)
+	body.MoveTo(pt(4, 14))
+	body.LineTo(pt(4, 9))
+	body.QuadTo(pt(4, 3), pt(10, 3))
+	body.QuadTo(pt(16, 3), pt(16, 9))
+	body.LineTo(pt(16, 14))
+	body.LineTo(pt(17.5, 15.5))
+	body.LineTo(pt(2.5, 15.5))
+	body.Close()
+	paint.FillShape(gtx.Ops, fg, clip.Outline{Path: body.End()}.Op())
+
+	// Knob and clapper
+	knob := clip.Ellipse{Min: pt(9, 1.5).Round(), Max: pt(11, 3.5).Round()}
+	paint.FillShape(gtx.Ops, fg, knob.Op(gtx.Ops))
+	clapper := clip.Ellipse{Min: pt(8.5, 15.5).Round(), Max: pt(11.5, 18.5).Round()}
+	paint.FillShape(gtx.Ops, fg, clapper.Op(gtx.Ops))
+}
@@ -0,0 +1,200 @@
+/*
+Package placeholder provides an empty state component for gio-shadcn applications.
+
+The placeholder component fills the space of a list, table or panel that has no
+content yet. It shows an optional illustration icon, a title, a short description
+and an optional call-to-action button, all centered in the available space.
+
+# Quick Start
+
+Create a basic empty state:
+
+	empty := placeholder.NewPlaceholder(
+		"No projects yet",
+		"Create your first project to get started.",
+	)
+
+Add an icon and an action:
+
+	empty := placeholder.NewPlaceholder(
+		"No results",
+		"Try adjusting your search or filters.",
+		placeholder.WithIcon(searchIcon),
+		placeholder.WithAction(button.NewButton(
+			button.WithText("Clear filters"),
+			button.WithVariant(theme.VariantOutline),
+		)),
+	)
+
+Use in layout:
+
+	dims := empty.Layout(gtx, th)
+
+# Features
+
+• Vertically and horizontally centered layout
+• Optional illustration icon rendered in the muted foreground color
+• Title and muted, center-aligned description
+• Optional call-to-action button
+• Theme integration with consistent spacing
+*/
+package placeholder
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// defaultIllustrationSize is the icon size used when none is configured.
+const defaultIllustrationSize = unit.Dp(64)
+
+// Placeholder represents an empty state component.
+type Placeholder struct {
+	// Configuration
+	Icon             *widget.Icon
+	Title            string
+	Description      string
+	Action           *button.Button
+	IllustrationSize unit.Dp
+}
+
+// Option is a functional option for configuring Placeholder components.
+type Option func(*Placeholder)
+
+// WithIcon sets the illustration icon.
+func WithIcon(icon *widget.Icon) Option {
+	return func(p *Placeholder) {
+		p.Icon = icon
+	}
+}
+
+// WithAction sets the call-to-action button.
+func WithAction(action *button.Button) Option {
+	return func(p *Placeholder) {
+		p.Action = action
+	}
+}
+
+// WithIllustrationSize sets the size of the illustration icon.
+func WithIllustrationSize(size unit.Dp) Option {
+	return func(p *Placeholder) {
+		p.IllustrationSize = size
+	}
+}
+
+// NewPlaceholder creates a new Placeholder with the given title, description and options.
+func NewPlaceholder(title, description string, options ...Option) *Placeholder {
+	p := &Placeholder{
+		Title:            title,
+		Description:      description,
+		IllustrationSize: defaultIllustrationSize,
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// Layout renders the placeholder centered in the available space.
+func (p *Placeholder) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		children := make([]layout.FlexChild, 0, 7)
+
+		if p.Icon != nil {
+			children = append(children,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return p.layoutIcon(gtx, th)
+				}),
+				layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+			)
+		}
+
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return label.NewTypography(p.Title, label.H3, "").Layout(gtx, th)
+		}))
+
+		if p.Description != "" {
+			children = append(children,
+				layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					description := label.NewTypography(p.Description, label.Muted, "")
+					style := th.Typography.BodySmall(&th.Colors)
+					style.Alignment = text.Middle
+					description.SetTextStyle(style)
+					return description.Layout(gtx, th)
+				}),
+			)
+		}
+
+		if p.Action != nil {
+			children = append(children,
+				layout.Rigid(layout.Spacer{Height: th.Spacing.Space6}.Layout),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return p.Action.Layout(gtx, th)
+				}),
+			)
+		}
+
+		return layout.Flex{
+			Axis:      layout.Vertical,
+			Alignment: layout.Middle,
+		}.Layout(gtx, children...)
+	})
+}
+
+func (p *Placeholder) layoutIcon(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	size := p.IllustrationSize
+	if size <= 0 {
+		size = defaultIllustrationSize
+	}
+
+	px := gtx.Dp(size)
+	gtx.Constraints = layout.Exact(image.Pt(px, px))
+	return p.Icon.Layout(gtx, th.Colors.MutedFg)
+}
+
+// Update returns the component state for Placeholder.
+func (p *Placeholder) Update(gtx layout.Context) theme.ComponentState {
+	if p.Action != nil {
+		return p.Action.Update(gtx)
+	}
+
+	return &State{}
+}
+
+// State implements ComponentState for Placeholder.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the placeholder is active.
+func (ps *State) IsActive() bool {
+	return ps.active
+}
+
+// IsHovered returns true if the placeholder is being hovered over.
+func (ps *State) IsHovered() bool {
+	return ps.hovered
+}
+
+// IsPressed returns true if the placeholder is being pressed.
+func (ps *State) IsPressed() bool {
+	return ps.pressed
+}
+
+// IsDisabled returns true if the placeholder is disabled.
+func (ps *State) IsDisabled() bool {
+	return ps.disabled
+}
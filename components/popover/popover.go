@@ -0,0 +1,260 @@
+/*
+Package popover provides a floating panel anchored to a trigger for gio-shadcn applications.
+
+A popover shows rich content, such as a form or extra details, next to the widget
+that opened it. Clicking the trigger toggles the popover; clicking outside of it or
+pressing Escape closes it. The panel is drawn above other content and is kept
+inside the window by a PositionResolver, which flips it to the opposite side of
+the trigger when the preferred placement would clip.
+
+# Quick Start
+
+Create a popover:
+
+	pop := popover.NewPopover(
+		popover.WithPlacement(popover.PlacementBottom),
+	)
+
+Use in layout:
+
+	dims := pop.Layout(gtx, th, triggerWidget, contentWidget)
+
+# Viewport
+
+Gio does not expose the absolute position of a widget, so the popover needs to
+know where its trigger sits in the window to avoid the window edges:
+
+	pop.SetViewport(triggerOrigin, windowSize)
+
+Without a viewport, the space available to the trigger is used as the bounds.
+
+# Custom Floating Content
+
+The PositionResolver is exported so other floating content can share the same
+placement rules:
+
+	r := popover.NewPositionResolver()
+	pos, _ := r.Resolve(triggerBounds, contentSize, popover.PlacementTop, windowSize)
+
+# Features
+
+• Top, bottom, left and right placements
+• Start, center or end alignment along the trigger
+• Automatic flipping when the preferred side would clip
+• Alignment to the nearest window edge when both sides clip
+• Close on Escape or outside click
+*/
+package popover
+
+import (
+	"image"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/overlay"
+)
+
+// Popover represents a shadcn/ui popover component.
+type Popover struct {
+	// State
+	clickable widget.Clickable
+	open      bool
+	dismiss   int
+
+	// Configuration
+	Placement Placement
+	AutoFlip  bool
+	Gap       unit.Dp
+
+	// Viewport
+	origin image.Point
+	window image.Point
+}
+
+// Option is a functional option for configuring Popover components.
+type Option func(*Popover)
+
+// WithPlacement sets the preferred placement.
+func WithPlacement(placement Placement) Option {
+	return func(p *Popover) {
+		p.Placement = placement
+	}
+}
+
+// WithAutoFlip sets whether the popover flips to the opposite side when the
+// preferred placement would clip. It is enabled by default.
+func WithAutoFlip(autoFlip bool) Option {
+	return func(p *Popover) {
+		p.AutoFlip = autoFlip
+	}
+}
+
+// WithGap sets the distance between the trigger and the popover.
+func WithGap(gap unit.Dp) Option {
+	return func(p *Popover) {
+		p.Gap = gap
+	}
+}
+
+// NewPopover creates a new Popover with the given options.
+func NewPopover(options ...Option) *Popover {
+	p := &Popover{
+		Placement: PlacementBottom,
+		AutoFlip:  true,
+		Gap:       unit.Dp(4),
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// Open opens the popover.
+func (p *Popover) Open() {
+	p.open = true
+}
+
+// Close closes the popover.
+func (p *Popover) Close() {
+	p.open = false
+}
+
+// Toggle opens the popover if closed, and closes it if open.
+func (p *Popover) Toggle() {
+	p.open = !p.open
+}
+
+// IsOpen returns true if the popover is open.
+func (p *Popover) IsOpen() bool {
+	return p.open
+}
+
+// SetViewport sets the trigger's position in the window and the window size,
+// both in pixels, used to keep the popover inside the window.
+func (p *Popover) SetViewport(origin, window image.Point) {
+	p.origin = origin
+	p.window = window
+}
+
+// Layout renders the trigger and, when open, the popover content.
+func (p *Popover) Layout(gtx layout.Context, th *theme.Theme, trigger, content layout.Widget) layout.Dimensions {
+	if p.clickable.Clicked(gtx) {
+		p.Toggle()
+	}
+	p.processKeys(gtx)
+
+	window := p.window
+	if window == (image.Point{}) {
+		window = p.origin.Add(gtx.Constraints.Max)
+	}
+
+	dims := p.clickable.Layout(gtx, trigger)
+
+	if p.open {
+		p.deferContent(gtx, th, content, image.Rectangle{Max: dims.Size}, window)
+	}
+
+	return dims
+}
+
+// deferContent records the popover panel and defers it so it draws above other content.
+func (p *Popover) deferContent(gtx layout.Context, th *theme.Theme, content layout.Widget, trigger image.Rectangle, window image.Point) {
+	// Close when clicking outside the popover
+	if overlay.Dismissed(gtx, &p.dismiss) {
+		p.open = false
+		return
+	}
+
+	// Measure the panel before positioning it
+	gtx.Constraints.Min = image.Point{}
+	gtx.Constraints.Max = window
+	panel := op.Record(gtx.Ops)
+	size := p.layoutPanel(gtx, th, content).Size
+	call := panel.Stop()
+
+	resolver := PositionResolver{AutoFlip: p.AutoFlip, Gap: gtx.Dp(p.Gap)}
+	pos, _ := resolver.Resolve(trigger.Add(p.origin), size, p.Placement, window)
+
+	overlay.Defer(gtx, pos.Sub(p.origin), &p.dismiss, call)
+}
+
+func (p *Popover) layoutPanel(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := layout.UniformInset(th.Spacing.Space4).Layout(gtx, content)
+	call := macro.Stop()
+
+	// Swallow pointer input over the panel so the dismiss layer doesn't see it
+	overlay.Block(gtx, p, dims.Size)
+
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+	call.Add(gtx.Ops)
+
+	return dims
+}
+
+// processKeys closes the popover on Escape. A closed popover leaves Escape to
+// the widgets laid out after it.
+func (p *Popover) processKeys(gtx layout.Context) {
+	if !p.open {
+		return
+	}
+	for {
+		ev, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(key.Event); ok && e.State == key.Press {
+			p.open = false
+		}
+	}
+}
+
+// Update returns the component state for Popover.
+func (p *Popover) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:  p.open,
+		hovered: p.clickable.Hovered(),
+		pressed: p.clickable.Pressed(),
+	}
+}
+
+// State implements ComponentState for Popover.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the popover is open.
+func (ps *State) IsActive() bool {
+	return ps.active
+}
+
+// IsHovered returns true if the trigger is being hovered over.
+func (ps *State) IsHovered() bool {
+	return ps.hovered
+}
+
+// IsPressed returns true if the trigger is being pressed.
+func (ps *State) IsPressed() bool {
+	return ps.pressed
+}
+
+// IsDisabled returns true if the popover is disabled.
+func (ps *State) IsDisabled() bool {
+	return ps.disabled
+}
@@ -0,0 +1,70 @@
+package popover
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/io/input"
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+func TestPopoverEscape(t *testing.T) {
+	empty := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(20, 20)}
+	}
+
+	tests := []struct {
+		name      string
+		open      bool
+		wantLater bool // a widget laid out after the popover sees Escape
+	}{
+		{"closed", false, true},
+		{"open", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r input.Router
+			th := theme.TestTheme()
+			p := NewPopover()
+			if tt.open {
+				p.Open()
+			}
+
+			frame := func() bool {
+				ops := new(op.Ops)
+				gtx := layout.Context{
+					Ops:         ops,
+					Source:      r.Source(),
+					Constraints: layout.Exact(image.Pt(400, 400)),
+				}
+				p.Layout(gtx, th, empty, empty)
+
+				got := false
+				for {
+					ev, ok := gtx.Event(key.Filter{Name: key.NameEscape})
+					if !ok {
+						break
+					}
+					if e, ok := ev.(key.Event); ok && e.State == key.Press {
+						got = true
+					}
+				}
+				r.Frame(ops)
+				return got
+			}
+
+			frame()
+			r.Queue(key.Event{Name: key.NameEscape, State: key.Press})
+			if got := frame(); got != tt.wantLater {
+				t.Errorf("later widget saw Escape = %v, want %v", got, tt.wantLater)
+			}
+			if tt.open && p.IsOpen() {
+				t.Error("open popover did not close on Escape")
+			}
+		})
+	}
+}
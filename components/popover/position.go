@@ -0,0 +1,135 @@
+package popover
+
+import "image"
+
+// Placement is the preferred side of the trigger a popover opens on.
+type Placement string
+
+// Available placements. The popover is centered on the trigger along the
+// other axis.
+const (
+	PlacementBottom Placement = "bottom"
+	PlacementTop    Placement = "top"
+	PlacementLeft   Placement = "left"
+	PlacementRight  Placement = "right"
+)
+
+// Opposite returns the placement on the other side of the trigger.
+func (p Placement) Opposite() Placement {
+	switch p {
+	case PlacementTop:
+		return PlacementBottom
+	case PlacementLeft:
+		return PlacementRight
+	case PlacementRight:
+		return PlacementLeft
+	default:
+		return PlacementTop
+	}
+}
+
+// Align is where content sits along the side of the trigger it opens on.
+type Align string
+
+// Available alignments. AlignStart lines the content up with the trigger's
+// left or top edge, AlignEnd with its right or bottom edge.
+const (
+	AlignCenter Align = "center"
+	AlignStart  Align = "start"
+	AlignEnd    Align = "end"
+)
+
+// PositionResolver positions floating content next to a trigger while keeping
+// it inside the window. It is shared by the popover and the other floating
+// components, and can be used directly for custom floating content.
+//
+// All coordinates are in pixels and must share the same origin, typically the
+// window's top-left corner.
+//
+// Example usage:.
+//
+//	r := popover.NewPositionResolver()
+//	pos, placement := r.Resolve(triggerBounds, contentSize, popover.PlacementBottom, windowSize)
+type PositionResolver struct {
+	// AutoFlip moves the content to the opposite side when the preferred
+	// placement would clip.
+	AutoFlip bool
+
+	// Gap is the distance between the trigger and the content.
+	Gap int
+
+	// Align lines the content up with the trigger along the other axis.
+	// Empty means AlignCenter.
+	Align Align
+}
+
+// NewPositionResolver creates a resolver with auto-flipping enabled.
+func NewPositionResolver() PositionResolver {
+	return PositionResolver{AutoFlip: true}
+}
+
+// Resolve returns the top-left position of content of the given size, and the
+// placement actually used. If the preferred placement clips and AutoFlip is
+// set, the opposite side is tried; if both sides clip, the preferred side is
+// kept and the content is aligned to the nearest window edge.
+func (r PositionResolver) Resolve(trigger image.Rectangle, size image.Point, placement Placement, window image.Point) (image.Point, Placement) {
+	pos := r.place(trigger, size, placement)
+
+	if r.AutoFlip && !fitsMainAxis(pos, size, placement, window) {
+		opposite := placement.Opposite()
+		if flipped := r.place(trigger, size, opposite); fitsMainAxis(flipped, size, opposite, window) {
+			pos, placement = flipped, opposite
+		}
+	}
+
+	return clampToWindow(pos, size, window), placement
+}
+
+// place returns the unclamped position for a placement.
+func (r PositionResolver) place(trigger image.Rectangle, size image.Point, placement Placement) image.Point {
+	x := r.align(trigger.Min.X, trigger.Max.X, size.X)
+	y := r.align(trigger.Min.Y, trigger.Max.Y, size.Y)
+
+	switch placement {
+	case PlacementTop:
+		return image.Pt(x, trigger.Min.Y-r.Gap-size.Y)
+	case PlacementLeft:
+		return image.Pt(trigger.Min.X-r.Gap-size.X, y)
+	case PlacementRight:
+		return image.Pt(trigger.Max.X+r.Gap, y)
+	default:
+		return image.Pt(x, trigger.Max.Y+r.Gap)
+	}
+}
+
+// align returns the start coordinate of content of the given length lined
+// up with the trigger span [start, end).
+func (r PositionResolver) align(start, end, length int) int {
+	switch r.Align {
+	case AlignStart:
+		return start
+	case AlignEnd:
+		return end - length
+	default:
+		return start + (end-start-length)/2
+	}
+}
+
+// fitsMainAxis reports whether the content stays inside the window along the
+// axis the placement moves it on.
+func fitsMainAxis(pos, size image.Point, placement Placement, window image.Point) bool {
+	switch placement {
+	case PlacementLeft, PlacementRight:
+		return pos.X >= 0 && pos.X+size.X <= window.X
+	default:
+		return pos.Y >= 0 && pos.Y+size.Y <= window.Y
+	}
+}
+
+// clampToWindow shifts the content inside the window, favoring the top-left
+// edge when the content is larger than the window.
+func clampToWindow(pos, size, window image.Point) image.Point {
+	pos.X = max(0, min(pos.X, window.X-size.X))
+	pos.Y = max(0, min(pos.Y, window.Y-size.Y))
+	return pos
+}
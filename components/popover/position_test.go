@@ -0,0 +1,94 @@
+package popover
+
+import (
+	"image"
+	"testing"
+)
+
+func TestPositionResolverResolve(t *testing.T) {
+	window := image.Pt(200, 200)
+	middle := image.Rect(90, 100, 110, 120)
+
+	tests := []struct {
+		name          string
+		resolver      PositionResolver
+		trigger       image.Rectangle
+		size          image.Point
+		placement     Placement
+		wantPos       image.Point
+		wantPlacement Placement
+	}{
+		{
+			name:          "preferred side fits",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4},
+			trigger:       middle,
+			size:          image.Pt(40, 30),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(80, 124),
+			wantPlacement: PlacementBottom,
+		},
+		{
+			name:          "flips above near the bottom edge",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4},
+			trigger:       image.Rect(90, 170, 110, 190),
+			size:          image.Pt(40, 30),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(80, 136),
+			wantPlacement: PlacementTop,
+		},
+		{
+			name:          "flips left near the right edge",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4},
+			trigger:       image.Rect(170, 100, 190, 120),
+			size:          image.Pt(40, 30),
+			placement:     PlacementRight,
+			wantPos:       image.Pt(126, 95),
+			wantPlacement: PlacementLeft,
+		},
+		{
+			name:          "keeps the preferred side when both clip",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4},
+			trigger:       middle,
+			size:          image.Pt(40, 190),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(80, 10),
+			wantPlacement: PlacementBottom,
+		},
+		{
+			name:          "clamps instead of flipping without AutoFlip",
+			resolver:      PositionResolver{Gap: 4},
+			trigger:       image.Rect(90, 170, 110, 190),
+			size:          image.Pt(40, 30),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(80, 170),
+			wantPlacement: PlacementBottom,
+		},
+		{
+			name:          "start alignment shifted inside the window",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4, Align: AlignStart},
+			trigger:       image.Rect(180, 10, 200, 30),
+			size:          image.Pt(40, 30),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(160, 34),
+			wantPlacement: PlacementBottom,
+		},
+		{
+			name:          "end alignment",
+			resolver:      PositionResolver{AutoFlip: true, Gap: 4, Align: AlignEnd},
+			trigger:       middle,
+			size:          image.Pt(40, 30),
+			placement:     PlacementBottom,
+			wantPos:       image.Pt(70, 124),
+			wantPlacement: PlacementBottom,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, placement := tt.resolver.Resolve(tt.trigger, tt.size, tt.placement, window)
+			if pos != tt.wantPos || placement != tt.wantPlacement {
+				t.Errorf("Resolve() = %v, %s, want %v, %s", pos, placement, tt.wantPos, tt.wantPlacement)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Package progress provides a progress bar component for gio-shadcn applications.
+
+A Progress bar fills a secondary-colored track with a primary-colored
+indicator. Determinate bars show a value from 0 to 100 and animate between
+values; indeterminate bars slide a segment along the track for work of
+unknown length.
+
+# Quick Start
+
+Create a progress bar:
+
+	upload := progress.NewProgress(progress.WithValue(40))
+
+Update it as work completes:
+
+	upload.SetValue(float32(done) / float32(total) * 100)
+
+Show work of unknown length:
+
+	busy := progress.NewProgress(progress.WithIndeterminate(true))
+
+Use in layout:
+
+	dims := upload.Layout(gtx, th)
+
+# Features
+
+• Determinate mode with animated value changes
+• Indeterminate mode with a looping sliding segment
+• Configurable height and corner radius
+• Indicator color from the button variant, such as VariantDestructive
+*/
+package progress
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// Defaults and timing.
+const (
+	DefaultHeight = unit.Dp(8)
+	// indeterminatePeriod is the time the segment takes to cross the track.
+	indeterminatePeriod = 1500 * time.Millisecond
+	// segmentFraction is the indeterminate segment width relative to the
+	// track.
+	segmentFraction = 0.4
+	valueDuration   = 300 * time.Millisecond
+)
+
+// Progress represents a shadcn/ui progress bar.
+//
+// Example usage:.
+//
+//	p := progress.NewProgress(progress.WithValue(66), progress.WithHeight(unit.Dp(4)))
+//	dims := p.Layout(gtx, th)
+type Progress struct {
+	// State
+	value   animation.Animator
+	laidOut bool
+	start   time.Time
+
+	// Configuration
+	// Value is the completed percentage, from 0 to 100.
+	Value         float32
+	Indeterminate bool
+	Height        unit.Dp
+	// Radius rounds the track and indicator corners. Zero uses the theme's
+	// RadiusFull, for a pill-shaped bar.
+	Radius  unit.Dp
+	Variant theme.Variant
+}
+
+// Option is a functional option for configuring Progress components.
+type Option func(*Progress)
+
+// WithValue sets the completed percentage, from 0 to 100.
+func WithValue(value float32) Option {
+	return func(p *Progress) {
+		p.Value = value
+	}
+}
+
+// WithIndeterminate shows a sliding segment instead of a value.
+func WithIndeterminate(indeterminate bool) Option {
+	return func(p *Progress) {
+		p.Indeterminate = indeterminate
+	}
+}
+
+// WithHeight sets the bar height.
+func WithHeight(height unit.Dp) Option {
+	return func(p *Progress) {
+		p.Height = height
+	}
+}
+
+// WithRadius sets the corner radius.
+func WithRadius(radius unit.Dp) Option {
+	return func(p *Progress) {
+		p.Radius = radius
+	}
+}
+
+// WithVariant sets the variant whose background colors the indicator.
+func WithVariant(variant theme.Variant) Option {
+	return func(p *Progress) {
+		p.Variant = variant
+	}
+}
+
+// NewProgress creates a new Progress with the given options.
+func NewProgress(options ...Option) *Progress {
+	p := &Progress{
+		Height:  DefaultHeight,
+		Variant: theme.VariantDefault,
+	}
+	p.value.Duration = valueDuration
+
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
+// SetValue sets the completed percentage, clamped to 0–100.
+func (p *Progress) SetValue(value float32) {
+	p.Value = max(0, min(value, 100))
+}
+
+// Layout renders the track and the indicator across the available width.
+func (p *Progress) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	height := p.Height
+	if height <= 0 {
+		height = DefaultHeight
+	}
+	size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(height))
+
+	radius := p.Radius
+	if radius <= 0 {
+		radius = th.Radius.RadiusFull
+	}
+	track := clip.UniformRRect(image.Rectangle{Max: size}, min(gtx.Dp(radius), size.Y/2))
+	defer track.Push(gtx.Ops).Pop()
+
+	paint.Fill(gtx.Ops, th.Colors.Secondary)
+
+	indicator := th.ButtonVariant(p.Variant).Background
+	var bar image.Rectangle
+	if p.Indeterminate {
+		bar = p.segment(gtx, size, th.ReducedMotion())
+	} else {
+		bar = image.Rect(0, 0, int(float32(size.X)*p.fraction(gtx, th.ReducedMotion())), size.Y)
+	}
+	// The track clip rounds the bar ends that touch the track ends
+	paint.FillShape(gtx.Ops, indicator, clip.UniformRRect(bar, min(gtx.Dp(radius), size.Y/2)).Op(gtx.Ops))
+
+	return layout.Dimensions{Size: size}
+}
+
+// fraction returns the animated completed fraction. Under reduced motion it
+// jumps to the value.
+func (p *Progress) fraction(gtx layout.Context, reduced bool) float32 {
+	target := max(0, min(p.Value, 100)) / 100
+	if !p.laidOut || reduced {
+		p.laidOut = true
+		p.value.Set(target)
+	} else if p.value.Target() != target {
+		p.value.Animate(target)
+	}
+	return p.value.Value(gtx)
+}
+
+// segment returns the indeterminate segment for the current frame, sliding
+// in from the left edge and out past the right one. Under reduced motion it
+// stays centered on the track.
+func (p *Progress) segment(gtx layout.Context, size image.Point, reduced bool) image.Rectangle {
+	width := int(float32(size.X) * segmentFraction)
+	if reduced {
+		x := (size.X - width) / 2
+		return image.Rect(x, 0, x+width, size.Y)
+	}
+
+	if p.start.IsZero() {
+		p.start = gtx.Now
+	}
+	gtx.Execute(op.InvalidateCmd{})
+
+	elapsed := gtx.Now.Sub(p.start) % indeterminatePeriod
+	t := animation.EaseInOut(float32(elapsed) / float32(indeterminatePeriod))
+
+	x := int(math.Round(float64(-width) + float64(size.X+width)*float64(t)))
+	return image.Rect(x, 0, x+width, size.Y)
+}
+
+// Update returns the component state for Progress.
+func (p *Progress) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active: p.Indeterminate || p.Value > 0,
+	}
+}
+
+// State implements ComponentState for Progress.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the bar shows progress or is indeterminate.
+func (ps *State) IsActive() bool {
+	return ps.active
+}
+
+// IsHovered always returns false; progress bars have no hover state.
+func (ps *State) IsHovered() bool {
+	return ps.hovered
+}
+
+// IsPressed always returns false; progress bars have no pressed state.
+func (ps *State) IsPressed() bool {
+	return ps.pressed
+}
+
+// IsDisabled always returns false; progress bars cannot be disabled.
+func (ps *State) IsDisabled() bool {
+	return ps.disabled
+}
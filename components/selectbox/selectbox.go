@@ -0,0 +1,569 @@
+/*
+Package selectbox provides a select (dropdown) component for gio-shadcn
+applications.
+
+A Select shows the chosen item in a trigger box. Clicking the trigger, or
+pressing Enter, Space or an arrow key while it is focused, opens a floating
+list of items above the rest of the window. The list is navigated with the
+arrow keys, Home and End, and closes on Enter, Escape or a click outside it.
+
+# Quick Start
+
+Create a select:
+
+	fruit := selectbox.NewSelect([]selectbox.Item{
+		{Value: "apple", Label: "Apple"},
+		{Value: "banana", Label: "Banana"},
+		{Value: "cherry", Label: "Cherry", Disabled: true},
+	},
+		selectbox.WithPlaceholder("Select a fruit"),
+		selectbox.WithOnSelect(func(item selectbox.Item) {
+			order.Fruit = item.Value
+		}),
+	)
+
+Use in layout:
+
+	dims := fruit.Layout(gtx, th)
+
+# Viewport
+
+Like a popover, the list opens below the trigger and flips above it when it
+would leave the window. Give the trigger's window position to use the whole
+window:
+
+	fruit.SetViewport(triggerOrigin, windowSize)
+
+# Features
+
+• Floating list drawn above other content through utils/overlay
+• Keyboard navigation with arrows, Home, End, Enter and Escape
+• Highlighted item scrolled into view in long lists
+• Disabled items, skipped by keyboard navigation
+• Checkmark on the selected item
+*/
+package selectbox
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/overlay"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Select defaults and geometry.
+const (
+	DefaultMaxVisible = 8
+	triggerHeight     = unit.Dp(36)
+	itemHeight        = unit.Dp(32)
+	iconSize          = unit.Dp(16)
+	listGap           = unit.Dp(4)
+	disabledOpacity   = 0.5
+)
+
+// Select icons. The icon data is constant, so NewIcon cannot fail.
+var (
+	chevronIcon, _ = widget.NewIcon(icons.NavigationExpandMore)
+	checkIcon, _   = widget.NewIcon(icons.ActionDone)
+)
+
+// Item is one choice of a Select.
+type Item struct {
+	Value    string
+	Label    string
+	Disabled bool
+}
+
+// Select represents a shadcn/ui select component.
+//
+// Example usage:.
+//
+//	size := selectbox.NewSelect(items, selectbox.WithSelected(1))
+//	dims := size.Layout(gtx, th)
+type Select struct {
+	// State
+	trigger     widget.Clickable
+	clicks      []widget.Clickable
+	list        layout.List
+	open        bool
+	highlighted int
+	hovered     int
+	reveal      bool
+	dismiss     int
+
+	// Configuration
+	Items       []Item
+	Selected    int
+	Placeholder string
+	Disabled    bool
+	// Width of the trigger and list. Zero fills the available width.
+	Width unit.Dp
+	// MaxVisible is the number of items shown before the list scrolls.
+	MaxVisible int
+
+	// Callbacks
+	OnSelect func(item Item)
+
+	// Viewport
+	origin image.Point
+	window image.Point
+}
+
+// Option is a functional option for configuring Select components.
+type Option func(*Select)
+
+// WithPlaceholder sets the text shown when nothing is selected.
+func WithPlaceholder(placeholder string) Option {
+	return func(s *Select) {
+		s.Placeholder = placeholder
+	}
+}
+
+// WithSelected sets the index of the initially selected item.
+func WithSelected(index int) Option {
+	return func(s *Select) {
+		s.Selected = index
+	}
+}
+
+// WithDisabled sets the disabled state.
+func WithDisabled(disabled bool) Option {
+	return func(s *Select) {
+		s.Disabled = disabled
+	}
+}
+
+// WithWidth sets the width of the trigger and the list.
+func WithWidth(width unit.Dp) Option {
+	return func(s *Select) {
+		s.Width = width
+	}
+}
+
+// WithMaxVisible sets how many items are shown before the list scrolls.
+func WithMaxVisible(maxVisible int) Option {
+	return func(s *Select) {
+		s.MaxVisible = maxVisible
+	}
+}
+
+// WithOnSelect sets the callback called when the user selects an item.
+func WithOnSelect(onSelect func(item Item)) Option {
+	return func(s *Select) {
+		s.OnSelect = onSelect
+	}
+}
+
+// NewSelect creates a new Select of items with nothing selected.
+func NewSelect(items []Item, options ...Option) *Select {
+	s := &Select{
+		Items:       items,
+		Selected:    -1,
+		MaxVisible:  DefaultMaxVisible,
+		highlighted: -1,
+		hovered:     -1,
+		list:        layout.List{Axis: layout.Vertical},
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// SelectedItem returns the selected item, and false when nothing is selected.
+func (s *Select) SelectedItem() (Item, bool) {
+	if s.Selected < 0 || s.Selected >= len(s.Items) {
+		return Item{}, false
+	}
+	return s.Items[s.Selected], true
+}
+
+// Value returns the value of the selected item, or "" when nothing is
+// selected.
+func (s *Select) Value() string {
+	item, _ := s.SelectedItem()
+	return item.Value
+}
+
+// GetValue returns the value of the selected item, like Value. It lets Select
+// be used with controlled.Controlled.
+func (s *Select) GetValue() string {
+	return s.Value()
+}
+
+// SetValue selects the first item with value without calling OnSelect, or
+// clears the selection when no item has it.
+func (s *Select) SetValue(value string) {
+	s.Selected = -1
+	for idx, item := range s.Items {
+		if item.Value == value {
+			s.Selected = idx
+			return
+		}
+	}
+}
+
+// Open opens the item list, highlighting the selected item.
+func (s *Select) Open() {
+	if s.Disabled || s.open {
+		return
+	}
+	s.open = true
+	s.highlighted = s.Selected
+	if s.highlighted < 0 || s.highlighted >= len(s.Items) || s.Items[s.highlighted].Disabled {
+		s.highlighted = s.step(-1, 1)
+	}
+	s.hovered = -1
+	s.reveal = true
+}
+
+// Close closes the item list.
+func (s *Select) Close() {
+	s.open = false
+}
+
+// IsOpen returns true if the item list is open.
+func (s *Select) IsOpen() bool {
+	return s.open
+}
+
+// SetViewport sets the trigger's position in the window and the window size,
+// both in pixels, used to keep the list inside the window.
+func (s *Select) SetViewport(origin, window image.Point) {
+	s.origin = origin
+	s.window = window
+}
+
+// choose selects the item at index, closes the list and gives focus back to
+// the trigger.
+func (s *Select) choose(gtx layout.Context, index int) {
+	if index < 0 || index >= len(s.Items) || s.Items[index].Disabled {
+		return
+	}
+	s.Selected = index
+	s.Close()
+	gtx.Execute(key.FocusCmd{Tag: &s.trigger})
+	if s.OnSelect != nil {
+		s.OnSelect(s.Items[index])
+	}
+}
+
+// step returns the next enabled item after from in direction dir, or from
+// when there is none.
+func (s *Select) step(from, dir int) int {
+	for idx := from + dir; idx >= 0 && idx < len(s.Items); idx += dir {
+		if !s.Items[idx].Disabled {
+			return idx
+		}
+	}
+	return from
+}
+
+// processKeys handles the keyboard while the trigger is focused. Enter and
+// Space are taken before the trigger's Clickable sees them, so they select
+// the highlighted item instead of toggling the list.
+func (s *Select) processKeys(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(
+			key.Filter{Focus: &s.trigger, Name: key.NameUpArrow},
+			key.Filter{Focus: &s.trigger, Name: key.NameDownArrow},
+			key.Filter{Focus: &s.trigger, Name: key.NameHome},
+			key.Filter{Focus: &s.trigger, Name: key.NameEnd},
+			key.Filter{Focus: &s.trigger, Name: key.NameReturn},
+			key.Filter{Focus: &s.trigger, Name: key.NameEnter},
+			key.Filter{Focus: &s.trigger, Name: key.NameSpace},
+			key.Filter{Focus: &s.trigger, Name: key.NameEscape},
+		)
+		if !ok {
+			break
+		}
+		e, ok := ev.(key.Event)
+		if !ok || e.State != key.Press {
+			continue
+		}
+
+		if !s.open {
+			if e.Name != key.NameEscape {
+				s.Open()
+			}
+			continue
+		}
+
+		switch e.Name {
+		case key.NameUpArrow:
+			s.highlighted = s.step(s.highlighted, -1)
+		case key.NameDownArrow:
+			s.highlighted = s.step(s.highlighted, 1)
+		case key.NameHome:
+			s.highlighted = s.step(-1, 1)
+		case key.NameEnd:
+			s.highlighted = s.step(len(s.Items), -1)
+		case key.NameReturn, key.NameEnter, key.NameSpace:
+			s.choose(gtx, s.highlighted)
+		case key.NameEscape:
+			s.Close()
+		}
+		s.reveal = true
+	}
+}
+
+// Layout renders the trigger and, when open, the item list.
+func (s *Select) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	s.processKeys(gtx)
+	if s.trigger.Clicked(gtx) {
+		if s.open {
+			s.Close()
+		} else {
+			s.Open()
+		}
+	}
+	if s.Disabled {
+		s.Close()
+	}
+
+	if len(s.clicks) != len(s.Items) {
+		s.clicks = make([]widget.Clickable, len(s.Items))
+	}
+	for idx := range s.clicks {
+		if s.clicks[idx].Clicked(gtx) {
+			s.choose(gtx, idx)
+		}
+	}
+
+	window := s.window
+	if window == (image.Point{}) {
+		window = s.origin.Add(gtx.Constraints.Max)
+	}
+
+	width := gtx.Constraints.Max.X
+	if s.Width > 0 {
+		width = min(width, gtx.Dp(s.Width))
+	}
+	size := image.Pt(width, gtx.Dp(triggerHeight))
+
+	dims := s.trigger.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return s.layoutTrigger(gtx, th, size)
+	})
+
+	if s.open {
+		s.deferList(gtx, th, image.Rectangle{Max: dims.Size}, window)
+	}
+
+	return dims
+}
+
+// layoutTrigger renders the bordered box with the selected label, or the
+// placeholder, and a chevron.
+func (s *Select) layoutTrigger(gtx layout.Context, th *theme.Theme, size image.Point) layout.Dimensions {
+	bg, border, fg := th.Colors.Background, th.Colors.Input, th.Colors.Foreground
+	if gtx.Focused(&s.trigger) || s.open {
+		border = th.Colors.Ring
+	}
+	label := s.Placeholder
+	if item, ok := s.SelectedItem(); ok {
+		label = item.Label
+	} else {
+		fg = th.Colors.MutedFg
+	}
+	if s.Disabled {
+		bg = utils.ApplyOpacity(bg, disabledOpacity)
+		border = utils.ApplyOpacity(border, disabledOpacity)
+		fg = utils.ApplyOpacity(fg, disabledOpacity)
+	}
+
+	rr := clip.UniformRRect(image.Rectangle{Max: size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, bg, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	gtx.Constraints = layout.Exact(size)
+	layout.Inset{Left: th.Spacing.Space3, Right: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return layoutLabel(gtx, th, label, fg)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layoutIcon(gtx, chevronIcon, utils.ApplyOpacity(fg, disabledOpacity))
+			}),
+		)
+	})
+
+	return layout.Dimensions{Size: size}
+}
+
+// deferList positions the item list next to the trigger and defers it above
+// other content.
+func (s *Select) deferList(gtx layout.Context, th *theme.Theme, trigger image.Rectangle, window image.Point) {
+	if overlay.Dismissed(gtx, &s.dismiss) {
+		s.Close()
+		return
+	}
+
+	rows := len(s.Items)
+	if s.MaxVisible > 0 {
+		rows = min(rows, s.MaxVisible)
+	}
+	padding := gtx.Dp(th.Spacing.Space1)
+	size := image.Pt(trigger.Dx(), rows*gtx.Dp(itemHeight)+2*padding)
+
+	macro := op.Record(gtx.Ops)
+	s.layoutList(gtx, th, size, rows)
+	call := macro.Stop()
+
+	resolver := popover.PositionResolver{AutoFlip: true, Gap: gtx.Dp(listGap)}
+	pos, _ := resolver.Resolve(trigger.Add(s.origin), size, popover.PlacementBottom, window)
+	overlay.Defer(gtx, pos.Sub(s.origin), &s.dismiss, call)
+}
+
+// layoutList renders the list panel, scrolling the highlighted item into
+// view after keyboard navigation.
+func (s *Select) layoutList(gtx layout.Context, th *theme.Theme, size image.Point, rows int) {
+	overlay.Block(gtx, s, size)
+
+	rr := clip.UniformRRect(image.Rectangle{Max: size}, gtx.Dp(th.Radius.RadiusMD))
+	paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	if s.reveal && s.highlighted >= 0 {
+		s.reveal = false
+		switch {
+		case s.highlighted < s.list.Position.First:
+			s.list.Position.First = s.highlighted
+			s.list.Position.Offset = 0
+		case s.highlighted >= s.list.Position.First+rows:
+			s.list.Position.First = s.highlighted - rows + 1
+			s.list.Position.Offset = 0
+		}
+	}
+
+	gtx.Constraints = layout.Exact(size)
+	layout.UniformInset(th.Spacing.Space1).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return s.list.Layout(gtx, len(s.Items), func(gtx layout.Context, idx int) layout.Dimensions {
+			return s.layoutItem(gtx, th, idx)
+		})
+	})
+}
+
+// layoutItem renders one row: the label, highlighted when hovered or
+// navigated to, and a checkmark on the selected item.
+func (s *Select) layoutItem(gtx layout.Context, th *theme.Theme, idx int) layout.Dimensions {
+	item := s.Items[idx]
+	click := &s.clicks[idx]
+
+	// The pointer moves the highlight only when it enters a new item, so it
+	// doesn't fight keyboard navigation
+	if click.Hovered() && s.hovered != idx && !item.Disabled {
+		s.highlighted = idx
+	}
+	if click.Hovered() {
+		s.hovered = idx
+	} else if s.hovered == idx {
+		s.hovered = -1
+	}
+
+	size := image.Pt(gtx.Constraints.Max.X, gtx.Dp(itemHeight))
+	return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		fg := th.Colors.PopoverFg
+		if item.Disabled {
+			fg = utils.ApplyOpacity(fg, disabledOpacity)
+		} else if idx == s.highlighted {
+			rr := clip.UniformRRect(image.Rectangle{Max: size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Accent, rr.Op(gtx.Ops))
+			fg = th.Colors.AccentFg
+		}
+
+		gtx.Constraints = layout.Exact(size)
+		layout.Inset{Left: th.Spacing.Space2, Right: th.Spacing.Space2}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					return layoutLabel(gtx, th, item.Label, fg)
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if idx != s.Selected {
+						return layout.Dimensions{}
+					}
+					return layoutIcon(gtx, checkIcon, fg)
+				}),
+			)
+		})
+		return layout.Dimensions{Size: size}
+	})
+}
+
+// layoutLabel renders single-line text vertically centered in the row.
+func layoutLabel(gtx layout.Context, th *theme.Theme, txt string, fg color.NRGBA) layout.Dimensions {
+	gtx.Constraints.Min.X = 0
+	return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, txt)
+		lbl.Color = fg
+		lbl.MaxLines = 1
+		lbl.Truncator = "…"
+		lbl.Alignment = text.Start
+		return lbl.Layout(gtx)
+	})
+}
+
+// layoutIcon renders icon at iconSize.
+func layoutIcon(gtx layout.Context, icon *widget.Icon, fg color.NRGBA) layout.Dimensions {
+	size := gtx.Dp(iconSize)
+	gtx.Constraints = layout.Exact(image.Pt(size, size))
+	return icon.Layout(gtx, fg)
+}
+
+// Update returns the component state for Select.
+func (s *Select) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:   s.open,
+		hovered:  s.trigger.Hovered(),
+		pressed:  s.trigger.Pressed(),
+		disabled: s.Disabled,
+	}
+}
+
+// State implements ComponentState for Select.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the item list is open.
+func (ss *State) IsActive() bool {
+	return ss.active
+}
+
+// IsHovered returns true if the trigger is being hovered over.
+func (ss *State) IsHovered() bool {
+	return ss.hovered
+}
+
+// IsPressed returns true if the trigger is being pressed.
+func (ss *State) IsPressed() bool {
+	return ss.pressed
+}
+
+// IsDisabled returns true if the select is disabled.
+func (ss *State) IsDisabled() bool {
+	return ss.disabled
+}
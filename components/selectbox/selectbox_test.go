@@ -0,0 +1,27 @@
+package selectbox_test
+
+import (
+	"testing"
+
+	"github.com/bnema/gio-shadcn/components/selectbox"
+	"github.com/bnema/gio-shadcn/utils/controlled"
+)
+
+func TestSelectControlled(t *testing.T) {
+	sel := selectbox.NewSelect([]selectbox.Item{
+		{Value: "apple", Label: "Apple"},
+		{Value: "pear", Label: "Pear"},
+	})
+	var c controlled.Component[string] = sel
+
+	c.SetValue("pear")
+	if got := c.GetValue(); got != "pear" {
+		t.Errorf("GetValue() = %q after SetValue(%q)", got, "pear")
+	}
+	c.SetValue("plum")
+	if got := c.GetValue(); got != "" {
+		t.Errorf("GetValue() = %q after setting an unknown value, want empty", got)
+	}
+
+	_ = controlled.New[string](sel, "apple", nil)
+}
@@ -0,0 +1,201 @@
+/*
+Package separator provides separator lines for gio-shadcn applications.
+
+A Separator is a thin rule in the theme's border color that divides content
+horizontally or vertically. A horizontal separator can carry a short label
+centered on the line, such as "or continue with" between sign-in options.
+
+# Quick Start
+
+Create a horizontal separator:
+
+	sep := separator.NewHorizontal()
+
+Create a labeled separator:
+
+	or := separator.NewSeparator(separator.WithLabel("or continue with"))
+
+Create a vertical separator between toolbar groups:
+
+	sep := separator.NewVertical(separator.WithLength(unit.Dp(20)))
+
+Use in layout:
+
+	dims := sep.Layout(gtx, th)
+
+# Features
+
+• Horizontal and vertical orientation
+• Theme border color and configurable thickness
+• Inset at both ends of the line
+• Optional label centered on horizontal separators
+*/
+package separator
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// DefaultThickness is the line thickness of a separator.
+const DefaultThickness = unit.Dp(1)
+
+// Separator represents a shadcn/ui separator.
+//
+// Example usage:.
+//
+//	sep := separator.NewSeparator(separator.WithInset(unit.Dp(8)))
+//	dims := sep.Layout(gtx, th)
+type Separator struct {
+	// Configuration
+	Orientation layout.Axis
+	Thickness   unit.Dp
+	// Inset shortens the line at both ends.
+	Inset unit.Dp
+	// Length fixes the extent along the line's axis. When zero, horizontal
+	// separators span the available width and vertical separators the
+	// minimum height, so they never grow to an unbounded constraint.
+	Length unit.Dp
+	// Label is shown centered on horizontal separators.
+	Label string
+}
+
+// Option is a functional option for configuring Separator components.
+type Option func(*Separator)
+
+// WithOrientation sets whether the line runs horizontally or vertically.
+func WithOrientation(orientation layout.Axis) Option {
+	return func(s *Separator) {
+		s.Orientation = orientation
+	}
+}
+
+// WithThickness sets the line thickness.
+func WithThickness(thickness unit.Dp) Option {
+	return func(s *Separator) {
+		s.Thickness = thickness
+	}
+}
+
+// WithInset shortens the line at both ends.
+func WithInset(inset unit.Dp) Option {
+	return func(s *Separator) {
+		s.Inset = inset
+	}
+}
+
+// WithLength fixes the extent of the line along its axis.
+func WithLength(length unit.Dp) Option {
+	return func(s *Separator) {
+		s.Length = length
+	}
+}
+
+// WithLabel sets the label centered on a horizontal separator.
+func WithLabel(label string) Option {
+	return func(s *Separator) {
+		s.Label = label
+	}
+}
+
+// NewSeparator creates a new horizontal Separator with the given options.
+func NewSeparator(options ...Option) *Separator {
+	s := &Separator{
+		Orientation: layout.Horizontal,
+		Thickness:   DefaultThickness,
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// NewHorizontal creates a horizontal Separator with the given options.
+func NewHorizontal(options ...Option) *Separator {
+	return NewSeparator(append([]Option{WithOrientation(layout.Horizontal)}, options...)...)
+}
+
+// NewVertical creates a vertical Separator with the given options.
+func NewVertical(options ...Option) *Separator {
+	return NewSeparator(append([]Option{WithOrientation(layout.Vertical)}, options...)...)
+}
+
+// Layout renders the line across the available width, or down the minimum
+// height for vertical separators. A non-zero Length overrides either extent.
+func (s *Separator) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	thickness := max(1, gtx.Dp(s.Thickness))
+	inset := gtx.Dp(s.Inset)
+
+	if s.Orientation == layout.Vertical {
+		length := gtx.Constraints.Min.Y
+		if s.Length > 0 {
+			length = gtx.Dp(s.Length)
+		}
+		size := image.Pt(thickness, length)
+		line := image.Rect(0, inset, thickness, size.Y-inset)
+		paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect(line).Op())
+		return layout.Dimensions{Size: size}
+	}
+
+	if s.Label != "" {
+		return s.layoutLabeled(gtx, th, thickness)
+	}
+
+	length := gtx.Constraints.Max.X
+	if s.Length > 0 {
+		length = gtx.Dp(s.Length)
+	}
+	size := image.Pt(length, thickness)
+	line := image.Rect(inset, 0, size.X-inset, thickness)
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect(line).Op())
+	return layout.Dimensions{Size: size}
+}
+
+// layoutLabeled renders the label with a line on each side, vertically
+// centered on the text.
+func (s *Separator) layoutLabeled(gtx layout.Context, th *theme.Theme, thickness int) layout.Dimensions {
+	rule := func(gtx layout.Context, height int) {
+		y := (height - thickness) / 2
+		line := image.Rect(0, y, gtx.Constraints.Max.X, y+thickness)
+		paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect(line).Op())
+	}
+
+	// Flex lays out the rigid label first, so its height is known when the
+	// flexed lines are drawn
+	gtx.Constraints.Min = image.Point{}
+	var labelHeight int
+	label := func(gtx layout.Context) layout.Dimensions {
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeXS, s.Label)
+		lbl.Color = th.Colors.MutedFg
+		lbl.Alignment = text.Middle
+		lbl.MaxLines = 1
+		dims := layout.Inset{Left: th.Spacing.Space2, Right: th.Spacing.Space2}.Layout(gtx, lbl.Layout)
+		labelHeight = dims.Size.Y
+		return dims
+	}
+
+	return layout.Inset{Left: s.Inset, Right: s.Inset}.Layout(gtx,
+		func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					rule(gtx, labelHeight)
+					return layout.Dimensions{Size: image.Pt(gtx.Constraints.Max.X, labelHeight)}
+				}),
+				layout.Rigid(label),
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					rule(gtx, labelHeight)
+					return layout.Dimensions{Size: image.Pt(gtx.Constraints.Max.X, labelHeight)}
+				}),
+			)
+		})
+}
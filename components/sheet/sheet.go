@@ -0,0 +1,342 @@
+/*
+Package sheet provides a bottom sheet component for gio-shadcn applications.
+
+A Sheet slides up from the bottom edge of its area and stops at one of its snap
+points, expressed as fractions of the available height. When Draggable is set,
+a handle bar at the top lets the user drag the sheet between snap points; on
+release the sheet animates to the nearest one, and dragging it below the lowest
+snap point closes it.
+
+# Quick Start
+
+Create a sheet:
+
+	s := sheet.NewSheet(
+		sheet.WithSnapPoints(0.25, 0.5, 0.9),
+		sheet.WithDraggable(true),
+		sheet.WithOnClose(func() { log.Println("closed") }),
+	)
+	s.Open()
+
+Use in layout, stacked above the page:
+
+	layout.Stack{}.Layout(gtx,
+		layout.Expanded(page),
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return s.Layout(gtx, th, sheetContent)
+		}),
+	)
+*/
+package sheet
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// handleHeight is the height of the drag handle area at the top of the sheet.
+const handleHeight = unit.Dp(24)
+
+// Sheet represents a bottom sheet with snap points.
+type Sheet struct {
+	// State
+	open     bool
+	height   animation.Animator
+	dragging bool
+	dragY    float32
+	handle   int
+	top      int
+	list     layout.List
+	backdrop int
+
+	// Configuration
+	SnapPoints    []float32
+	Draggable     bool
+	CurrentHeight float32
+	OnClose       func()
+}
+
+// Option is a functional option for configuring Sheet components.
+type Option func(*Sheet)
+
+// WithSnapPoints sets the snap points as fractions of the available height.
+// They should be given in ascending order.
+func WithSnapPoints(points ...float32) Option {
+	return func(s *Sheet) {
+		s.SnapPoints = points
+	}
+}
+
+// WithDraggable shows a drag handle that lets the user resize the sheet.
+func WithDraggable(draggable bool) Option {
+	return func(s *Sheet) {
+		s.Draggable = draggable
+	}
+}
+
+// WithOnClose sets the handler called once the sheet has closed.
+func WithOnClose(onClose func()) Option {
+	return func(s *Sheet) {
+		s.OnClose = onClose
+	}
+}
+
+// NewSheet creates a new Sheet with the given options.
+func NewSheet(options ...Option) *Sheet {
+	s := &Sheet{
+		SnapPoints: []float32{0.5},
+	}
+	s.height.Duration = 250 * time.Millisecond
+	s.list.Axis = layout.Vertical
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// Open slides the sheet up to its lowest snap point.
+func (s *Sheet) Open() {
+	s.open = true
+	s.height.Animate(s.minSnap())
+}
+
+// Close slides the sheet down and calls OnClose once it is hidden.
+func (s *Sheet) Close() {
+	s.dragging = false
+	s.height.Animate(0)
+}
+
+// IsOpen returns true while the sheet is shown.
+func (s *Sheet) IsOpen() bool {
+	return s.open
+}
+
+// Layout renders the sheet anchored to the bottom of the available space.
+func (s *Sheet) Layout(gtx layout.Context, th *theme.Theme, content layout.Widget) layout.Dimensions {
+	area := gtx.Constraints.Max
+	if !s.open || area.Y <= 0 {
+		return layout.Dimensions{Size: area}
+	}
+
+	s.processBackdrop(gtx)
+	if s.Draggable {
+		s.processDrag(gtx, area.Y)
+	}
+
+	if !s.dragging {
+		if th.ReducedMotion() {
+			s.height.Set(s.height.Target())
+		}
+		s.CurrentHeight = s.height.Value(gtx)
+		if s.CurrentHeight <= 0 && !s.height.Running() {
+			s.open = false
+			if s.OnClose != nil {
+				s.OnClose()
+			}
+			return layout.Dimensions{Size: area}
+		}
+	}
+
+	// Backdrop dims the page in proportion to how far the sheet is open
+	alpha := uint8(0x80 * min(1, s.CurrentHeight/max(s.minSnap(), 0.01)))
+	backdrop := clip.Rect{Max: area}.Push(gtx.Ops)
+	paint.ColorOp{Color: color.NRGBA{A: alpha}}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	event.Op(gtx.Ops, &s.backdrop)
+	backdrop.Pop()
+
+	height := int(s.CurrentHeight * float32(area.Y))
+	s.top = area.Y - height
+
+	offset := op.Offset(image.Pt(0, s.top)).Push(gtx.Ops)
+	s.layoutPanel(gtx, th, image.Pt(area.X, height), content)
+	offset.Pop()
+
+	return layout.Dimensions{Size: area}
+}
+
+func (s *Sheet) layoutPanel(gtx layout.Context, th *theme.Theme, size image.Point, content layout.Widget) {
+	radius := gtx.Dp(th.Radius.RadiusXL)
+	panel := clip.RRect{Rect: image.Rectangle{Max: size}, NW: radius, NE: radius}
+	defer panel.Push(gtx.Ops).Pop()
+
+	paint.Fill(gtx.Ops, th.Colors.Background)
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  panel.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	// Swallow pointer input over the panel so the backdrop doesn't see it
+	event.Op(gtx.Ops, s)
+
+	contentTop := 0
+	if s.Draggable {
+		contentTop = s.layoutHandle(gtx, th, size.X)
+	}
+
+	// Content scrolls independently below the handle
+	offset := op.Offset(image.Pt(0, contentTop)).Push(gtx.Ops)
+	gtx.Constraints = layout.Exact(image.Pt(size.X, max(0, size.Y-contentTop)))
+	s.list.Layout(gtx, 1, func(gtx layout.Context, _ int) layout.Dimensions {
+		return layout.UniformInset(th.Spacing.Space4).Layout(gtx, content)
+	})
+	offset.Pop()
+}
+
+// layoutHandle draws the drag handle bar and registers the drag area.
+// It returns the height of the handle area.
+func (s *Sheet) layoutHandle(gtx layout.Context, th *theme.Theme, width int) int {
+	height := gtx.Dp(handleHeight)
+
+	area := clip.Rect{Max: image.Pt(width, height)}.Push(gtx.Ops)
+	pointer.CursorRowResize.Add(gtx.Ops)
+	event.Op(gtx.Ops, &s.handle)
+	area.Pop()
+
+	barSize := image.Pt(gtx.Dp(unit.Dp(40)), gtx.Dp(unit.Dp(4)))
+	bar := image.Rectangle{Max: barSize}.Add(image.Pt((width-barSize.X)/2, (height-barSize.Y)/2))
+	paint.FillShape(gtx.Ops, th.Colors.Muted, clip.UniformRRect(bar, barSize.Y/2).Op(gtx.Ops))
+
+	return height
+}
+
+// processDrag follows the pointer while the handle is dragged and snaps to
+// the nearest snap point on release.
+func (s *Sheet) processDrag(gtx layout.Context, areaHeight int) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{
+			Target: &s.handle,
+			Kinds:  pointer.Press | pointer.Drag | pointer.Release | pointer.Cancel,
+		})
+		if !ok {
+			break
+		}
+		e, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+
+		// Positions are relative to the handle, which moves with the sheet,
+		// so convert them to the sheet area using last frame's top.
+		y := e.Position.Y + float32(s.top)
+
+		switch e.Kind {
+		case pointer.Press:
+			s.dragging = true
+			s.dragY = y
+		case pointer.Drag:
+			if !s.dragging {
+				continue
+			}
+			delta := (s.dragY - y) / float32(areaHeight)
+			s.dragY = y
+			s.CurrentHeight = max(0, min(s.CurrentHeight+delta, s.maxSnap()))
+		case pointer.Release, pointer.Cancel:
+			if !s.dragging {
+				continue
+			}
+			s.dragging = false
+			s.height.Set(s.CurrentHeight)
+			if s.CurrentHeight < s.minSnap() {
+				s.height.Animate(0)
+			} else {
+				s.height.Animate(s.nearestSnap(s.CurrentHeight))
+			}
+		}
+	}
+}
+
+// processBackdrop closes the sheet when the backdrop is clicked.
+func (s *Sheet) processBackdrop(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: &s.backdrop, Kinds: pointer.Press})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok && e.Kind == pointer.Press {
+			s.Close()
+		}
+	}
+}
+
+func (s *Sheet) nearestSnap(height float32) float32 {
+	nearest := s.minSnap()
+	for _, p := range s.SnapPoints {
+		if math.Abs(float64(p-height)) < math.Abs(float64(nearest-height)) {
+			nearest = p
+		}
+	}
+	return nearest
+}
+
+func (s *Sheet) minSnap() float32 {
+	if len(s.SnapPoints) == 0 {
+		return 0.5
+	}
+	lowest := s.SnapPoints[0]
+	for _, p := range s.SnapPoints[1:] {
+		lowest = min(lowest, p)
+	}
+	return lowest
+}
+
+func (s *Sheet) maxSnap() float32 {
+	if len(s.SnapPoints) == 0 {
+		return 0.5
+	}
+	highest := s.SnapPoints[0]
+	for _, p := range s.SnapPoints[1:] {
+		highest = max(highest, p)
+	}
+	return highest
+}
+
+// Update returns the component state for Sheet.
+func (s *Sheet) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:  s.open,
+		pressed: s.dragging,
+	}
+}
+
+// State implements ComponentState for Sheet.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the sheet is open.
+func (ss *State) IsActive() bool {
+	return ss.active
+}
+
+// IsHovered returns false; sheets have no hover state.
+func (ss *State) IsHovered() bool {
+	return ss.hovered
+}
+
+// IsPressed returns true while the sheet is being dragged.
+func (ss *State) IsPressed() bool {
+	return ss.pressed
+}
+
+// IsDisabled returns true if the sheet is disabled.
+func (ss *State) IsDisabled() bool {
+	return ss.disabled
+}
@@ -0,0 +1,251 @@
+/*
+Package shortcut provides a keyboard shortcut display component for gio-shadcn
+applications.
+
+A Shortcut renders keys such as Ctrl+K as small keycap boxes, like the HTML
+kbd element, for menus and command palettes. Modifier names are shown the way
+the platform writes them: "Mod+S" reads ⌘+S on macOS and Ctrl+S elsewhere.
+
+# Quick Start
+
+Create a shortcut:
+
+	save := shortcut.NewShortcut("Mod", "S")
+
+Use in layout:
+
+	dims := save.Layout(gtx, th)
+
+Or format it as plain text:
+
+	text := shortcut.ShortcutString("Mod", "Shift", "P") // "⌘+⇧+P" on macOS
+
+# Key Names
+
+• Mod - ⌘ on macOS, Ctrl elsewhere, like key.ModShortcut
+• Ctrl, Shift, Alt, Cmd, Super - Modifier keys
+• Enter, Esc, Tab, Backspace, Delete, Space, Up, Down, Left, Right
+• Any other name, such as A-Z, 0-9 or F1-F12, is shown as given, with single
+letters in upper case
+
+# Features
+
+• Platform-specific modifier symbols, detected automatically or forced
+• Keycap boxes with the theme's secondary background and border
+• Plain-text formatting for inline use
+*/
+package shortcut
+
+import (
+	"image"
+	"runtime"
+	"strings"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Keycap dimensions.
+const (
+	keyHeight  = unit.Dp(20)
+	keyPadding = unit.Dp(4)
+)
+
+// Platform selects the modifier symbols of a shortcut.
+type Platform int
+
+// Available platforms.
+const (
+	// PlatformAuto uses the platform the program runs on.
+	PlatformAuto Platform = iota
+	PlatformMac
+	PlatformWindows
+	PlatformLinux
+)
+
+// resolve returns the concrete platform, detecting it from runtime.GOOS for
+// PlatformAuto.
+func (p Platform) resolve() Platform {
+	if p != PlatformAuto {
+		return p
+	}
+	switch runtime.GOOS {
+	case "darwin", "ios":
+		return PlatformMac
+	case "windows":
+		return PlatformWindows
+	default:
+		return PlatformLinux
+	}
+}
+
+// macKeys are the symbols macOS uses for modifier and special keys.
+var macKeys = map[string]string{
+	"mod":       "⌘",
+	"cmd":       "⌘",
+	"command":   "⌘",
+	"ctrl":      "⌃",
+	"control":   "⌃",
+	"alt":       "⌥",
+	"option":    "⌥",
+	"shift":     "⇧",
+	"enter":     "↩",
+	"return":    "↩",
+	"esc":       "⎋",
+	"escape":    "⎋",
+	"tab":       "⇥",
+	"backspace": "⌫",
+	"delete":    "⌦",
+}
+
+// pcKeys are the names Windows and Linux use for modifier and special keys.
+var pcKeys = map[string]string{
+	"mod":       "Ctrl",
+	"ctrl":      "Ctrl",
+	"control":   "Ctrl",
+	"cmd":       "Cmd",
+	"command":   "Cmd",
+	"alt":       "Alt",
+	"option":    "Alt",
+	"shift":     "Shift",
+	"enter":     "Enter",
+	"return":    "Enter",
+	"esc":       "Esc",
+	"escape":    "Esc",
+	"tab":       "Tab",
+	"backspace": "Backspace",
+	"delete":    "Del",
+}
+
+// commonKeys are shown the same way on every platform.
+var commonKeys = map[string]string{
+	"space": "Space",
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+}
+
+// displayKey returns how key is written on platform.
+func displayKey(key string, platform Platform) string {
+	name := strings.ToLower(strings.TrimSpace(key))
+
+	if platform == PlatformMac {
+		if symbol, ok := macKeys[name]; ok {
+			return symbol
+		}
+	} else {
+		if text, ok := pcKeys[name]; ok {
+			return text
+		}
+		if name == "super" || name == "win" || name == "meta" {
+			if platform == PlatformWindows {
+				return "Win"
+			}
+			return "Super"
+		}
+	}
+	if text, ok := commonKeys[name]; ok {
+		return text
+	}
+
+	if len([]rune(key)) == 1 {
+		return strings.ToUpper(key)
+	}
+	return key
+}
+
+// Shortcut displays a key combination as keycaps joined by "+".
+//
+// Example usage:.
+//
+//	palette := shortcut.NewShortcut("Mod", "K")
+//	dims := palette.Layout(gtx, th)
+type Shortcut struct {
+	// Configuration
+	Keys     []string
+	Platform Platform
+}
+
+// NewShortcut creates a Shortcut for keys, using the current platform's
+// modifier symbols.
+func NewShortcut(keys ...string) *Shortcut {
+	return &Shortcut{
+		Keys:     keys,
+		Platform: PlatformAuto,
+	}
+}
+
+// Parse creates a Shortcut from text such as "Ctrl+Shift+S".
+func Parse(text string) *Shortcut {
+	return NewShortcut(strings.Split(text, "+")...)
+}
+
+// ShortcutString formats keys as text with the current platform's modifier
+// symbols, such as "Ctrl+K" or "⌘+K".
+//
+//nolint:revive // ShortcutString is the documented public name
+func ShortcutString(keys ...string) string {
+	return NewShortcut(keys...).String()
+}
+
+// String returns the shortcut as text, with keys joined by "+".
+func (s *Shortcut) String() string {
+	platform := s.Platform.resolve()
+	names := make([]string, len(s.Keys))
+	for i, key := range s.Keys {
+		names[i] = displayKey(key, platform)
+	}
+	return strings.Join(names, "+")
+}
+
+// Layout renders each key as a keycap, with "+" between keys.
+func (s *Shortcut) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	platform := s.Platform.resolve()
+	gtx.Constraints.Min = image.Point{}
+
+	children := make([]layout.FlexChild, 0, 2*len(s.Keys))
+	for i, key := range s.Keys {
+		if i > 0 {
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Inset{Left: th.Spacing.Space1, Right: th.Spacing.Space1}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return label.NewTypography("+", label.Muted, "").Layout(gtx, th)
+				})
+			}))
+		}
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return layoutKey(gtx, th, displayKey(key, platform))
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, children...)
+}
+
+// layoutKey renders one keycap: the key name centered in a bordered box at
+// least as wide as it is high.
+func layoutKey(gtx layout.Context, th *theme.Theme, name string) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	textDims := label.NewTypography(name, label.Small, "").Layout(gtx, th)
+	text := macro.Stop()
+
+	height := gtx.Dp(keyHeight)
+	size := image.Pt(max(textDims.Size.X+2*gtx.Dp(keyPadding), height), height)
+
+	rr := clip.UniformRRect(image.Rectangle{Max: size}, gtx.Dp(th.Radius.RadiusSM))
+	paint.FillShape(gtx.Ops, th.Colors.Secondary, rr.Op(gtx.Ops))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	offset := op.Offset(image.Pt((size.X-textDims.Size.X)/2, (size.Y-textDims.Size.Y)/2)).Push(gtx.Ops)
+	text.Add(gtx.Ops)
+	offset.Pop()
+
+	return layout.Dimensions{Size: size, Baseline: textDims.Baseline + (size.Y-textDims.Size.Y)/2}
+}
@@ -0,0 +1,283 @@
+/*
+Package skeleton provides loading placeholders for gio-shadcn applications.
+
+A Skeleton is a muted rounded block, circle or paragraph of text lines that
+stands in for content while it loads, with a highlight that sweeps across it
+to show that something is happening.
+
+# Quick Start
+
+Create a skeleton line:
+
+	line := skeleton.NewSkeleton(unit.Dp(16), skeleton.WithWidthRatio(0.6))
+
+Create an avatar and a paragraph placeholder:
+
+	avatar := skeleton.NewSkeleton(unit.Dp(40), skeleton.WithShape(skeleton.ShapeCircle))
+	bio := skeleton.NewSkeleton(unit.Dp(16), skeleton.WithShape(skeleton.ShapeText), skeleton.WithLines(3))
+
+Use in layout:
+
+	dims := line.Layout(gtx, th)
+
+Show the placeholder until data arrives:
+
+	layout.Rigid(skeleton.Wrap(th, profile == nil, avatar, avatarWidget))
+
+# Shapes
+
+• ShapeRectangle - Rounded block (default)
+• ShapeCircle - Circle as wide as it is high
+• ShapeText - Lines of text, the last one shorter
+
+# Features
+
+• Fixed width, a fraction of the available width, or the full width
+• Rectangle, circle and text line shapes
+• Wrap helper that swaps in content once loading ends
+• Shimmer highlight that sweeps across the block
+• Theme-based muted color and rounded corners
+• Static blocks when animations are disabled
+*/
+package skeleton
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+)
+
+// ShimmerPeriod is the time the highlight takes to sweep across a skeleton.
+const ShimmerPeriod = 1500 * time.Millisecond
+
+// Text shape defaults.
+const (
+	DefaultLines = 3
+	// lastLineRatio is the width of the last text line relative to the
+	// others.
+	lastLineRatio = 0.6
+)
+
+// Shape is the outline of a skeleton.
+type Shape string
+
+// Shapes.
+const (
+	ShapeRectangle Shape = "rectangle"
+	ShapeCircle    Shape = "circle"
+	ShapeText      Shape = "text"
+)
+
+// Skeleton represents a placeholder block for loading content.
+//
+// Example usage:.
+//
+//	title := skeleton.NewSkeleton(unit.Dp(24), skeleton.WithWidthRatio(0.5))
+//	dims := title.Layout(gtx, th)
+type Skeleton struct {
+	// Configuration
+	Height unit.Dp
+	// Width is a fixed width. When zero, WidthRatio of the available width is
+	// used instead.
+	Width unit.Dp
+	// WidthRatio is the share of the available width (0-1). Zero means the
+	// full width.
+	WidthRatio float32
+	// Radius overrides the theme's medium radius when positive.
+	Radius  unit.Dp
+	Shimmer bool
+	Shape   Shape
+	// Lines is the number of lines of ShapeText skeletons, each Height high.
+	Lines int
+}
+
+// Option is a functional option for configuring Skeleton components.
+type Option func(*Skeleton)
+
+// WithWidth sets a fixed width.
+func WithWidth(width unit.Dp) Option {
+	return func(s *Skeleton) {
+		s.Width = width
+	}
+}
+
+// WithWidthRatio sets the width as a share (0-1) of the available width.
+func WithWidthRatio(ratio float32) Option {
+	return func(s *Skeleton) {
+		s.WidthRatio = ratio
+	}
+}
+
+// WithRadius sets the corner radius.
+func WithRadius(radius unit.Dp) Option {
+	return func(s *Skeleton) {
+		s.Radius = radius
+	}
+}
+
+// WithShimmer enables or disables the sweeping highlight.
+func WithShimmer(shimmer bool) Option {
+	return func(s *Skeleton) {
+		s.Shimmer = shimmer
+	}
+}
+
+// WithShape sets the skeleton shape.
+func WithShape(shape Shape) Option {
+	return func(s *Skeleton) {
+		s.Shape = shape
+	}
+}
+
+// WithLines sets the number of lines of ShapeText skeletons.
+func WithLines(lines int) Option {
+	return func(s *Skeleton) {
+		s.Lines = lines
+	}
+}
+
+// NewSkeleton creates a full-width rectangle Skeleton of the given height,
+// with shimmer enabled. Circles use height as their diameter; text
+// skeletons use it as the line height.
+func NewSkeleton(height unit.Dp, options ...Option) *Skeleton {
+	s := &Skeleton{
+		Height:  height,
+		Shimmer: true,
+		Shape:   ShapeRectangle,
+		Lines:   DefaultLines,
+	}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// Layout draws the shape and, while shimmering, schedules the next frame.
+func (s *Skeleton) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	width := gtx.Constraints.Max.X
+	switch {
+	case s.Width > 0:
+		width = gtx.Dp(s.Width)
+	case s.WidthRatio > 0:
+		width = int(float32(width) * min(s.WidthRatio, 1))
+	}
+	height := gtx.Dp(s.Height)
+
+	switch s.Shape {
+	case ShapeCircle:
+		size := gtx.Constraints.Constrain(image.Pt(height, height))
+		s.fill(gtx, th, size, clip.Ellipse{Max: size}.Op(gtx.Ops))
+		return layout.Dimensions{Size: size}
+	case ShapeText:
+		return s.layoutLines(gtx, th, width, height)
+	}
+
+	size := gtx.Constraints.Constrain(image.Pt(width, height))
+	s.fill(gtx, th, size, s.rect(gtx, th, size).Op(gtx.Ops))
+	return layout.Dimensions{Size: size}
+}
+
+// layoutLines draws Lines rounded lines of the given width and height, the
+// last one shorter, separated by the theme's small spacing.
+func (s *Skeleton) layoutLines(gtx layout.Context, th *theme.Theme, width, height int) layout.Dimensions {
+	lines := max(1, s.Lines)
+	gap := gtx.Dp(th.Spacing.Space2)
+
+	total := image.Pt(width, lines*height+(lines-1)*gap)
+	for i := range lines {
+		lineWidth := width
+		if lines > 1 && i == lines-1 {
+			lineWidth = int(float32(width) * lastLineRatio)
+		}
+		size := image.Pt(lineWidth, height)
+
+		offset := op.Offset(image.Pt(0, i*(height+gap))).Push(gtx.Ops)
+		s.fill(gtx, th, size, s.rect(gtx, th, size).Op(gtx.Ops))
+		offset.Pop()
+	}
+
+	return layout.Dimensions{Size: gtx.Constraints.Constrain(total)}
+}
+
+// rect returns the rounded rectangle outline of a block of size.
+func (s *Skeleton) rect(gtx layout.Context, th *theme.Theme, size image.Point) clip.RRect {
+	radius := th.Radius.RadiusMD
+	if s.Radius > 0 {
+		radius = s.Radius
+	}
+	return clip.UniformRRect(image.Rectangle{Max: size}, min(gtx.Dp(radius), size.Y/2))
+}
+
+// fill paints the muted shape and the shimmer within it.
+func (s *Skeleton) fill(gtx layout.Context, th *theme.Theme, size image.Point, shape clip.Op) {
+	paint.FillShape(gtx.Ops, th.Colors.Muted, shape)
+
+	if s.Shimmer && !th.ReducedMotion() && size.X > 0 {
+		stack := shape.Push(gtx.Ops)
+		drawShimmer(gtx, th, size)
+		stack.Pop()
+		gtx.Execute(op.InvalidateCmd{})
+	}
+}
+
+// Wrap returns a widget that lays out placeholder while loading is true and
+// content otherwise.
+//
+// Example:.
+//
+//	avatar := skeleton.NewSkeleton(unit.Dp(40), skeleton.WithShape(skeleton.ShapeCircle))
+//	dims := skeleton.Wrap(th, user == nil, avatar, userAvatar)(gtx)
+func Wrap(th *theme.Theme, loading bool, placeholder *Skeleton, content layout.Widget) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		if loading && placeholder != nil {
+			return placeholder.Layout(gtx, th)
+		}
+		return content(gtx)
+	}
+}
+
+// drawShimmer paints a soft highlight band at its current position. The band
+// is as wide as the block and travels from fully left of it to fully right.
+func drawShimmer(gtx layout.Context, th *theme.Theme, size image.Point) {
+	elapsed := gtx.Now.UnixNano() % int64(ShimmerPeriod)
+	progress := float32(elapsed) / float32(ShimmerPeriod)
+
+	band := float32(size.X)
+	left := -band + progress*(float32(size.X)+band)
+	center := left + band/2
+	highlight := colorutil.Mix(th.Colors.Muted, th.Colors.Background, 0.6)
+	transparent := color.NRGBA{R: highlight.R, G: highlight.G, B: highlight.B}
+
+	halves := []struct {
+		from, to   float32
+		start, end color.NRGBA
+	}{
+		{left, center, transparent, highlight},
+		{center, left + band, highlight, transparent},
+	}
+	for _, h := range halves {
+		area := clip.Rect{
+			Min: image.Pt(int(h.from), 0),
+			Max: image.Pt(int(h.to)+1, size.Y),
+		}.Push(gtx.Ops)
+		paint.LinearGradientOp{
+			Stop1:  f32.Pt(h.from, 0),
+			Color1: h.start,
+			Stop2:  f32.Pt(h.to, 0),
+			Color2: h.end,
+		}.Add(gtx.Ops)
+		paint.PaintOp{}.Add(gtx.Ops)
+		area.Pop()
+	}
+}
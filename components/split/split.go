@@ -0,0 +1,105 @@
+/*
+Package split provides a fixed two-panel split layout for gio-shadcn applications.
+
+A Split divides the available space between two widgets along an axis, separated
+by a colored divider line. The split is static: the ratio is set in code and
+cannot be dragged by the user, which keeps it simple for layouts such as a
+header/content split or a side panel.
+
+# Quick Start
+
+Create a horizontal split with a 30% side panel:
+
+	s := split.NewHSplit(sidebar, content, 0.3)
+
+Create a vertical split:
+
+	s := split.NewVSplit(header, body, 0.2)
+
+Use in layout:
+
+	dims := s.Layout(gtx, th)
+*/
+package split
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Split represents a two-panel layout with a fixed ratio.
+type Split struct {
+	// Configuration
+	First        layout.Widget
+	Second       layout.Widget
+	Axis         layout.Axis
+	Ratio        float32
+	Divider      unit.Dp
+	DividerColor color.NRGBA
+}
+
+// NewHSplit creates a split with the panels side by side.
+// Ratio is the share of the space given to the first panel, from 0 to 1.
+func NewHSplit(first, second layout.Widget, ratio float32) *Split {
+	return newSplit(layout.Horizontal, first, second, ratio)
+}
+
+// NewVSplit creates a split with the panels stacked vertically.
+// Ratio is the share of the space given to the first panel, from 0 to 1.
+func NewVSplit(first, second layout.Widget, ratio float32) *Split {
+	return newSplit(layout.Vertical, first, second, ratio)
+}
+
+func newSplit(axis layout.Axis, first, second layout.Widget, ratio float32) *Split {
+	return &Split{
+		First:   first,
+		Second:  second,
+		Axis:    axis,
+		Ratio:   ratio,
+		Divider: unit.Dp(4),
+	}
+}
+
+// Layout renders both panels and the divider between them.
+// When DividerColor is unset, the theme border color is used.
+func (s *Split) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	ratio := max(0, min(s.Ratio, 1))
+
+	dividerColor := s.DividerColor
+	if dividerColor == (color.NRGBA{}) {
+		dividerColor = th.Colors.Border
+	}
+
+	return layout.Flex{Axis: s.Axis}.Layout(gtx,
+		layout.Flexed(ratio, panel(s.First)),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			thickness := gtx.Dp(s.Divider)
+			size := image.Pt(thickness, gtx.Constraints.Max.Y)
+			if s.Axis == layout.Vertical {
+				size = image.Pt(gtx.Constraints.Max.X, thickness)
+			}
+			paint.FillShape(gtx.Ops, dividerColor, clip.Rect{Max: size}.Op())
+			return layout.Dimensions{Size: size}
+		}),
+		layout.Flexed(1-ratio, panel(s.Second)),
+	)
+}
+
+// panel fills the flexed space and clips the widget to it.
+func panel(w layout.Widget) layout.Widget {
+	return func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min = gtx.Constraints.Max
+		if w == nil {
+			return layout.Dimensions{Size: gtx.Constraints.Max}
+		}
+		defer clip.Rect{Max: gtx.Constraints.Max}.Push(gtx.Ops).Pop()
+		w(gtx)
+		return layout.Dimensions{Size: gtx.Constraints.Max}
+	}
+}
@@ -0,0 +1,135 @@
+package table
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Column factory defaults.
+const (
+	defaultNumberFormat = "%g"
+	defaultDateFormat   = "2006-01-02"
+	trueMark            = "✓"
+	falseMark           = "×"
+)
+
+// TableAction is a button in an ActionColumn. Handler is called with the
+// row of the clicked button.
+//
+//nolint:revive // TableAction is the documented public name
+type TableAction[T any] struct {
+	Label   string
+	Variant theme.Variant
+	Handler func(row T)
+}
+
+// TextColumn creates a column showing the text returned by getter. The header
+// doubles as the column key.
+//
+// Example:.
+//
+//	name := table.TextColumn("Name", func(u User) string { return u.Name })
+func TextColumn[T any](header string, getter func(row T) string) Column[T] {
+	return Column[T]{
+		Key:    header,
+		Header: header,
+		Cell:   getter,
+	}
+}
+
+// NumberColumn creates a column showing the number returned by getter with
+// the fmt verb format, such as "%.2f". An empty format uses "%g".
+func NumberColumn[T any](header string, getter func(row T) float64, format string) Column[T] {
+	if format == "" {
+		format = defaultNumberFormat
+	}
+	return TextColumn(header, func(row T) string {
+		return fmt.Sprintf(format, getter(row))
+	})
+}
+
+// BoolColumn creates a column showing a checkmark for true and × for false.
+func BoolColumn[T any](header string, getter func(row T) bool) Column[T] {
+	return TextColumn(header, func(row T) string {
+		if getter(row) {
+			return trueMark
+		}
+		return falseMark
+	})
+}
+
+// DateColumn creates a column showing the time returned by getter with the
+// time layout format. An empty format uses "2006-01-02", and zero times are
+// left blank.
+func DateColumn[T any](header string, getter func(row T) time.Time, format string) Column[T] {
+	if format == "" {
+		format = defaultDateFormat
+	}
+	return TextColumn(header, func(row T) string {
+		t := getter(row)
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(format)
+	})
+}
+
+// ActionColumn creates a column with a row of small buttons, one per action,
+// in every row.
+//
+// Example:.
+//
+//	actions := table.ActionColumn("", table.TableAction[User]{
+//		Label:   "Delete",
+//		Variant: theme.VariantDestructive,
+//		Handler: func(u User) { deleteUser(u.ID) },
+//	})
+func ActionColumn[T any](header string, actions ...TableAction[T]) Column[T] {
+	// Buttons for each row position on the page, created on first use
+	var buttons [][]*button.Button
+
+	return Column[T]{
+		Key:    header,
+		Header: header,
+		Render: func(gtx layout.Context, th *theme.Theme, index int, row T) layout.Dimensions {
+			for len(buttons) <= index {
+				rowButtons := make([]*button.Button, len(actions))
+				for i, action := range actions {
+					rowButtons[i] = button.NewButton(
+						button.WithText(action.Label),
+						button.WithVariant(action.Variant),
+						button.WithSize(theme.SizeSM),
+					)
+				}
+				buttons = append(buttons, rowButtons)
+			}
+
+			children := make([]layout.FlexChild, 0, 2*len(actions))
+			for i, action := range actions {
+				if i > 0 {
+					children = append(children, layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout))
+				}
+				btn := buttons[index][i]
+				btn.OnClick = nil
+				if action.Handler != nil {
+					btn.OnClick = func() { action.Handler(row) }
+				}
+				children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return btn.Layout(gtx, th)
+				}))
+			}
+
+			return layout.Inset{Left: th.Spacing.Space3, Right: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					gtx.Constraints.Min = image.Point{}
+					return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx, children...)
+				})
+			})
+		},
+	}
+}
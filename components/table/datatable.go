@@ -0,0 +1,582 @@
+/*
+Package table provides table components for gio-shadcn applications.
+
+Table lays out rows that are already in memory from Header, Row and Cell
+building blocks. DataTable displays one page of rows at a time and delegates
+sorting, filtering and paging to a fetch function, typically backed by a
+server.
+
+# Quick Start
+
+Build a static table:
+
+	invoices := table.NewTable(
+		[]table.ColumnWidth{table.FitContent(), table.WeightedWidth(2), table.FixedWidth(unit.Dp(96))},
+		table.WithHeader(table.NewHeader("Invoice", "Method", "Amount")),
+		table.WithRows(
+			table.NewRow("INV001", "Credit Card", "$250.00"),
+			table.NewRow("INV002", "PayPal", "$150.00"),
+		),
+		table.WithStriped(true),
+	)
+
+Define the columns and a fetch function:
+
+	users := table.NewDataTable([]table.Column[User]{
+		{Key: "name", Header: "Name", Sortable: true, Cell: func(u User) string { return u.Name }},
+		{Key: "email", Header: "Email", Cell: func(u User) string { return u.Email }},
+	}, func(page, pageSize int, sort table.SortSpec, filter table.FilterSpec) ([]User, int, error) {
+		return api.ListUsers(page, pageSize, sort, filter)
+	})
+	users.Invalidate = w.Invalidate
+
+Filter and select rows held in memory:
+
+	columns := []table.Column[User]{
+		{Key: "name", Header: "Name", Sortable: true, Filterable: true, Cell: func(u User) string { return u.Name }},
+	}
+	users := table.NewDataTable(columns, table.SliceSource(allUsers, columns))
+	users.Selectable = true
+	users.RowKey = func(u User) string { return u.ID }
+
+Use in layout:
+
+	dims := users.Layout(gtx, th)
+
+# Features
+
+• Static tables with a muted header, row hover highlighting and striped rows
+• Placeholder empty state for static tables without rows
+• Fixed, weighted and fit-content column widths
+• Server-side paging, sorting and filtering through FetchPage
+• In-memory rows through SliceSource
+• Per-column text filters below the header
+• Row selection checkboxes with a select-page checkbox in the header
+• Sortable column headers that toggle ascending and descending order
+• Column factories for text, number, boolean, date and action columns
+• Custom cell rendering through Column.Render
+• Loading overlay while a page is being fetched
+• Page controls with item range and page count
+*/
+package table
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"sync"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/components/input"
+	"github.com/bnema/gio-shadcn/components/loadingoverlay"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// DefaultPageSize is the page size used when PageSize is zero.
+const DefaultPageSize = 10
+
+// Table geometry.
+const (
+	rowHeight     = unit.Dp(40)
+	minBodyHeight = unit.Dp(120)
+)
+
+// SortDirection is the order of a sorted column.
+type SortDirection int
+
+// Sort directions.
+const (
+	SortNone SortDirection = iota
+	SortAsc
+	SortDesc
+)
+
+// SortSpec describes the sorted column. An empty Column means unsorted.
+type SortSpec struct {
+	Column    string
+	Direction SortDirection
+}
+
+// FilterSpec maps column keys to filter values.
+type FilterSpec map[string]string
+
+// FetchFunc returns the rows of a zero-based page and the total number of
+// items matching filter.
+type FetchFunc[T any] func(page, pageSize int, sort SortSpec, filter FilterSpec) ([]T, int, error)
+
+// Column describes a DataTable column. Key identifies the column in SortSpec
+// and FilterSpec, and Cell formats a row's value. Render, when set, draws the
+// cell instead, for content other than text; index is the row's position on
+// the current page, to keep per-row widget state. Columns with a zero Width
+// share the remaining space equally. Filterable columns get a text filter
+// below the header, and Compare orders rows for SliceSource.
+type Column[T any] struct {
+	Key        string
+	Header     string
+	Sortable   bool
+	Filterable bool
+	Width      unit.Dp
+	Cell       func(row T) string
+	Render     func(gtx layout.Context, th *theme.Theme, index int, row T) layout.Dimensions
+	Compare    func(a, b T) int
+}
+
+// DataTable is a paged table whose rows come from FetchPage. Whenever the
+// page, sort or filter changes, FetchPage runs in a goroutine while a loading
+// overlay covers the table body. Results are applied on the next frame, so set
+// Invalidate to request one.
+//
+// Selectable tables show a checkbox on every row and one in the header that
+// selects the whole page. Rows stay selected across pages; set RowKey so rows
+// are recognized when they are fetched again.
+//
+// Example usage:.
+//
+//	users := table.NewDataTable(columns, fetchUsers)
+//	users.Invalidate = w.Invalidate
+//	users.SetFilter(table.FilterSpec{"name": query})
+//	dims := users.Layout(gtx, th)
+type DataTable[T any] struct {
+	// Configuration
+	Columns    []Column[T]
+	FetchPage  FetchFunc[T]
+	PageSize   int
+	Invalidate func()
+	OnError    func(error)
+
+	Selectable        bool
+	RowKey            func(row T) string
+	OnSelectionChange func(selected []T)
+
+	// State
+	rows        []T
+	currentPage int
+	totalItems  int
+	loading     bool
+	sort        SortSpec
+	filter      FilterSpec
+	err         error
+	stale       bool
+
+	headers  []widget.Clickable
+	previous *button.Button
+	next     *button.Button
+	overlay  *loadingoverlay.LoadingOverlay
+
+	filterInputs []*input.Input
+	filterSynced bool
+
+	headerCheck  widget.Clickable
+	rowChecks    []widget.Clickable
+	selected     map[string]T
+	selectedKeys []string
+
+	// Fetch results, written by the fetch goroutine
+	mu         sync.Mutex
+	generation int
+	result     *fetchResult[T]
+}
+
+// fetchResult is the outcome of one FetchPage call.
+type fetchResult[T any] struct {
+	rows  []T
+	total int
+	err   error
+}
+
+// NewDataTable creates a data table that loads its first page on first render.
+func NewDataTable[T any](columns []Column[T], fetch FetchFunc[T]) *DataTable[T] {
+	d := &DataTable[T]{
+		Columns:   columns,
+		FetchPage: fetch,
+		stale:     true,
+		overlay:   loadingoverlay.NewLoadingOverlay(loadingoverlay.WithSize(loadingoverlay.OverlaySizeSM)),
+	}
+	d.previous = button.NewButton(
+		button.WithVariant(theme.VariantOutline),
+		button.WithSize(theme.SizeSM),
+		button.WithOnClick(func() { d.SetPage(d.currentPage - 1) }),
+	)
+	d.next = button.NewButton(
+		button.WithVariant(theme.VariantOutline),
+		button.WithSize(theme.SizeSM),
+		button.WithOnClick(func() { d.SetPage(d.currentPage + 1) }),
+	)
+	return d
+}
+
+// Rows returns the rows of the current page.
+func (d *DataTable[T]) Rows() []T {
+	return d.rows
+}
+
+// Page returns the zero-based current page.
+func (d *DataTable[T]) Page() int {
+	return d.currentPage
+}
+
+// TotalItems returns the item count reported by the last fetch.
+func (d *DataTable[T]) TotalItems() int {
+	return d.totalItems
+}
+
+// Loading reports whether a page is being fetched.
+func (d *DataTable[T]) Loading() bool {
+	return d.loading
+}
+
+// Sort returns the current sort.
+func (d *DataTable[T]) Sort() SortSpec {
+	return d.sort
+}
+
+// SetPage switches to a zero-based page, clamped to the known page range.
+func (d *DataTable[T]) SetPage(page int) {
+	page = max(0, min(page, d.pageCount()-1))
+	if page != d.currentPage {
+		d.currentPage = page
+		d.stale = true
+	}
+}
+
+// SetSort sorts by spec and returns to the first page.
+func (d *DataTable[T]) SetSort(spec SortSpec) {
+	d.sort = spec
+	d.currentPage = 0
+	d.stale = true
+}
+
+// Filter returns the current filter.
+func (d *DataTable[T]) Filter() FilterSpec {
+	return d.filter
+}
+
+// SetFilter filters by spec and returns to the first page. The column filter
+// inputs are updated to match.
+func (d *DataTable[T]) SetFilter(spec FilterSpec) {
+	d.applyFilter(spec)
+	d.filterSynced = false
+}
+
+func (d *DataTable[T]) applyFilter(spec FilterSpec) {
+	d.filter = spec
+	d.currentPage = 0
+	d.stale = true
+}
+
+// Refresh fetches the current page again.
+func (d *DataTable[T]) Refresh() {
+	d.stale = true
+}
+
+// pageCount returns the number of pages, at least one.
+func (d *DataTable[T]) pageCount() int {
+	size := d.pageSize()
+	return max(1, (d.totalItems+size-1)/size)
+}
+
+func (d *DataTable[T]) pageSize() int {
+	if d.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return d.PageSize
+}
+
+// toggleSort sorts by column key, flipping the direction when it is already
+// the sorted column.
+func (d *DataTable[T]) toggleSort(key string) {
+	spec := SortSpec{Column: key, Direction: SortAsc}
+	if d.sort.Column == key && d.sort.Direction == SortAsc {
+		spec.Direction = SortDesc
+	}
+	d.SetSort(spec)
+}
+
+// fetch starts fetching the current page. Results of earlier fetches that are
+// still running are discarded.
+func (d *DataTable[T]) fetch() {
+	d.stale = false
+	if d.FetchPage == nil {
+		return
+	}
+
+	d.mu.Lock()
+	d.generation++
+	generation := d.generation
+	d.mu.Unlock()
+
+	d.loading = true
+	d.overlay.Show("")
+
+	page, size, sort, filter := d.currentPage, d.pageSize(), d.sort, d.filter
+	fetchPage := d.FetchPage
+	go func() {
+		rows, total, err := fetchPage(page, size, sort, filter)
+
+		d.mu.Lock()
+		if generation == d.generation {
+			d.result = &fetchResult[T]{rows: rows, total: total, err: err}
+		}
+		d.mu.Unlock()
+
+		if d.Invalidate != nil {
+			d.Invalidate()
+		}
+	}()
+}
+
+// applyResult applies a finished fetch, if any.
+func (d *DataTable[T]) applyResult() {
+	d.mu.Lock()
+	result := d.result
+	d.result = nil
+	d.mu.Unlock()
+
+	if result == nil {
+		return
+	}
+
+	d.loading = false
+	d.overlay.Hide()
+	d.err = result.err
+	if result.err != nil {
+		if d.OnError != nil {
+			d.OnError(result.err)
+		}
+		return
+	}
+	d.rows = result.rows
+	d.totalItems = result.total
+}
+
+// Layout renders the header, the current page of rows and the page controls.
+func (d *DataTable[T]) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	d.applyResult()
+
+	if len(d.headers) != len(d.Columns) {
+		d.headers = make([]widget.Clickable, len(d.Columns))
+	}
+	for idx, column := range d.Columns {
+		if d.headers[idx].Clicked(gtx) && column.Sortable {
+			d.toggleSort(column.Key)
+		}
+	}
+
+	if d.Selectable {
+		d.processSelection(gtx)
+	}
+	d.syncFilterInputs()
+
+	if d.stale {
+		d.fetch()
+	}
+
+	children := []layout.FlexChild{
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.layoutHeader(gtx, th)
+		}),
+	}
+	if d.hasFilters() {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.layoutFilters(gtx, th)
+		}))
+	}
+	children = append(children,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.overlay.Layout(gtx, th, func(gtx layout.Context) layout.Dimensions {
+				return d.layoutBody(gtx, th)
+			})
+		}),
+		layout.Rigid(layout.Spacer{Height: th.Spacing.Space3}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.layoutPageControls(gtx, th)
+		}),
+	)
+	dims := layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+
+	d.processFilters()
+
+	// Page buttons and filter inputs change the page while laying out; fetch
+	// on the next frame
+	if d.stale {
+		gtx.Execute(op.InvalidateCmd{})
+	}
+
+	return dims
+}
+
+// layoutHeader renders the header row with sort indicators and, for
+// selectable tables, the page checkbox.
+func (d *DataTable[T]) layoutHeader(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	var lead layout.Widget
+	if d.Selectable {
+		lead = func(gtx layout.Context) layout.Dimensions {
+			return layoutCheckbox(gtx, th, &d.headerCheck, d.pageCheckState())
+		}
+	}
+	return d.layoutRow(gtx, th, th.Colors.Muted, lead, func(gtx layout.Context, idx int) layout.Dimensions {
+		column := d.Columns[idx]
+		text := column.Header
+		if column.Sortable && d.sort.Column == column.Key {
+			switch d.sort.Direction {
+			case SortAsc:
+				text += " ▲"
+			case SortDesc:
+				text += " ▼"
+			}
+		}
+
+		cell := func(gtx layout.Context) layout.Dimensions {
+			return layoutCell(gtx, th, text, th.Colors.MutedFg, font.SemiBold)
+		}
+		if !column.Sortable {
+			return cell(gtx)
+		}
+		return d.headers[idx].Layout(gtx, cell)
+	})
+}
+
+// layoutBody renders the rows, or a message when there are none.
+func (d *DataTable[T]) layoutBody(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+
+	message := ""
+	switch {
+	case d.err != nil:
+		message = d.err.Error()
+	case len(d.rows) == 0 && !d.loading:
+		message = th.Locale.T(i18n.KeyNoResults)
+	case len(d.rows) == 0:
+		message = " "
+	}
+	if message != "" {
+		gtx.Constraints.Min.Y = gtx.Dp(minBodyHeight)
+		return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			fg := th.Colors.MutedFg
+			if d.err != nil {
+				fg = th.Colors.Destructive
+			}
+			return layoutCell(gtx, th, message, fg, font.Normal)
+		})
+	}
+
+	rows := make([]layout.FlexChild, len(d.rows))
+	for r, row := range d.rows {
+		rows[r] = layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			bg := th.Colors.Background
+			var lead layout.Widget
+			if d.Selectable {
+				state := unchecked
+				if d.IsSelected(row) {
+					state = checked
+					bg = th.Colors.Muted
+				}
+				lead = func(gtx layout.Context) layout.Dimensions {
+					return layoutCheckbox(gtx, th, &d.rowChecks[r], state)
+				}
+			}
+			return d.layoutRow(gtx, th, bg, lead, func(gtx layout.Context, idx int) layout.Dimensions {
+				if render := d.Columns[idx].Render; render != nil {
+					return render(gtx, th, r, row)
+				}
+				text := ""
+				if cell := d.Columns[idx].Cell; cell != nil {
+					text = cell(row)
+				}
+				return layoutCell(gtx, th, text, th.Colors.Foreground, font.Normal)
+			})
+		})
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+// layoutRow lays out one cell per column over bg, with a bottom border. lead,
+// when set, fills the selection column before the first cell.
+func (d *DataTable[T]) layoutRow(gtx layout.Context, th *theme.Theme, bg color.NRGBA, lead layout.Widget, cell func(gtx layout.Context, idx int) layout.Dimensions) layout.Dimensions {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	height := gtx.Dp(rowHeight)
+	size := image.Pt(gtx.Constraints.Max.X, height)
+
+	paint.FillShape(gtx.Ops, bg, clip.Rect{Max: size}.Op())
+	border := gtx.Dp(unit.Dp(1))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect{Min: image.Pt(0, height-border), Max: size}.Op())
+
+	children := make([]layout.FlexChild, 0, len(d.Columns)+1)
+	if d.Selectable {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints = layout.Exact(image.Pt(gtx.Dp(checkboxColumn), height))
+			if lead == nil {
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			}
+			return lead(gtx)
+		}))
+	}
+	for idx, column := range d.Columns {
+		w := func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			gtx.Constraints.Min.Y = height
+			gtx.Constraints.Max.Y = height
+			return cell(gtx, idx)
+		}
+		if column.Width > 0 {
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Max.X = min(gtx.Dp(column.Width), gtx.Constraints.Max.X)
+				return w(gtx)
+			}))
+		} else {
+			children = append(children, layout.Flexed(1, w))
+		}
+	}
+	layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+
+	return layout.Dimensions{Size: size}
+}
+
+// layoutCell renders vertically centered, single-line cell text.
+func layoutCell(gtx layout.Context, th *theme.Theme, text string, fg color.NRGBA, weight font.Weight) layout.Dimensions {
+	return layout.Inset{Left: th.Spacing.Space3, Right: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, text)
+			lbl.Color = fg
+			lbl.Font.Weight = weight
+			lbl.MaxLines = 1
+			return lbl.Layout(gtx)
+		})
+	})
+}
+
+// layoutPageControls renders the item range and Previous/Next buttons.
+func (d *DataTable[T]) layoutPageControls(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	first := min(d.currentPage*d.pageSize()+1, d.totalItems)
+	last := min((d.currentPage+1)*d.pageSize(), d.totalItems)
+	summary := fmt.Sprintf(th.Locale.T(i18n.KeyPageSummary), first, last, d.totalItems, d.currentPage+1, d.pageCount())
+
+	d.previous.SetText(th.Locale.T(i18n.KeyPrevious))
+	d.next.SetText(th.Locale.T(i18n.KeyNext))
+	d.previous.SetDisabled(d.currentPage == 0 || d.loading)
+	d.next.SetDisabled(d.currentPage >= d.pageCount()-1 || d.loading)
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, summary)
+			lbl.Color = th.Colors.MutedFg
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.previous.Layout(gtx, th)
+		}),
+		layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return d.next.Layout(gtx, th)
+		}),
+	)
+}
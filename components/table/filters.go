@@ -0,0 +1,90 @@
+package table
+
+import (
+	"maps"
+
+	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/components/input"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// hasFilters reports whether any column is filterable.
+func (d *DataTable[T]) hasFilters() bool {
+	for _, column := range d.Columns {
+		if column.Filterable {
+			return true
+		}
+	}
+	return false
+}
+
+// syncFilterInputs creates the filter inputs of filterable columns and, after
+// SetFilter, shows the filter values in them.
+func (d *DataTable[T]) syncFilterInputs() {
+	if len(d.filterInputs) != len(d.Columns) {
+		d.filterInputs = make([]*input.Input, len(d.Columns))
+		for idx, column := range d.Columns {
+			if column.Filterable {
+				d.filterInputs[idx] = input.NewInput(
+					input.WithPlaceholder(column.Header),
+					input.WithInputSize(input.InputSizeSmall),
+				)
+			}
+		}
+		d.filterSynced = false
+	}
+
+	if d.filterSynced {
+		return
+	}
+	for idx, column := range d.Columns {
+		if in := d.filterInputs[idx]; in != nil {
+			in.SetText(d.filter[column.Key])
+		}
+	}
+	d.filterSynced = true
+}
+
+// processFilters applies text typed into the filter inputs. Empty inputs
+// remove their column from the filter.
+func (d *DataTable[T]) processFilters() {
+	var spec FilterSpec
+	for idx, column := range d.Columns {
+		in := d.filterInputs[idx]
+		if in == nil || in.Text() == d.filter[column.Key] {
+			continue
+		}
+		if spec == nil {
+			spec = maps.Clone(d.filter)
+			if spec == nil {
+				spec = FilterSpec{}
+			}
+		}
+		if text := in.Text(); text != "" {
+			spec[column.Key] = text
+		} else {
+			delete(spec, column.Key)
+		}
+	}
+
+	if spec != nil {
+		d.applyFilter(spec)
+	}
+}
+
+// layoutFilters renders the filter row, with an input under each filterable
+// column.
+func (d *DataTable[T]) layoutFilters(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	return d.layoutRow(gtx, th, th.Colors.Background, nil, func(gtx layout.Context, idx int) layout.Dimensions {
+		in := d.filterInputs[idx]
+		if in == nil {
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}
+		return layout.Inset{Left: th.Spacing.Space2, Right: th.Spacing.Space2}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min.X = gtx.Constraints.Max.X
+				return in.Layout(gtx, th)
+			})
+		})
+	})
+}
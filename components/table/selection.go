@@ -0,0 +1,184 @@
+package table
+
+import (
+	"fmt"
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/theme"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Checkbox geometry.
+const (
+	checkboxSize   = unit.Dp(16)
+	checkboxColumn = unit.Dp(40)
+)
+
+// Checkbox icons. The icon data is constant, so NewIcon cannot fail.
+var (
+	checkIcon, _ = widget.NewIcon(icons.NavigationCheck)
+	mixedIcon, _ = widget.NewIcon(icons.ContentRemove)
+)
+
+// checkState is the look of a selection checkbox.
+type checkState int
+
+const (
+	unchecked checkState = iota
+	checked
+	// mixed is the header checkbox when only some rows are selected.
+	mixed
+)
+
+// Selected returns the selected rows in the order they were selected,
+// including rows on other pages.
+func (d *DataTable[T]) Selected() []T {
+	rows := make([]T, len(d.selectedKeys))
+	for i, key := range d.selectedKeys {
+		rows[i] = d.selected[key]
+	}
+	return rows
+}
+
+// IsSelected reports whether row is selected.
+func (d *DataTable[T]) IsSelected(row T) bool {
+	_, ok := d.selected[d.rowKey(row)]
+	return ok
+}
+
+// SetSelected selects or deselects row.
+func (d *DataTable[T]) SetSelected(row T, selected bool) {
+	if d.setSelected(row, selected) {
+		d.selectionChanged()
+	}
+}
+
+// ClearSelection deselects every row.
+func (d *DataTable[T]) ClearSelection() {
+	if len(d.selectedKeys) == 0 {
+		return
+	}
+	clear(d.selected)
+	d.selectedKeys = d.selectedKeys[:0]
+	d.selectionChanged()
+}
+
+// setSelected updates the selection without notifying, and reports whether
+// it changed.
+func (d *DataTable[T]) setSelected(row T, selected bool) bool {
+	key := d.rowKey(row)
+	_, ok := d.selected[key]
+	if ok == selected {
+		return false
+	}
+
+	if selected {
+		if d.selected == nil {
+			d.selected = make(map[string]T)
+		}
+		d.selected[key] = row
+		d.selectedKeys = append(d.selectedKeys, key)
+		return true
+	}
+
+	delete(d.selected, key)
+	for i, k := range d.selectedKeys {
+		if k == key {
+			d.selectedKeys = append(d.selectedKeys[:i], d.selectedKeys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (d *DataTable[T]) selectionChanged() {
+	if d.OnSelectionChange != nil {
+		d.OnSelectionChange(d.Selected())
+	}
+}
+
+// rowKey identifies row in the selection. Without RowKey, rows are told
+// apart by their formatted value.
+func (d *DataTable[T]) rowKey(row T) string {
+	if d.RowKey != nil {
+		return d.RowKey(row)
+	}
+	return fmt.Sprintf("%#v", row)
+}
+
+// pageCheckState returns the header checkbox state for the current page.
+func (d *DataTable[T]) pageCheckState() checkState {
+	count := 0
+	for _, row := range d.rows {
+		if d.IsSelected(row) {
+			count++
+		}
+	}
+	switch {
+	case count == 0:
+		return unchecked
+	case count == len(d.rows):
+		return checked
+	default:
+		return mixed
+	}
+}
+
+// processSelection handles clicks on the header and row checkboxes. The
+// header checkbox selects the whole page, or clears it when it is checked.
+func (d *DataTable[T]) processSelection(gtx layout.Context) {
+	if len(d.rowChecks) != len(d.rows) {
+		d.rowChecks = make([]widget.Clickable, len(d.rows))
+	}
+
+	changed := false
+	if d.headerCheck.Clicked(gtx) && len(d.rows) > 0 {
+		selectAll := d.pageCheckState() != checked
+		for _, row := range d.rows {
+			changed = d.setSelected(row, selectAll) || changed
+		}
+	}
+	for r, row := range d.rows {
+		if d.rowChecks[r].Clicked(gtx) {
+			changed = d.setSelected(row, !d.IsSelected(row)) || changed
+		}
+	}
+
+	if changed {
+		d.selectionChanged()
+	}
+}
+
+// layoutCheckbox renders a checkbox centered in the selection column.
+func layoutCheckbox(gtx layout.Context, th *theme.Theme, click *widget.Clickable, state checkState) layout.Dimensions {
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return click.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			size := gtx.Dp(checkboxSize)
+			box := image.Rectangle{Max: image.Pt(size, size)}
+			radius := gtx.Dp(th.Radius.RadiusSM)
+
+			if state == unchecked {
+				border := float32(gtx.Dp(unit.Dp(1)))
+				paint.FillShape(gtx.Ops, th.Colors.Primary, clip.Stroke{
+					Path:  clip.UniformRRect(box, radius).Path(gtx.Ops),
+					Width: border,
+				}.Op())
+				return layout.Dimensions{Size: box.Max}
+			}
+
+			paint.FillShape(gtx.Ops, th.Colors.Primary, clip.UniformRRect(box, radius).Op(gtx.Ops))
+			icon := checkIcon
+			if state == mixed {
+				icon = mixedIcon
+			}
+			gtx.Constraints = layout.Exact(box.Max)
+			icon.Layout(gtx, th.Colors.PrimaryFg)
+			return layout.Dimensions{Size: box.Max}
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package table
+
+import (
+	"slices"
+	"strings"
+)
+
+// SliceSource returns a fetch function over rows held in memory, for tables
+// that do not need a server. It keeps the rows whose Cell text contains each
+// filter value, ignoring case, and sorts them with the column's Compare, or
+// by Cell text when Compare is nil. rows is not modified.
+//
+// Example:.
+//
+//	users := table.NewDataTable(columns, table.SliceSource(allUsers, columns))
+func SliceSource[T any](rows []T, columns []Column[T]) FetchFunc[T] {
+	byKey := make(map[string]Column[T], len(columns))
+	for _, column := range columns {
+		byKey[column.Key] = column
+	}
+
+	return func(page, pageSize int, sort SortSpec, filter FilterSpec) ([]T, int, error) {
+		matches := make([]T, 0, len(rows))
+		for _, row := range rows {
+			if matchesFilter(row, filter, byKey) {
+				matches = append(matches, row)
+			}
+		}
+
+		if column, ok := byKey[sort.Column]; ok && sort.Direction != SortNone {
+			compare := column.Compare
+			if compare == nil {
+				compare = func(a, b T) int {
+					return strings.Compare(cellText(column, a), cellText(column, b))
+				}
+			}
+			slices.SortStableFunc(matches, func(a, b T) int {
+				if sort.Direction == SortDesc {
+					return compare(b, a)
+				}
+				return compare(a, b)
+			})
+		}
+
+		start := min(page*pageSize, len(matches))
+		end := min(start+pageSize, len(matches))
+		return matches[start:end], len(matches), nil
+	}
+}
+
+// matchesFilter reports whether row's cells contain every filter value.
+// Filters on unknown columns are ignored.
+func matchesFilter[T any](row T, filter FilterSpec, columns map[string]Column[T]) bool {
+	for key, value := range filter {
+		column, ok := columns[key]
+		if !ok || value == "" {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(cellText(column, row)), strings.ToLower(value)) {
+			return false
+		}
+	}
+	return true
+}
+
+func cellText[T any](column Column[T], row T) string {
+	if column.Cell == nil {
+		return ""
+	}
+	return column.Cell(row)
+}
@@ -0,0 +1,367 @@
+package table
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/font"
+	"gioui.org/gesture"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/placeholder"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// WidthKind is how a Table column is sized.
+type WidthKind int
+
+// Width kinds.
+const (
+	// WidthWeighted shares the space left by the other columns by weight.
+	WidthWeighted WidthKind = iota
+	// WidthFixed is a fixed width.
+	WidthFixed
+	// WidthFit is as wide as the widest cell of the column.
+	WidthFit
+)
+
+// ColumnWidth is the sizing strategy of a Table column. The zero value is a
+// weighted column with weight 1.
+type ColumnWidth struct {
+	Kind   WidthKind
+	Fixed  unit.Dp
+	Weight float32
+}
+
+// FixedWidth returns a column width of width.
+func FixedWidth(width unit.Dp) ColumnWidth {
+	return ColumnWidth{Kind: WidthFixed, Fixed: width}
+}
+
+// WeightedWidth returns a column width sharing the remaining space by weight.
+func WeightedWidth(weight float32) ColumnWidth {
+	return ColumnWidth{Kind: WidthWeighted, Weight: weight}
+}
+
+// FitContent returns a column width that fits the widest cell. Widget cells
+// in such columns are laid out twice per frame, once to measure them.
+func FitContent() ColumnWidth {
+	return ColumnWidth{Kind: WidthFit}
+}
+
+// Cell is a table cell showing Text, or Widget when it is set.
+type Cell struct {
+	Text   string
+	Widget layout.Widget
+}
+
+// TextCell returns a cell showing text.
+func TextCell(text string) Cell {
+	return Cell{Text: text}
+}
+
+// WidgetCell returns a cell showing w.
+func WidgetCell(w layout.Widget) Cell {
+	return Cell{Widget: w}
+}
+
+// Row is a table body row with one cell per column.
+type Row struct {
+	Cells []Cell
+}
+
+// NewRow returns a row of text cells.
+func NewRow(texts ...string) Row {
+	cells := make([]Cell, len(texts))
+	for i, text := range texts {
+		cells[i] = TextCell(text)
+	}
+	return Row{Cells: cells}
+}
+
+// Header is the header row of a table, drawn on a muted background.
+type Header struct {
+	Cells []Cell
+}
+
+// NewHeader returns a header of text cells.
+func NewHeader(texts ...string) Header {
+	return Header{Cells: NewRow(texts...).Cells}
+}
+
+// Table is a static table built from a Header and Rows, for data that is
+// already in memory. Use DataTable for paged or server-side data.
+//
+// Example usage:.
+//
+//	invoices := table.NewTable(
+//		[]table.ColumnWidth{table.FitContent(), table.WeightedWidth(1), table.FixedWidth(unit.Dp(96))},
+//		table.WithHeader(table.NewHeader("Invoice", "Method", "Amount")),
+//		table.WithStriped(true),
+//	)
+//	invoices.Rows = append(invoices.Rows, table.NewRow("INV001", "Credit Card", "$250.00"))
+//	dims := invoices.Layout(gtx, th)
+type Table struct {
+	// Configuration
+	Columns   []ColumnWidth
+	Header    *Header
+	Rows      []Row
+	Striped   bool
+	Hoverable bool
+	// EmptyState is shown below the header when there are no rows. Nil
+	// shows a localized "No results." placeholder.
+	EmptyState *placeholder.Placeholder
+
+	// State
+	hovers []gesture.Hover
+}
+
+// Option is a functional option for configuring Table components.
+type Option func(*Table)
+
+// WithHeader sets the header row.
+func WithHeader(header Header) Option {
+	return func(t *Table) {
+		t.Header = &header
+	}
+}
+
+// WithRows sets the body rows.
+func WithRows(rows ...Row) Option {
+	return func(t *Table) {
+		t.Rows = rows
+	}
+}
+
+// WithStriped shades every other body row.
+func WithStriped(striped bool) Option {
+	return func(t *Table) {
+		t.Striped = striped
+	}
+}
+
+// WithHoverable sets whether body rows are highlighted under the pointer.
+func WithHoverable(hoverable bool) Option {
+	return func(t *Table) {
+		t.Hoverable = hoverable
+	}
+}
+
+// WithEmptyState sets the placeholder shown when there are no rows.
+func WithEmptyState(empty *placeholder.Placeholder) Option {
+	return func(t *Table) {
+		t.EmptyState = empty
+	}
+}
+
+// NewTable creates a new Table with the given column widths and options.
+// Rows are highlighted on hover by default.
+func NewTable(columns []ColumnWidth, options ...Option) *Table {
+	t := &Table{
+		Columns:   columns,
+		Hoverable: true,
+	}
+
+	for _, option := range options {
+		option(t)
+	}
+
+	return t
+}
+
+// Layout renders the header and the rows across the available width, or the
+// empty state when there are no rows.
+func (t *Table) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	if len(t.hovers) != len(t.Rows) {
+		t.hovers = make([]gesture.Hover, len(t.Rows))
+	}
+
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	widths := t.columnWidths(gtx, th)
+
+	var children []layout.FlexChild
+	if t.Header != nil {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return t.layoutRow(gtx, th, widths, t.Header.Cells, true, th.Colors.Muted)
+		}))
+	}
+	if len(t.Rows) == 0 {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return t.layoutEmpty(gtx, th)
+		}))
+	}
+	for r := range t.Rows {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			hovered := t.hovers[r].Update(gtx.Source) && t.Hoverable
+
+			bg := th.Colors.Background
+			switch {
+			case hovered:
+				bg = th.Colors.Muted
+			case t.Striped && r%2 == 1:
+				bg = utils.ApplyOpacity(th.Colors.Muted, 0.5)
+			}
+
+			// The hover area encloses the row so cell widgets still get input
+			macro := op.Record(gtx.Ops)
+			dims := t.layoutRow(gtx, th, widths, t.Rows[r].Cells, false, bg)
+			call := macro.Stop()
+
+			area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+			t.hovers[r].Add(gtx.Ops)
+			call.Add(gtx.Ops)
+			area.Pop()
+			return dims
+		}))
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+}
+
+// layoutEmpty renders the empty state placeholder in a body at least
+// minBodyHeight high.
+func (t *Table) layoutEmpty(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	empty := t.EmptyState
+	if empty == nil {
+		empty = placeholder.NewPlaceholder(th.Locale.T(i18n.KeyNoResults), "")
+	}
+
+	gtx.Constraints.Min = image.Pt(gtx.Constraints.Max.X, gtx.Dp(minBodyHeight))
+	return layout.UniformInset(th.Spacing.Space6).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return empty.Layout(gtx, th)
+	})
+}
+
+// columnWidths resolves the column widths in pixels: fixed columns first,
+// then fit-content columns measured from their cells, then weighted columns
+// sharing what is left.
+func (t *Table) columnWidths(gtx layout.Context, th *theme.Theme) []int {
+	available := gtx.Constraints.Max.X
+	widths := make([]int, len(t.Columns))
+
+	used := 0
+	totalWeight := float32(0)
+	for c, column := range t.Columns {
+		switch column.Kind {
+		case WidthFixed:
+			widths[c] = gtx.Dp(column.Fixed)
+		case WidthFit:
+			widths[c] = t.measureColumn(gtx, th, c)
+		default:
+			weight := column.Weight
+			if weight <= 0 {
+				weight = 1
+			}
+			totalWeight += weight
+			continue
+		}
+		used += widths[c]
+	}
+
+	remaining := max(0, available-used)
+	for c, column := range t.Columns {
+		if column.Kind != WidthWeighted {
+			continue
+		}
+		weight := column.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		widths[c] = int(float32(remaining) * weight / totalWeight)
+	}
+
+	return widths
+}
+
+// measureColumn returns the width of the widest cell in column c, without
+// drawing anything.
+func (t *Table) measureColumn(gtx layout.Context, th *theme.Theme, c int) int {
+	mgtx := gtx
+	mgtx.Constraints = layout.Constraints{Max: gtx.Constraints.Max}
+
+	widest := 0
+	measure := func(cells []Cell, header bool) {
+		if c >= len(cells) {
+			return
+		}
+		macro := op.Record(gtx.Ops)
+		dims := layoutTableCell(mgtx, th, cells[c], header)
+		macro.Stop()
+		widest = max(widest, dims.Size.X)
+	}
+
+	if t.Header != nil {
+		measure(t.Header.Cells, true)
+	}
+	for _, row := range t.Rows {
+		measure(row.Cells, false)
+	}
+	return widest
+}
+
+// layoutRow lays out cells at the resolved widths over bg, at least
+// rowHeight high with a bottom border, and vertically centered.
+func (t *Table) layoutRow(gtx layout.Context, th *theme.Theme, widths []int, cells []Cell, header bool, bg color.NRGBA) layout.Dimensions {
+	height := gtx.Dp(rowHeight)
+
+	// Cells are recorded first so the row height is known before drawing
+	calls := make([]op.CallOp, len(widths))
+	sizes := make([]image.Point, len(widths))
+	for c := range widths {
+		if c >= len(cells) {
+			continue
+		}
+		cgtx := gtx
+		cgtx.Constraints = layout.Constraints{Max: image.Pt(widths[c], gtx.Constraints.Max.Y)}
+		macro := op.Record(gtx.Ops)
+		sizes[c] = layoutTableCell(cgtx, th, cells[c], header).Size
+		calls[c] = macro.Stop()
+		height = max(height, sizes[c].Y)
+	}
+
+	size := image.Pt(gtx.Constraints.Max.X, height)
+	paint.FillShape(gtx.Ops, bg, clip.Rect{Max: size}.Op())
+	border := gtx.Dp(unit.Dp(1))
+	paint.FillShape(gtx.Ops, th.Colors.Border, clip.Rect{Min: image.Pt(0, height-border), Max: size}.Op())
+
+	x := 0
+	for c, width := range widths {
+		offset := op.Offset(image.Pt(x, (height-sizes[c].Y)/2)).Push(gtx.Ops)
+		cell := clip.Rect{Max: image.Pt(width, height)}.Push(gtx.Ops)
+		calls[c].Add(gtx.Ops)
+		cell.Pop()
+		offset.Pop()
+		x += width
+	}
+
+	return layout.Dimensions{Size: size}
+}
+
+// layoutTableCell renders a cell's widget or text with horizontal padding.
+// Header text is muted and medium weight.
+func layoutTableCell(gtx layout.Context, th *theme.Theme, cell Cell, header bool) layout.Dimensions {
+	inset := layout.Inset{
+		Top:    th.Spacing.Space2,
+		Bottom: th.Spacing.Space2,
+		Left:   th.Spacing.Space3,
+		Right:  th.Spacing.Space3,
+	}
+	return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		if cell.Widget != nil {
+			return cell.Widget(gtx)
+		}
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, cell.Text)
+		lbl.Color = th.Colors.Foreground
+		if header {
+			lbl.Color = th.Colors.MutedFg
+			lbl.Font.Weight = font.Medium
+		}
+		return lbl.Layout(gtx)
+	})
+}
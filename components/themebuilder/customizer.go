@@ -0,0 +1,334 @@
+/*
+Package themebuilder provides tools for editing gio-shadcn themes at runtime.
+
+The Customizer is a compact panel that lists every color of a theme's active
+ColorScheme as an editable hex field with a swatch. Edits are applied to the
+theme in place, so an application that renders with the same *theme.Theme
+sees them on the next frame.
+
+# Quick Start
+
+Create a customizer for the application theme:
+
+	custom := themebuilder.NewCustomizer(th, w,
+		themebuilder.WithPreset("Light", theme.New()),
+		themebuilder.WithPreset("Dark", theme.NewDark()),
+	)
+
+Use in layout, typically in a sidebar:
+
+	dims := custom.Layout(gtx, th)
+
+# Features
+
+• One row per ColorScheme color with a swatch and hex field
+• Hex, rgb() and rgba() values parsed with theme.ParseColor
+• Preset buttons that load a built-in or custom theme
+• Copy the theme as JSON or as Go source
+*/
+package themebuilder
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"reflect"
+
+	"gioui.org/app"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/components/input"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/theme"
+	clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
+)
+
+// Customizer layout constants.
+const (
+	swatchSize    = unit.Dp(20)
+	hexFieldWidth = unit.Dp(120)
+	presetsPerRow = 3
+	goPackageName = "customtheme"
+)
+
+// colorRow edits one ColorScheme field.
+type colorRow struct {
+	name  string
+	field func(cs *theme.ColorScheme) *color.NRGBA
+	input *input.Input
+	hex   string
+}
+
+// preset is a theme that can be loaded into the customized theme.
+type preset struct {
+	theme  *theme.Theme
+	button *button.Button
+}
+
+// Customizer is a panel of color fields that edits a theme in place.
+//
+// Example usage:.
+//
+//	custom := themebuilder.NewCustomizer(th, w)
+//	dims := custom.Layout(gtx, th)
+type Customizer struct {
+	// Clipboard receives the exported theme. The system clipboard is used by
+	// default.
+	Clipboard clipboardutil.Interface
+
+	// State
+	theme    *theme.Theme
+	window   *app.Window
+	rows     []*colorRow
+	presets  []*preset
+	list     layout.List
+	copyJSON *button.Button
+	copyGo   *button.Button
+}
+
+// Option is a functional option for configuring Customizer components.
+type Option func(*Customizer)
+
+// WithPreset adds a button that loads th into the customized theme.
+func WithPreset(name string, th *theme.Theme) Option {
+	return func(c *Customizer) {
+		c.presets = append(c.presets, &preset{
+			theme: th,
+			button: button.NewButton(
+				button.WithText(name),
+				button.WithVariant(theme.VariantOutline),
+				button.WithSize(theme.SizeSM),
+			),
+		})
+	}
+}
+
+// WithClipboard sets the clipboard used by the export buttons.
+func WithClipboard(clipboard clipboardutil.Interface) Option {
+	return func(c *Customizer) {
+		c.Clipboard = clipboard
+	}
+}
+
+// NewCustomizer creates a Customizer that edits th and invalidates w after
+// every change. w may be nil.
+func NewCustomizer(th *theme.Theme, w *app.Window, options ...Option) *Customizer {
+	c := &Customizer{
+		Clipboard: clipboardutil.NewSystem(),
+		theme:     th,
+		window:    w,
+		list:      layout.List{Axis: layout.Vertical},
+		copyJSON: button.NewButton(
+			button.WithText("Copy JSON"),
+			button.WithVariant(theme.VariantSecondary),
+			button.WithSize(theme.SizeSM),
+		),
+		copyGo: button.NewButton(
+			button.WithText("Copy Go"),
+			button.WithVariant(theme.VariantSecondary),
+			button.WithSize(theme.SizeSM),
+		),
+	}
+
+	// One row per color.NRGBA field of ColorScheme, in declaration order
+	scheme := reflect.TypeOf(theme.ColorScheme{})
+	for i := range scheme.NumField() {
+		if scheme.Field(i).Type != reflect.TypeOf(color.NRGBA{}) {
+			continue
+		}
+		row := &colorRow{
+			name: scheme.Field(i).Name,
+			field: func(cs *theme.ColorScheme) *color.NRGBA {
+				return reflect.ValueOf(cs).Elem().Field(i).Addr().Interface().(*color.NRGBA)
+			},
+		}
+		row.input = input.NewInput(
+			input.WithInputSize(input.InputSizeSmall),
+			input.WithOnChange(func(text string) {
+				c.setColor(row, text)
+			}),
+		)
+		c.rows = append(c.rows, row)
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// SetTheme switches the customizer to edit th, for applications that replace
+// their theme, such as when toggling dark mode.
+func (c *Customizer) SetTheme(th *theme.Theme) {
+	c.theme = th
+}
+
+// Theme returns the theme being edited.
+func (c *Customizer) Theme() *theme.Theme {
+	return c.theme
+}
+
+// setColor applies a typed color when it parses.
+func (c *Customizer) setColor(row *colorRow, text string) {
+	col, err := theme.ParseColor(text)
+	if err != nil {
+		return
+	}
+	*row.field(&c.theme.Colors) = col
+	row.hex = text
+	c.invalidate()
+}
+
+// invalidate redraws the window after a theme change.
+func (c *Customizer) invalidate() {
+	if c.window != nil {
+		c.window.Invalidate()
+	}
+}
+
+// update loads clicked presets and copies the theme for the export buttons.
+func (c *Customizer) update(gtx layout.Context) {
+	for _, p := range c.presets {
+		if p.button.Clicked(gtx) {
+			*c.theme = *p.theme
+			c.invalidate()
+		}
+	}
+
+	if c.copyJSON.Clicked(gtx) {
+		if data, err := c.theme.JSON(); err == nil {
+			c.copyText(string(data))
+		}
+	}
+	if c.copyGo.Clicked(gtx) {
+		c.copyText(theme.SerializeToGo(c.theme, goPackageName))
+	}
+}
+
+// copyText writes text to the clipboard without blocking the frame.
+func (c *Customizer) copyText(text string) {
+	if c.Clipboard == nil {
+		return
+	}
+	clipboard := c.Clipboard
+	go func() { _ = clipboard.Write(text) }()
+}
+
+// Layout renders the panel: a title, the preset buttons, a scrollable list of
+// color rows and the export buttons.
+func (c *Customizer) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	c.update(gtx)
+
+	paint.FillShape(gtx.Ops, th.Colors.Card, clip.Rect{Max: gtx.Constraints.Max}.Op())
+
+	return layout.UniformInset(th.Spacing.Space4).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		children := []layout.FlexChild{
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return label.NewTypography("Customize Theme", label.H4, "").Layout(gtx, th)
+			}),
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+		}
+
+		for start := 0; start < len(c.presets); start += presetsPerRow {
+			row := c.presets[start:min(start+presetsPerRow, len(c.presets))]
+			children = append(children,
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					return c.layoutPresetRow(gtx, th, row)
+				}),
+				layout.Rigid(layout.Spacer{Height: th.Spacing.Space2}.Layout),
+			)
+		}
+
+		children = append(children,
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return c.list.Layout(gtx, len(c.rows), func(gtx layout.Context, index int) layout.Dimensions {
+					return layout.Inset{Top: th.Spacing.Space1, Bottom: th.Spacing.Space1}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return c.layoutColorRow(gtx, th, c.rows[index])
+					})
+				})
+			}),
+			layout.Rigid(layout.Spacer{Height: th.Spacing.Space4}.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return c.copyJSON.Layout(gtx, th)
+					}),
+					layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						return c.copyGo.Layout(gtx, th)
+					}),
+				)
+			}),
+		)
+
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+	})
+}
+
+// layoutPresetRow renders preset buttons side by side with equal widths.
+func (c *Customizer) layoutPresetRow(gtx layout.Context, th *theme.Theme, row []*preset) layout.Dimensions {
+	children := make([]layout.FlexChild, 0, 2*presetsPerRow)
+	for i := range presetsPerRow {
+		if i > 0 {
+			children = append(children, layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout))
+		}
+		children = append(children, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			if i >= len(row) {
+				return layout.Dimensions{}
+			}
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return row[i].button.Layout(gtx, th)
+		}))
+	}
+	return layout.Flex{Axis: layout.Horizontal}.Layout(gtx, children...)
+}
+
+// layoutColorRow renders the swatch, name and hex field of a color. The field
+// follows outside changes, such as a loaded preset, unless it is being edited.
+func (c *Customizer) layoutColorRow(gtx layout.Context, th *theme.Theme, row *colorRow) layout.Dimensions {
+	current := *row.field(&c.theme.Colors)
+	if parsed, err := theme.ParseColor(row.hex); (err != nil || parsed != current) && !row.input.Update(gtx).IsActive() {
+		row.hex = hexString(current)
+		row.input.SetText(row.hex)
+	}
+
+	return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			size := gtx.Dp(swatchSize)
+			rr := clip.UniformRRect(image.Rectangle{Max: image.Pt(size, size)}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, current, rr.Op(gtx.Ops))
+			paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+				Path:  rr.Path(gtx.Ops),
+				Width: float32(gtx.Dp(unit.Dp(1))),
+			}.Op())
+			return layout.Dimensions{Size: image.Pt(size, size)}
+		}),
+		layout.Rigid(layout.Spacer{Width: th.Spacing.Space2}.Layout),
+		layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, row.name)
+			lbl.Color = th.Colors.CardFg
+			lbl.MaxLines = 1
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			width := gtx.Dp(hexFieldWidth)
+			gtx.Constraints.Min.X = width
+			gtx.Constraints.Max.X = width
+			return row.input.Layout(gtx, th)
+		}),
+	)
+}
+
+// hexString formats a color as "#rrggbb", or "#rrggbbaa" when it is not
+// opaque.
+func hexString(c color.NRGBA) string {
+	if c.A == 255 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
@@ -44,6 +44,30 @@ Create titlebar with custom close handler:
 • Cross-platform consistent appearance
 • Maximize/restore toggle functionality
 • Proper window state management
+• Optional application menu bar row
+• Optional application icon with notification badge
+• Optional notification bell before the window controls
+
+# Menu Bar
+
+An application menu bar can be shown below the title row:
+
+	mb := menubar.NewMenuBar(
+		menubar.Menu{Label: "File", Items: []menubar.MenuItem{
+			{Label: "Open", Shortcut: "Ctrl+O", OnSelect: openFile},
+			{Separator: true},
+			{Label: "Quit", Shortcut: "Ctrl+Q", OnSelect: quit},
+		}},
+	)
+
+	titlebar := titlebar.NewTitleBar(
+		titlebar.WithTitle("My App"),
+		titlebar.WithWindow(w),
+		titlebar.WithMenuBar(mb),
+	)
+
+On macOS, applications conventionally use the global system menu bar instead of
+an in-window one; consider omitting WithMenuBar there.
 
 # Window Integration
 
@@ -117,14 +141,20 @@ package titlebar
 
 import (
 	"image"
+	"strconv"
 
 	"gioui.org/font"
 	"gioui.org/io/system"
 	"gioui.org/layout"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"github.com/bnema/gio-shadcn/components/badge"
 	"github.com/bnema/gio-shadcn/components/button"
 	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/menubar"
+	"github.com/bnema/gio-shadcn/components/notification"
 	"github.com/bnema/gio-shadcn/theme"
 )
 
@@ -135,10 +165,20 @@ type TitleBar struct {
 	minimizeBtn *button.Button
 	maximizeBtn *button.Button
 	closeBtn    *button.Button
+	menuBar     *menubar.MenuBar
+	icon        *paint.ImageOp
+	badgeCount  *int
+	bell        *notification.Bell
 	isMaximized bool
 	variant     theme.Variant
 }
 
+// Titlebar row heights.
+const (
+	titleRowHeight   = 40
+	menuBarRowHeight = 32
+)
+
 // Option is a functional option for configuring TitleBar components.
 type Option func(*TitleBar)
 
@@ -182,6 +222,39 @@ func WithVariant(variant theme.Variant) Option {
 	}
 }
 
+// WithMenuBar places an application menu bar below the title and window controls.
+// The titlebar grows taller to make room for the menu bar row.
+func WithMenuBar(mb *menubar.MenuBar) Option {
+	return func(tb *TitleBar) {
+		tb.menuBar = mb
+	}
+}
+
+// WithIcon sets the application icon shown at the left of the titlebar.
+func WithIcon(img paint.ImageOp) Option {
+	return func(tb *TitleBar) {
+		tb.icon = &img
+	}
+}
+
+// WithNotificationBadge overlays a notification badge on the icon area.
+// The count is read every frame, so updating the pointed-to value updates the
+// badge. A count of zero shows a dot, counts above 99 show "99+", and a nil
+// count shows no badge.
+func WithNotificationBadge(count *int) Option {
+	return func(tb *TitleBar) {
+		tb.badgeCount = count
+	}
+}
+
+// WithNotificationBell places a notification bell at the right of the drag
+// area, before the window controls.
+func WithNotificationBell(bell *notification.Bell) Option {
+	return func(tb *TitleBar) {
+		tb.bell = bell
+	}
+}
+
 // NewTitleBar creates a new TitleBar with the given options.
 func NewTitleBar(options ...Option) *TitleBar {
 	tb := &TitleBar{
@@ -226,8 +299,11 @@ func NewTitleBar(options ...Option) *TitleBar {
 
 // Layout renders the title bar.
 func (tb *TitleBar) Layout(gtx layout.Context, th *theme.Theme, _ interface{}) layout.Dimensions {
-	// Set fixed height for title bar
-	height := gtx.Dp(40)
+	// Set fixed height for title bar, taller when a menu bar is present
+	height := gtx.Dp(titleRowHeight)
+	if tb.menuBar != nil {
+		height += gtx.Dp(menuBarRowHeight)
+	}
 
 	// Constrain the height
 	gtx.Constraints.Min.Y = height
@@ -249,6 +325,32 @@ func (tb *TitleBar) Layout(gtx layout.Context, th *theme.Theme, _ interface{}) l
 		paint.FillShape(gtx.Ops, variantConfig.Border, borderRect)
 	}
 
+	if tb.menuBar == nil {
+		return tb.layoutTitleRow(gtx, th, variantConfig)
+	}
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.Y = gtx.Dp(titleRowHeight)
+			gtx.Constraints.Max.Y = gtx.Constraints.Min.Y
+			return tb.layoutTitleRow(gtx, th, variantConfig)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.Y = gtx.Dp(menuBarRowHeight)
+			gtx.Constraints.Max.Y = gtx.Constraints.Min.Y
+			return layout.Inset{
+				Left: th.Spacing.Space2,
+			}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.W.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return tb.menuBar.Layout(gtx, th)
+				})
+			})
+		}),
+	)
+}
+
+// layoutTitleRow renders the draggable title area and the window controls.
+func (tb *TitleBar) layoutTitleRow(gtx layout.Context, th *theme.Theme, variantConfig theme.VariantConfig) layout.Dimensions {
 	// Layout content with explicit height constraint
 	return layout.Stack{}.Layout(gtx,
 		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
@@ -258,6 +360,16 @@ func (tb *TitleBar) Layout(gtx layout.Context, th *theme.Theme, _ interface{}) l
 				Axis:      layout.Horizontal,
 				Alignment: layout.Middle,
 			}.Layout(gtx,
+				// Application icon with optional notification badge
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if tb.icon == nil && tb.badgeCount == nil {
+						return layout.Dimensions{}
+					}
+					return layout.Inset{Left: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return tb.layoutIcon(gtx, th)
+					})
+				}),
+
 				// Draggable area with title
 				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
 					// Register the drag area for system move action
@@ -285,6 +397,18 @@ func (tb *TitleBar) Layout(gtx layout.Context, th *theme.Theme, _ interface{}) l
 					})
 				}),
 
+				// Notification bell
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if tb.bell == nil {
+						return layout.Dimensions{}
+					}
+					return layout.Inset{Right: th.Spacing.Space2}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+							return tb.bell.Layout(gtx, th)
+						})
+					})
+				}),
+
 				// Window controls
 				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 					// Center the buttons vertically within the titlebar
@@ -309,6 +433,41 @@ func (tb *TitleBar) Layout(gtx layout.Context, th *theme.Theme, _ interface{}) l
 	)
 }
 
+// layoutIcon renders the application icon with the notification badge at its
+// top-right corner.
+func (tb *TitleBar) layoutIcon(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	size := gtx.Dp(unit.Dp(20))
+	badgeOverlap := unit.Dp(6)
+
+	return layout.Stack{Alignment: layout.NE}.Layout(gtx,
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			// Leave room above and to the right for the badge
+			return layout.Inset{Top: badgeOverlap, Right: badgeOverlap}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints = layout.Exact(image.Pt(size, size))
+				if tb.icon == nil {
+					return layout.Dimensions{Size: gtx.Constraints.Min}
+				}
+				return widget.Image{Src: *tb.icon, Fit: widget.Contain}.Layout(gtx)
+			})
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			if tb.badgeCount == nil {
+				return layout.Dimensions{}
+			}
+
+			count := *tb.badgeCount
+			switch {
+			case count <= 0:
+				return badge.NewDotBadge(theme.VariantDestructive).Layout(gtx, th)
+			case count > 99:
+				return badge.NewBadge("99+", badge.WithVariant(theme.VariantDestructive)).Layout(gtx, th)
+			default:
+				return badge.NewBadge(strconv.Itoa(count), badge.WithVariant(theme.VariantDestructive)).Layout(gtx, th)
+			}
+		}),
+	)
+}
+
 // Update returns the component state for titlebar buttons.
 func (tb *TitleBar) Update(gtx layout.Context) theme.ComponentState {
 	return &State{
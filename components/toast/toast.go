@@ -0,0 +1,576 @@
+/*
+Package toast provides toast notifications for gio-shadcn applications.
+
+A Manager queues toasts and shows them stacked in a corner of the window.
+Each toast dismisses itself after a timeout, which pauses while the pointer
+rests on any toast, and can carry an action button. Toasts beyond MaxVisible
+wait in the queue until earlier ones are dismissed.
+
+# Quick Start
+
+Create a manager and lay it out above the page, once per frame:
+
+	toasts := toast.NewManager(toast.WithPosition(toast.PositionBottomRight))
+
+	layout.Stack{}.Layout(gtx,
+		layout.Expanded(page),
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			return toasts.Layout(gtx, th)
+		}),
+	)
+
+Show toasts from anywhere:
+
+	toasts.Success("Profile saved")
+	toasts.Show(toast.Toast{
+		Title:  "Message archived",
+		Action: &toast.Action{Label: "Undo", OnClick: undoArchive},
+	})
+
+# Variants
+
+• VariantDefault - Background colored card with a border, shown by Success
+• VariantDestructive - Red, shown by Error
+• VariantSuccess - Green
+• VariantWarning - Amber, shown by Warning
+• VariantInfo - Blue, shown by Info
+
+# Features
+
+• Stacking in any window corner, newest toast nearest the edge
+• Queue with a configurable number of visible toasts
+• Auto-dismiss after a per-toast or default timeout
+• Timeouts paused while a toast is hovered
+• Optional action button and a close button on every toast
+• Fade and slide animations
+• Safe to show toasts from other goroutines
+*/
+package toast
+
+import (
+	"image"
+	"image/color"
+	"sync"
+	"time"
+
+	"gioui.org/font"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/animation"
+	"golang.org/x/exp/shiny/materialdesign/icons"
+)
+
+// Position is the window corner toasts stack in.
+type Position string
+
+// Positions.
+const (
+	PositionTopLeft     Position = "top-left"
+	PositionTopRight    Position = "top-right"
+	PositionBottomLeft  Position = "bottom-left"
+	PositionBottomRight Position = "bottom-right"
+)
+
+// Defaults and geometry.
+const (
+	DefaultDuration = 5 * time.Second
+	// ErrorDuration is how long toasts shown by Error stay, whatever the
+	// manager's Duration.
+	ErrorDuration     = 5 * time.Second
+	DefaultMaxVisible = 3
+	DefaultWidth      = unit.Dp(356)
+	closeIconSize     = unit.Dp(16)
+	slideDistance     = unit.Dp(16)
+	fadeDuration      = 200 * time.Millisecond
+)
+
+// closeIcon is the close button icon. The icon data is constant, so NewIcon
+// cannot fail.
+var closeIcon, _ = widget.NewIcon(icons.NavigationClose)
+
+// Action is a button shown on a toast. Clicking it calls OnClick and
+// dismisses the toast.
+type Action struct {
+	Label   string
+	OnClick func()
+}
+
+// Toast describes a single notification.
+type Toast struct {
+	Title       string
+	Description string
+	Variant     theme.Variant
+	// Duration is how long the toast stays before dismissing itself. Zero
+	// uses the manager's Duration; a negative duration keeps the toast until
+	// it is closed.
+	Duration time.Duration
+	Action   *Action
+}
+
+// Manager queues toasts and lays them out stacked in a window corner.
+//
+// Example usage:.
+//
+//	toasts := toast.NewManager(toast.WithMaxVisible(5))
+//	toasts.Error(err)
+//	dims := toasts.Layout(gtx, th)
+type Manager struct {
+	// State
+	mu      sync.Mutex
+	pending []Toast
+	queue   []*entry
+	active  []*entry
+
+	// Configuration
+	Position   Position
+	Duration   time.Duration
+	MaxVisible int
+	Width      unit.Dp
+}
+
+// entry is a toast being shown or waiting to be shown.
+type entry struct {
+	toast      Toast
+	remaining  time.Duration
+	last       time.Time
+	fade       animation.Animator
+	dismissing bool
+	hovered    bool
+	close      widget.Clickable
+	action     *button.Button
+}
+
+// Option is a functional option for configuring Manager components.
+type Option func(*Manager)
+
+// WithPosition sets the corner toasts stack in.
+func WithPosition(position Position) Option {
+	return func(m *Manager) {
+		m.Position = position
+	}
+}
+
+// WithDuration sets the default time before toasts dismiss themselves.
+func WithDuration(duration time.Duration) Option {
+	return func(m *Manager) {
+		m.Duration = duration
+	}
+}
+
+// WithMaxVisible sets how many toasts are shown at once.
+func WithMaxVisible(maxVisible int) Option {
+	return func(m *Manager) {
+		m.MaxVisible = maxVisible
+	}
+}
+
+// WithWidth sets the toast width. Narrow windows shrink the toasts.
+func WithWidth(width unit.Dp) Option {
+	return func(m *Manager) {
+		m.Width = width
+	}
+}
+
+// NewManager creates a new Manager with the given options.
+func NewManager(options ...Option) *Manager {
+	m := &Manager{
+		Position:   PositionBottomRight,
+		Duration:   DefaultDuration,
+		MaxVisible: DefaultMaxVisible,
+		Width:      DefaultWidth,
+	}
+
+	for _, option := range options {
+		option(m)
+	}
+
+	return m
+}
+
+// Show queues t. It is safe to call from any goroutine; outside the frame
+// loop, invalidate the window afterwards so the toast appears.
+func (m *Manager) Show(t Toast) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pending = append(m.pending, t)
+}
+
+// Error shows a destructive toast titled "Error" with the error message,
+// for ErrorDuration.
+func (m *Manager) Error(err error) {
+	m.Show(Toast{
+		Title:       "Error",
+		Description: err.Error(),
+		Variant:     theme.VariantDestructive,
+		Duration:    ErrorDuration,
+	})
+}
+
+// Success shows a default toast with msg.
+func (m *Manager) Success(msg string) {
+	m.Show(Toast{Title: msg, Variant: theme.VariantDefault})
+}
+
+// Warning shows an amber toast with msg.
+func (m *Manager) Warning(msg string) {
+	m.Show(Toast{Title: msg, Variant: theme.VariantWarning})
+}
+
+// Info shows a blue toast with msg.
+func (m *Manager) Info(msg string) {
+	m.Show(Toast{Title: msg, Variant: theme.VariantInfo})
+}
+
+// DismissAll dismisses the visible toasts and drops the queued ones.
+func (m *Manager) DismissAll() {
+	m.mu.Lock()
+	m.pending = nil
+	m.mu.Unlock()
+
+	m.queue = nil
+	for _, e := range m.active {
+		e.dismiss()
+	}
+}
+
+// Count returns the number of toasts shown or waiting to be shown. Like
+// Layout, it must be called from the frame loop.
+func (m *Manager) Count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.pending) + len(m.queue) + len(m.active)
+}
+
+// Layout renders the visible toasts in the configured corner of the
+// available space. Space not covered by a toast passes input through.
+func (m *Manager) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	area := gtx.Constraints.Max
+	m.update(gtx, th.ReducedMotion())
+	if len(m.active) == 0 {
+		return layout.Dimensions{Size: area}
+	}
+
+	inset := gtx.Dp(th.Spacing.Space4)
+	gap := gtx.Dp(th.Spacing.Space2)
+	width := min(gtx.Dp(m.Width), area.X-2*inset)
+	if width <= 0 {
+		return layout.Dimensions{Size: area}
+	}
+
+	top := m.Position == PositionTopLeft || m.Position == PositionTopRight
+	x := area.X - inset - width
+	if m.Position == PositionTopLeft || m.Position == PositionBottomLeft {
+		x = inset
+	}
+
+	// Newest toasts sit nearest the edge, older ones stack away from it
+	y := inset
+	if !top {
+		y = area.Y - inset
+	}
+	for i := len(m.active) - 1; i >= 0; i-- {
+		e := m.active[i]
+		progress := e.fade.Value(gtx)
+
+		tgtx := gtx
+		tgtx.Constraints = layout.Constraints{Min: image.Pt(width, 0), Max: image.Pt(width, area.Y)}
+		macro := op.Record(gtx.Ops)
+		size := m.layoutToast(tgtx, th, e)
+		call := macro.Stop()
+
+		// Toasts slide in from the edge and close the gap as they fade out
+		slide := int(float32(gtx.Dp(slideDistance)) * (1 - progress))
+		var pos image.Point
+		if top {
+			pos = image.Pt(x, y-slide)
+			y += int(float32(size.Y+gap) * progress)
+		} else {
+			pos = image.Pt(x, y-size.Y+slide)
+			y -= int(float32(size.Y+gap) * progress)
+		}
+
+		offset := op.Offset(pos).Push(gtx.Ops)
+		opacity := paint.PushOpacity(gtx.Ops, progress)
+		call.Add(gtx.Ops)
+		opacity.Pop()
+		offset.Pop()
+	}
+
+	return layout.Dimensions{Size: area}
+}
+
+// update moves shown toasts into the queue and the queue into view, runs the
+// timeouts and removes toasts that have faded out. Under reduced motion
+// toasts appear and disappear without fading or sliding.
+func (m *Manager) update(gtx layout.Context, reduced bool) {
+	m.mu.Lock()
+	for _, t := range m.pending {
+		remaining := t.Duration
+		if remaining == 0 {
+			remaining = m.Duration
+		}
+		e := &entry{toast: t, remaining: remaining}
+		e.fade.Duration = fadeDuration
+		m.queue = append(m.queue, e)
+	}
+	m.pending = m.pending[:0]
+	m.mu.Unlock()
+
+	// Remove faded out toasts before counting visible ones
+	active := m.active[:0]
+	for _, e := range m.active {
+		if !e.dismissing || e.fade.Running() || e.fade.Value(gtx) > 0 {
+			active = append(active, e)
+		}
+	}
+	clear(m.active[len(active):])
+	m.active = active
+
+	maxVisible := max(1, m.MaxVisible)
+	for len(m.queue) > 0 && m.visible() < maxVisible {
+		e := m.queue[0]
+		m.queue = m.queue[1:]
+		e.fade.Animate(1)
+		m.active = append(m.active, e)
+	}
+
+	paused := false
+	for _, e := range m.active {
+		m.processEvents(gtx, e)
+		paused = paused || e.hovered
+	}
+
+	for _, e := range m.active {
+		if e.dismissing || e.remaining < 0 {
+			continue
+		}
+		if paused {
+			// No frames arrive while the pointer rests on a toast, so only
+			// time since the last unpaused frame counts
+			e.last = time.Time{}
+			continue
+		}
+		if !e.last.IsZero() {
+			e.remaining -= gtx.Now.Sub(e.last)
+		}
+		e.last = gtx.Now
+		if e.remaining <= 0 {
+			e.dismiss()
+			continue
+		}
+		gtx.Execute(op.InvalidateCmd{At: gtx.Now.Add(e.remaining)})
+	}
+
+	if reduced {
+		for _, e := range m.active {
+			e.fade.Set(e.fade.Target())
+			if e.dismissing {
+				// Remove the hidden toast on the next frame
+				gtx.Execute(op.InvalidateCmd{})
+			}
+		}
+	}
+}
+
+// visible returns the number of toasts shown and not fading out.
+func (m *Manager) visible() int {
+	n := 0
+	for _, e := range m.active {
+		if !e.dismissing {
+			n++
+		}
+	}
+	return n
+}
+
+// processEvents handles the close button and hover of a toast.
+func (m *Manager) processEvents(gtx layout.Context, e *entry) {
+	if e.close.Clicked(gtx) {
+		e.dismiss()
+	}
+
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: e, Kinds: pointer.Enter | pointer.Leave | pointer.Cancel})
+		if !ok {
+			break
+		}
+		if pe, ok := ev.(pointer.Event); ok {
+			e.hovered = pe.Kind == pointer.Enter
+		}
+	}
+}
+
+// dismiss fades the toast out.
+func (e *entry) dismiss() {
+	if e.dismissing {
+		return
+	}
+	e.dismissing = true
+	e.hovered = false
+	e.fade.Animate(0)
+}
+
+// layoutToast draws a toast card and returns its size.
+func (m *Manager) layoutToast(gtx layout.Context, th *theme.Theme, e *entry) image.Point {
+	bg, fg, border := th.Colors.Background, th.Colors.Foreground, th.Colors.Border
+	if e.toast.Variant != "" && e.toast.Variant != theme.VariantDefault {
+		vc := th.ButtonVariant(e.toast.Variant)
+		bg, fg, border = vc.Background, vc.Foreground, vc.Background
+	}
+
+	padding := layout.Inset{
+		Top:    th.Spacing.Space4,
+		Bottom: th.Spacing.Space4,
+		Left:   th.Spacing.Space4,
+		Right:  th.Spacing.Space8,
+	}
+
+	macro := op.Record(gtx.Ops)
+	dims := padding.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+				return m.layoutText(gtx, th, e, fg)
+			}),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if e.toast.Action == nil {
+					return layout.Dimensions{}
+				}
+				return layout.Inset{Left: th.Spacing.Space3}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+					return e.actionButton().Layout(gtx, th)
+				})
+			}),
+		)
+	})
+	call := macro.Stop()
+	size := dims.Size
+
+	rr := clip.UniformRRect(image.Rectangle{Max: size}, gtx.Dp(th.Radius.RadiusMD))
+	defer rr.Push(gtx.Ops).Pop()
+	paint.Fill(gtx.Ops, bg)
+	paint.FillShape(gtx.Ops, border, clip.Stroke{
+		Path:  rr.Path(gtx.Ops),
+		Width: float32(gtx.Dp(unit.Dp(1))),
+	}.Op())
+
+	// Track hover for pausing and keep input off the page beneath
+	event.Op(gtx.Ops, e)
+
+	call.Add(gtx.Ops)
+	m.layoutClose(gtx, th, e, size.X, fg)
+
+	return size
+}
+
+// layoutText renders the title above the description.
+func (m *Manager) layoutText(gtx layout.Context, th *theme.Theme, e *entry, fg color.NRGBA) layout.Dimensions {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if e.toast.Title == "" {
+				return layout.Dimensions{}
+			}
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, e.toast.Title)
+			lbl.Color = fg
+			lbl.Font.Weight = font.SemiBold
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if e.toast.Description == "" {
+				return layout.Dimensions{}
+			}
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, e.toast.Description)
+			lbl.Color = utils.ApplyOpacity(fg, 0.9)
+			return lbl.Layout(gtx)
+		}),
+	)
+}
+
+// layoutClose draws the close icon in the top-right corner.
+func (m *Manager) layoutClose(gtx layout.Context, th *theme.Theme, e *entry, width int, fg color.NRGBA) {
+	iconSize := gtx.Dp(closeIconSize)
+	corner := gtx.Dp(th.Spacing.Space2)
+
+	offset := op.Offset(image.Pt(width-corner-iconSize, corner)).Push(gtx.Ops)
+	defer offset.Pop()
+
+	gtx.Constraints = layout.Exact(image.Pt(iconSize, iconSize))
+	e.close.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		pointer.CursorPointer.Add(gtx.Ops)
+		iconColor := utils.ApplyOpacity(fg, 0.5)
+		if e.close.Hovered() || gtx.Focused(&e.close) {
+			iconColor = fg
+		}
+		return closeIcon.Layout(gtx, iconColor)
+	})
+}
+
+// actionButton returns the action button, creating it on first use.
+func (e *entry) actionButton() *button.Button {
+	if e.action == nil {
+		e.action = button.NewButton(
+			button.WithText(e.toast.Action.Label),
+			button.WithVariant(theme.VariantOutline),
+			button.WithSize(theme.SizeSM),
+			button.WithOnClick(func() {
+				if e.toast.Action.OnClick != nil {
+					e.toast.Action.OnClick()
+				}
+				e.dismiss()
+			}),
+		)
+	}
+	return e.action
+}
+
+// Update returns the component state for Manager.
+func (m *Manager) Update(_ layout.Context) theme.ComponentState {
+	hovered := false
+	for _, e := range m.active {
+		hovered = hovered || e.hovered
+	}
+	return &State{
+		active:  len(m.active) > 0,
+		hovered: hovered,
+	}
+}
+
+// State implements ComponentState for Manager.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true while any toast is shown.
+func (ts *State) IsActive() bool {
+	return ts.active
+}
+
+// IsHovered returns true if a toast is being hovered over.
+func (ts *State) IsHovered() bool {
+	return ts.hovered
+}
+
+// IsPressed always returns false; toasts have no pressed state.
+func (ts *State) IsPressed() bool {
+	return ts.pressed
+}
+
+// IsDisabled always returns false; toasts cannot be disabled.
+func (ts *State) IsDisabled() bool {
+	return ts.disabled
+}
+
+// Manager shows AsyncButton errors.
+var _ button.ErrorToaster = (*Manager)(nil)
@@ -0,0 +1,64 @@
+package toast
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/input"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+func TestHoverPausesTimeout(t *testing.T) {
+	var r input.Router
+	th := theme.TestTheme()
+	m := NewManager(WithPosition(PositionTopLeft), WithDuration(5*time.Second))
+	m.Show(Toast{Title: "Saved"})
+
+	start := time.Unix(1000, 0)
+	frame := func(at time.Duration) {
+		ops := new(op.Ops)
+		gtx := layout.Context{
+			Ops:         ops,
+			Source:      r.Source(),
+			Now:         start.Add(at),
+			Constraints: layout.Exact(image.Pt(600, 400)),
+		}
+		m.Layout(gtx, th)
+		r.Frame(ops)
+	}
+	move := func(x, y float32) {
+		r.Queue(pointer.Event{Kind: pointer.Move, Source: pointer.Mouse, Position: f32.Pt(x, y)})
+	}
+	dismissed := func() bool {
+		return len(m.active) == 0 || m.active[0].dismissing
+	}
+
+	frame(0)
+	frame(time.Second)
+
+	// Hover well past the timeout; no frames arrive while the pointer rests
+	move(40, 30)
+	frame(time.Second)
+	if !m.active[0].hovered {
+		t.Fatal("toast not hovered")
+	}
+	move(590, 390)
+	frame(20 * time.Second)
+	if dismissed() {
+		t.Fatal("toast dismissed when the pointer left")
+	}
+
+	frame(23 * time.Second)
+	if dismissed() {
+		t.Fatal("toast dismissed before its remaining time")
+	}
+	frame(24*time.Second + time.Millisecond)
+	if !dismissed() {
+		t.Error("toast not dismissed after its remaining time")
+	}
+}
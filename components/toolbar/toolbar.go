@@ -0,0 +1,259 @@
+/*
+Package toolbar provides a horizontal toolbar of grouped actions for gio-shadcn applications.
+
+A Toolbar lays out groups of items in a single row, separated by vertical
+divider lines. Items are buttons, separators, or arbitrary widgets. When
+overflow is enabled and the items don't fit in the available width, the
+trailing items collapse into a popover opened from a "⋯" trigger at the end.
+
+# Quick Start
+
+Create a toolbar:
+
+	tb := toolbar.NewToolbar(
+		toolbar.ToolbarGroup{Items: []toolbar.ToolbarItem{
+			{Button: boldBtn},
+			{Button: italicBtn},
+		}},
+		toolbar.ToolbarGroup{Items: []toolbar.ToolbarItem{
+			{Button: undoBtn},
+			{Button: redoBtn},
+		}},
+	)
+	tb.Overflow = true
+
+Use in layout:
+
+	dims := tb.Layout(gtx, th)
+
+The overflow menu is kept inside the window by the popover's position
+resolver. Give the toolbar's window position to use the whole window:
+
+	tb.SetViewport(toolbarOrigin, windowSize)
+*/
+package toolbar
+
+import (
+	"image"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/button"
+	"github.com/bnema/gio-shadcn/components/label"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/components/separator"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// ToolbarItem is a single toolbar entry. Exactly one of Button, Separator or
+// Widget should be set.
+//
+//nolint:revive // ToolbarItem is the documented public name
+type ToolbarItem struct {
+	Button    *button.Button
+	Separator bool
+	Widget    layout.Widget
+}
+
+// ToolbarGroup is a set of related items. Groups are separated by a vertical line.
+//
+//nolint:revive // ToolbarGroup is the documented public name
+type ToolbarGroup struct {
+	Items []ToolbarItem
+}
+
+// Toolbar represents a horizontal row of grouped actions.
+type Toolbar struct {
+	// State
+	more   *popover.Popover
+	origin image.Point
+	window image.Point
+
+	// Configuration
+	Groups   []ToolbarGroup
+	Overflow bool
+}
+
+// NewToolbar creates a new Toolbar with the given groups.
+func NewToolbar(groups ...ToolbarGroup) *Toolbar {
+	return &Toolbar{
+		Groups: groups,
+		more:   popover.NewPopover(popover.WithPlacement(popover.PlacementBottom)),
+	}
+}
+
+// SetViewport sets the toolbar's position in the window and the window size,
+// both in pixels, used to keep the overflow menu inside the window.
+func (t *Toolbar) SetViewport(origin, window image.Point) {
+	t.origin = origin
+	t.window = window
+}
+
+// measured is a recorded toolbar entry ready to be placed.
+type measured struct {
+	item ToolbarItem
+	call op.CallOp
+	size image.Point
+}
+
+// Layout renders the toolbar, moving items that don't fit into the overflow
+// popover when Overflow is set.
+func (t *Toolbar) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	items := t.items()
+	gap := gtx.Dp(th.Spacing.Space1)
+
+	// Measure every item at its natural size before placing anything
+	cgtx := gtx
+	cgtx.Constraints.Min = image.Point{}
+	entries := make([]measured, len(items))
+	total := 0
+	for i, item := range items {
+		macro := op.Record(gtx.Ops)
+		dims := t.layoutItem(cgtx, th, item)
+		entries[i] = measured{item: item, call: macro.Stop(), size: dims.Size}
+		total += dims.Size.X
+		if i > 0 {
+			total += gap
+		}
+	}
+
+	// Keep as many items as fit next to the overflow trigger
+	visible, hidden := entries, []measured(nil)
+	var trigger measured
+	if t.Overflow && total > gtx.Constraints.Max.X {
+		macro := op.Record(gtx.Ops)
+		dims := t.layoutMore(cgtx, th, nil)
+		trigger = measured{call: macro.Stop(), size: dims.Size}
+
+		available := gtx.Constraints.Max.X - trigger.size.X - gap
+		n, used := 0, 0
+		for n < len(entries) {
+			w := entries[n].size.X
+			if n > 0 {
+				w += gap
+			}
+			if used+w > available {
+				break
+			}
+			used += w
+			n++
+		}
+
+		// Don't end the visible row on a separator
+		for n > 0 && entries[n-1].item.Separator {
+			n--
+		}
+		visible, hidden = entries[:n], entries[n:]
+	}
+
+	height := trigger.size.Y
+	for _, e := range visible {
+		height = max(height, e.size.Y)
+	}
+
+	x := 0
+	place := func(e measured) {
+		offset := op.Offset(image.Pt(x, (height-e.size.Y)/2)).Push(gtx.Ops)
+		e.call.Add(gtx.Ops)
+		offset.Pop()
+		x += e.size.X + gap
+	}
+	for _, e := range visible {
+		place(e)
+	}
+
+	if len(hidden) > 0 {
+		// Lay out the trigger for real so the popover can open from it
+		at := image.Pt(x, (height-trigger.size.Y)/2)
+		window := t.window
+		if window == (image.Point{}) {
+			window = t.origin.Add(gtx.Constraints.Max)
+		}
+		t.more.SetViewport(t.origin.Add(at), window)
+		offset := op.Offset(at).Push(gtx.Ops)
+		t.layoutMore(cgtx, th, hidden)
+		offset.Pop()
+		x += trigger.size.X + gap
+	}
+
+	return layout.Dimensions{Size: image.Pt(max(0, x-gap), height)}
+}
+
+// items flattens the groups, inserting a separator between groups.
+func (t *Toolbar) items() []ToolbarItem {
+	var items []ToolbarItem
+	for i, group := range t.Groups {
+		if i > 0 && len(group.Items) > 0 && len(items) > 0 {
+			items = append(items, ToolbarItem{Separator: true})
+		}
+		items = append(items, group.Items...)
+	}
+	return items
+}
+
+func (t *Toolbar) layoutItem(gtx layout.Context, th *theme.Theme, item ToolbarItem) layout.Dimensions {
+	switch {
+	case item.Button != nil:
+		return item.Button.Layout(gtx, th)
+	case item.Widget != nil:
+		return item.Widget(gtx)
+	case item.Separator:
+		return layoutSeparator(gtx, th, layout.Vertical)
+	default:
+		return layout.Dimensions{}
+	}
+}
+
+// layoutMore renders the "⋯" trigger and the popover listing hidden items.
+func (t *Toolbar) layoutMore(gtx layout.Context, th *theme.Theme, hidden []measured) layout.Dimensions {
+	trigger := func(gtx layout.Context) layout.Dimensions {
+		return layout.Inset{
+			Top:    th.Spacing.Space2,
+			Bottom: th.Spacing.Space2,
+			Left:   th.Spacing.Space3,
+			Right:  th.Spacing.Space3,
+		}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return label.NewTypography("⋯", label.Small, "").Layout(gtx, th)
+		})
+	}
+
+	if hidden == nil {
+		return trigger(gtx)
+	}
+
+	return t.more.Layout(gtx, th, trigger, func(gtx layout.Context) layout.Dimensions {
+		children := make([]layout.FlexChild, 0, len(hidden))
+		for _, e := range hidden {
+			item := e.item
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				if item.Separator {
+					return layoutSeparator(gtx, th, layout.Horizontal)
+				}
+				return t.layoutItem(gtx, th, item)
+			}))
+		}
+		return layout.Flex{Axis: layout.Vertical, Alignment: layout.Start}.Layout(gtx, children...)
+	})
+}
+
+// separatorLength is the length of separator lines in the row and the
+// minimum width of those in the overflow popover.
+const separatorLength = unit.Dp(20)
+
+// layoutSeparator draws a divider line. A vertical line separates items in
+// the row; a horizontal one separates items in the overflow popover.
+func layoutSeparator(gtx layout.Context, th *theme.Theme, axis layout.Axis) layout.Dimensions {
+	margin := th.Spacing.Space1
+
+	if axis == layout.Horizontal {
+		gtx.Constraints.Max.X = max(gtx.Constraints.Min.X, gtx.Dp(separatorLength))
+		return layout.Inset{Top: margin, Bottom: margin}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return separator.NewHorizontal().Layout(gtx, th)
+		})
+	}
+
+	return layout.Inset{Left: margin, Right: margin}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return separator.NewVertical(separator.WithLength(separatorLength)).Layout(gtx, th)
+	})
+}
@@ -0,0 +1,110 @@
+package tooltip
+
+import (
+	"image"
+	_ "image/gif"  // Register GIF decoding for ImageTooltip
+	_ "image/jpeg" // Register JPEG decoding for ImageTooltip
+	_ "image/png"  // Register PNG decoding for ImageTooltip
+	"os"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// maxImageHeight caps the height of the image in an image tooltip.
+const maxImageHeight = unit.Dp(180)
+
+// ImageTooltip creates a rich tooltip showing the image at imgSrc above a
+// title and a description. The image is loaded from the file system the
+// first time the tooltip opens; when it can't be read, only the text is shown.
+//
+// Example:.
+//
+//	tip := tooltip.ImageTooltip("assets/dashboard.png", "Dashboard", "Live metrics for every project.")
+//	dims := tip.Layout(gtx, th, previewLink)
+func ImageTooltip(imgSrc string, title, description string) *RichTooltip {
+	content := &imageContent{src: imgSrc, title: title, description: description}
+	t := NewRichTooltip(nil)
+	t.themedContent = content.Layout
+	return t
+}
+
+// imageContent is the content of an image tooltip.
+type imageContent struct {
+	src         string
+	title       string
+	description string
+
+	loaded bool
+	img    paint.ImageOp
+	hasImg bool
+}
+
+// load decodes the image once.
+func (c *imageContent) load() {
+	if c.loaded {
+		return
+	}
+	c.loaded = true
+
+	file, err := os.Open(c.src) // #nosec G304 - path is provided by the caller
+	if err != nil {
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return
+	}
+	c.img = paint.NewImageOp(img)
+	c.hasImg = true
+}
+
+// Layout renders the image, title and description.
+func (c *imageContent) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	c.load()
+
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !c.hasImg {
+				return layout.Dimensions{}
+			}
+			gtx.Constraints.Max.Y = min(gtx.Constraints.Max.Y, gtx.Dp(maxImageHeight))
+			picture := widget.Image{Src: c.img, Fit: widget.ScaleDown, Position: layout.W}
+			dims := picture.Layout(gtx)
+			rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusSM))
+			paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+				Path:  rr.Path(gtx.Ops),
+				Width: float32(gtx.Dp(unit.Dp(1))),
+			}.Op())
+			return dims
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if !c.hasImg {
+				return layout.Dimensions{}
+			}
+			return layout.Spacer{Height: th.Spacing.Space2}.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, c.title)
+			lbl.Font.Weight = font.SemiBold
+			lbl.Color = th.Colors.PopoverFg
+			return lbl.Layout(gtx)
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			if c.description == "" {
+				return layout.Dimensions{}
+			}
+			lbl := material.Label(material.NewTheme(), th.Typography.FontSizeSM, c.description)
+			lbl.Color = th.Colors.MutedFg
+			return lbl.Layout(gtx)
+		}),
+	)
+}
@@ -0,0 +1,25 @@
+package tooltip
+
+import (
+	"gioui.org/layout"
+	"gioui.org/widget/material"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// NewTooltip creates a tooltip showing text in a small primary-colored label,
+// like the shadcn/ui Tooltip. It takes the same options as NewRichTooltip.
+//
+// Example:.
+//
+//	tip := tooltip.NewTooltip("Add to library", tooltip.WithPlacement(popover.PlacementBottom))
+//	dims := tip.Layout(gtx, th, addButton.Layout)
+func NewTooltip(text string, options ...Option) *RichTooltip {
+	t := NewRichTooltip(nil, options...)
+	t.plain = true
+	t.themedContent = func(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+		lbl := material.Label(material.NewTheme(), th.Typography.FontSizeXS, text)
+		lbl.Color = th.Colors.PrimaryFg
+		return lbl.Layout(gtx)
+	}
+	return t
+}
@@ -0,0 +1,326 @@
+/*
+Package tooltip provides floating hints shown while hovering a trigger for
+gio-shadcn applications.
+
+A tooltip opens after the pointer rests on its trigger for a short delay, fades
+in next to it and closes as soon as the pointer leaves or presses the trigger. Placement follows the
+popover rules: the content flips to the other side of the trigger when the
+preferred side would clip the window.
+
+# Quick Start
+
+Create a text tooltip:
+
+	tip := tooltip.NewTooltip("Add to library")
+
+Create a rich tooltip with any content:
+
+	tip := tooltip.NewRichTooltip(func(gtx layout.Context) layout.Dimensions {
+		return label.NewTypography("Saved 2 minutes ago", label.Small, "").Layout(gtx, th)
+	})
+
+Use in layout:
+
+	dims := tip.Layout(gtx, th, triggerWidget)
+
+Show an image with a title and description:
+
+	tip := tooltip.ImageTooltip("assets/preview.png", "Preview", "How the page looks when published.")
+
+# Viewport
+
+Like the popover, the tooltip needs to know where its trigger sits in the
+window to avoid the window edges:
+
+	tip.SetViewport(triggerOrigin, windowSize)
+
+# Features
+
+• Hover delay before opening and a fade-in animation
+• Text tooltips in a small primary-colored label
+• Closes on pointer leave and on presses of the trigger
+• Any widget as content, drawn in a popover-colored card
+• Automatic flipping when the preferred side would clip
+• Image tooltips loaded from a file
+*/
+package tooltip
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/components/popover"
+	"github.com/bnema/gio-shadcn/theme"
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// Default timing and size of rich tooltips.
+const (
+	DefaultDelay    = 500 * time.Millisecond
+	DefaultMaxWidth = unit.Dp(320)
+	fadeDuration    = 150 * time.Millisecond
+)
+
+// RichTooltip shows an arbitrary widget in a floating card while its trigger
+// is hovered. Content is drawn on th.Colors.Popover; text in it should use
+// th.Colors.PopoverFg.
+//
+// Example usage:.
+//
+//	tip := tooltip.NewRichTooltip(profileCard, tooltip.WithPlacement(popover.PlacementRight))
+//	dims := tip.Layout(gtx, th, avatarWidget)
+type RichTooltip struct {
+	// State
+	hovered    bool
+	hoverStart time.Time
+	open       bool
+	pressed    bool
+	fade       animation.Animator
+
+	// Configuration
+	Content   layout.Widget
+	Placement popover.Placement
+	Delay     time.Duration
+	Gap       unit.Dp
+	MaxWidth  unit.Dp
+
+	// Viewport
+	origin image.Point
+	window image.Point
+
+	// themedContent replaces Content for built-in content that needs the theme
+	themedContent func(gtx layout.Context, th *theme.Theme) layout.Dimensions
+	// plain draws the content on a compact primary-colored label instead of
+	// a popover card
+	plain bool
+}
+
+// Option is a functional option for configuring RichTooltip components.
+type Option func(*RichTooltip)
+
+// WithPlacement sets the preferred placement.
+func WithPlacement(placement popover.Placement) Option {
+	return func(t *RichTooltip) {
+		t.Placement = placement
+	}
+}
+
+// WithDelay sets how long the trigger must be hovered before the tooltip opens.
+func WithDelay(delay time.Duration) Option {
+	return func(t *RichTooltip) {
+		t.Delay = delay
+	}
+}
+
+// WithGap sets the distance between the trigger and the tooltip.
+func WithGap(gap unit.Dp) Option {
+	return func(t *RichTooltip) {
+		t.Gap = gap
+	}
+}
+
+// WithMaxWidth sets the maximum width of the tooltip card.
+func WithMaxWidth(width unit.Dp) Option {
+	return func(t *RichTooltip) {
+		t.MaxWidth = width
+	}
+}
+
+// NewRichTooltip creates a new RichTooltip showing content.
+func NewRichTooltip(content layout.Widget, options ...Option) *RichTooltip {
+	t := &RichTooltip{
+		Content:   content,
+		Placement: popover.PlacementTop,
+		Delay:     DefaultDelay,
+		Gap:       unit.Dp(6),
+		MaxWidth:  DefaultMaxWidth,
+	}
+	t.fade.Configure(animation.Config{Duration: fadeDuration, Easing: animation.EaseOut})
+
+	for _, option := range options {
+		option(t)
+	}
+
+	return t
+}
+
+// IsOpen returns true if the tooltip is showing.
+func (t *RichTooltip) IsOpen() bool {
+	return t.open
+}
+
+// SetViewport sets the trigger's position in the window and the window size,
+// both in pixels, used to keep the tooltip inside the window.
+func (t *RichTooltip) SetViewport(origin, window image.Point) {
+	t.origin = origin
+	t.window = window
+}
+
+// Layout renders the trigger and, once it has been hovered for Delay, the
+// tooltip content.
+func (t *RichTooltip) Layout(gtx layout.Context, th *theme.Theme, trigger layout.Widget) layout.Dimensions {
+	t.processHover(gtx)
+
+	window := t.window
+	if window == (image.Point{}) {
+		window = t.origin.Add(gtx.Constraints.Max)
+	}
+
+	// The hover area encloses the trigger so it doesn't block its input
+	macro := op.Record(gtx.Ops)
+	dims := trigger(gtx)
+	call := macro.Stop()
+
+	area := clip.Rect{Max: dims.Size}.Push(gtx.Ops)
+	event.Op(gtx.Ops, t)
+	call.Add(gtx.Ops)
+	area.Pop()
+
+	if t.open && (t.Content != nil || t.themedContent != nil) {
+		t.deferContent(gtx, th, image.Rectangle{Max: dims.Size}, window)
+	}
+
+	return dims
+}
+
+// processHover tracks the pointer over the trigger and opens the tooltip once
+// the hover delay has passed. A press closes the tooltip until the pointer
+// leaves and enters again.
+func (t *RichTooltip) processHover(gtx layout.Context) {
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: t, Kinds: pointer.Enter | pointer.Leave | pointer.Press | pointer.Cancel})
+		if !ok {
+			break
+		}
+		e, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+		switch e.Kind {
+		case pointer.Enter:
+			if !t.hovered {
+				t.hovered = true
+				t.hoverStart = gtx.Now
+			}
+		case pointer.Leave, pointer.Cancel:
+			t.hovered = false
+			t.pressed = false
+			t.open = false
+			t.fade.Set(0)
+		case pointer.Press:
+			t.pressed = true
+			t.open = false
+			t.fade.Set(0)
+		}
+	}
+
+	if t.hovered && !t.pressed && !t.open {
+		openAt := t.hoverStart.Add(t.Delay)
+		if gtx.Now.Before(openAt) {
+			gtx.Execute(op.InvalidateCmd{At: openAt})
+			return
+		}
+		t.open = true
+		t.fade.Set(0)
+		t.fade.Animate(1)
+	}
+}
+
+// deferContent records the tooltip card and defers it so it draws above
+// other content.
+func (t *RichTooltip) deferContent(gtx layout.Context, th *theme.Theme, trigger image.Rectangle, window image.Point) {
+	gtx.Constraints.Min = image.Point{}
+	gtx.Constraints.Max = image.Pt(min(window.X, gtx.Dp(t.MaxWidth)), window.Y)
+	card := op.Record(gtx.Ops)
+	size := t.layoutCard(gtx, th).Size
+	call := card.Stop()
+
+	resolver := popover.PositionResolver{AutoFlip: true, Gap: gtx.Dp(t.Gap)}
+	pos, _ := resolver.Resolve(trigger.Add(t.origin), size, t.Placement, window)
+
+	macro := op.Record(gtx.Ops)
+	offset := op.Offset(pos.Sub(t.origin)).Push(gtx.Ops)
+	if th.ReducedMotion() {
+		t.fade.Set(t.fade.Target())
+	}
+	opacity := paint.PushOpacity(gtx.Ops, t.fade.Value(gtx))
+	call.Add(gtx.Ops)
+	opacity.Pop()
+	offset.Pop()
+	op.Defer(gtx.Ops, macro.Stop())
+}
+
+// layoutCard renders the content in a popover-colored card, or a primary
+// label for text tooltips.
+func (t *RichTooltip) layoutCard(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	content := t.Content
+	if t.themedContent != nil {
+		content = func(gtx layout.Context) layout.Dimensions {
+			return t.themedContent(gtx, th)
+		}
+	}
+	inset := layout.UniformInset(th.Spacing.Space3)
+	if t.plain {
+		inset = layout.Inset{Top: unit.Dp(6), Bottom: unit.Dp(6), Left: th.Spacing.Space3, Right: th.Spacing.Space3}
+	}
+	dims := inset.Layout(gtx, content)
+	call := macro.Stop()
+
+	rr := clip.UniformRRect(image.Rectangle{Max: dims.Size}, gtx.Dp(th.Radius.RadiusMD))
+	if t.plain {
+		paint.FillShape(gtx.Ops, th.Colors.Primary, rr.Op(gtx.Ops))
+	} else {
+		paint.FillShape(gtx.Ops, th.Colors.Popover, rr.Op(gtx.Ops))
+		paint.FillShape(gtx.Ops, th.Colors.Border, clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Width: float32(gtx.Dp(unit.Dp(1))),
+		}.Op())
+	}
+	call.Add(gtx.Ops)
+
+	return dims
+}
+
+// Update returns the component state for RichTooltip.
+func (t *RichTooltip) Update(_ layout.Context) theme.ComponentState {
+	return &State{
+		active:  t.open,
+		hovered: t.hovered,
+	}
+}
+
+// State implements ComponentState for RichTooltip.
+type State struct {
+	active   bool
+	hovered  bool
+	pressed  bool
+	disabled bool
+}
+
+// IsActive returns true if the tooltip is showing.
+func (s *State) IsActive() bool {
+	return s.active
+}
+
+// IsHovered returns true if the trigger is being hovered over.
+func (s *State) IsHovered() bool {
+	return s.hovered
+}
+
+// IsPressed always returns false; tooltips don't react to presses.
+func (s *State) IsPressed() bool {
+	return s.pressed
+}
+
+// IsDisabled returns true if the tooltip is disabled.
+func (s *State) IsDisabled() bool {
+	return s.disabled
+}
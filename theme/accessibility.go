@@ -0,0 +1,154 @@
+package theme
+
+import (
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+// AccessibilityMode is a preset of theme adjustments for accessibility needs.
+type AccessibilityMode int
+
+// Available accessibility modes.
+const (
+	// AccessibilityNone applies no adjustments.
+	AccessibilityNone AccessibilityMode = iota
+	// AccessibilityHighContrast draws borders and muted text in the
+	// foreground color, with borders twice as wide.
+	AccessibilityHighContrast
+	// AccessibilityReducedMotion disables animations.
+	AccessibilityReducedMotion
+	// AccessibilityLargePrint scales spacing and font sizes by 1.5.
+	AccessibilityLargePrint
+)
+
+// largePrintScale is the scale factor of AccessibilityLargePrint.
+const largePrintScale = 1.5
+
+// String returns the mode name.
+func (m AccessibilityMode) String() string {
+	switch m {
+	case AccessibilityHighContrast:
+		return "high-contrast"
+	case AccessibilityReducedMotion:
+		return "reduced-motion"
+	case AccessibilityLargePrint:
+		return "large-print"
+	default:
+		return "none"
+	}
+}
+
+// WithAccessibility returns a copy of the theme with the adjustments of mode
+// applied. It leaves the global animation switch alone; call
+// ApplyAccessibility once the mode is chosen so Animators follow it too.
+//
+// Example:.
+//
+//	th = th.WithAccessibility(theme.AccessibilityHighContrast)
+func (t *Theme) WithAccessibility(mode AccessibilityMode) *Theme {
+	th := *t
+	th.Accessibility = mode
+
+	switch mode {
+	case AccessibilityHighContrast:
+		for _, cs := range []*ColorScheme{&th.Colors, &th.DarkColors} {
+			cs.Border = cs.Foreground
+			cs.Input = cs.Foreground
+			cs.MutedFg = cs.Foreground
+		}
+		th.BorderWidthScale = 2
+	case AccessibilityLargePrint:
+		return th.Scale(largePrintScale)
+	}
+
+	return &th
+}
+
+// ReducedMotion reports whether components should skip decorative motion,
+// because the theme uses AccessibilityReducedMotion or animations are off
+// globally.
+func (t *Theme) ReducedMotion() bool {
+	return t.Accessibility == AccessibilityReducedMotion || !animation.Enabled()
+}
+
+// ApplyAccessibility applies the process-wide side of mode: animations are
+// disabled with AccessibilityReducedMotion and enabled otherwise. Call it at
+// start-up and whenever the user changes the mode, next to WithAccessibility.
+//
+// Example:.
+//
+//	mode := theme.AccessibilityFromSystem()
+//	theme.ApplyAccessibility(mode)
+//	th := theme.New(theme.WithAccessibility(mode))
+func ApplyAccessibility(mode AccessibilityMode) {
+	animation.SetEnabled(mode != AccessibilityReducedMotion)
+}
+
+// WithAccessibility applies an accessibility mode to a theme created by New
+// or NewDark.
+//
+// Example:.
+//
+//	th := theme.New(theme.WithAccessibility(theme.AccessibilityFromSystem()))
+func WithAccessibility(mode AccessibilityMode) Option {
+	return func(t *Theme) {
+		*t = *t.WithAccessibility(mode)
+	}
+}
+
+// AccessibilityFromSystem returns the accessibility mode requested by the
+// operating system, preferring high contrast, then large print, then reduced
+// motion. It reads the macOS universal access defaults, the GNOME desktop
+// settings on Linux and the high contrast setting on Windows. Settings that
+// can't be read count as off.
+func AccessibilityFromSystem() AccessibilityMode {
+	switch runtime.GOOS {
+	case "darwin":
+		switch {
+		case systemOutput("defaults", "read", "com.apple.universalaccess", "increaseContrast") == "1":
+			return AccessibilityHighContrast
+		case systemOutput("defaults", "read", "com.apple.universalaccess", "reduceMotion") == "1":
+			return AccessibilityReducedMotion
+		}
+	case "windows":
+		// Bit 0 of the Flags value is set while high contrast is on
+		out := systemOutput("reg", "query", `HKCU\Control Panel\Accessibility\HighContrast`, "/v", "Flags")
+		if fields := strings.Fields(out); len(fields) > 0 {
+			if flags, err := strconv.Atoi(fields[len(fields)-1]); err == nil && flags&1 != 0 {
+				return AccessibilityHighContrast
+			}
+		}
+	default:
+		switch {
+		case systemOutput("gsettings", "get", "org.gnome.desktop.a11y.interface", "high-contrast") == "true":
+			return AccessibilityHighContrast
+		case parseFloat(systemOutput("gsettings", "get", "org.gnome.desktop.interface", "text-scaling-factor")) >= largePrintScale:
+			return AccessibilityLargePrint
+		case systemOutput("gsettings", "get", "org.gnome.desktop.interface", "enable-animations") == "false":
+			return AccessibilityReducedMotion
+		}
+	}
+	return AccessibilityNone
+}
+
+// systemOutput runs a settings command and returns its trimmed output, or ""
+// when it fails.
+func systemOutput(name string, args ...string) string {
+	out, err := exec.Command(name, args...).Output() // #nosec G204 - fixed settings commands
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func parseFloat(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
@@ -0,0 +1,33 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/bnema/gio-shadcn/utils/animation"
+)
+
+func TestWithAccessibilityLeavesAnimationsAlone(t *testing.T) {
+	defer animation.SetEnabled(true)
+
+	ApplyAccessibility(AccessibilityReducedMotion)
+	reduced := New().WithAccessibility(AccessibilityReducedMotion)
+	derived := reduced.WithAccessibility(AccessibilityHighContrast)
+
+	if animation.Enabled() {
+		t.Error("WithAccessibility re-enabled animations turned off by ApplyAccessibility")
+	}
+	if !reduced.ReducedMotion() {
+		t.Error("reduced-motion theme does not report ReducedMotion")
+	}
+	if derived.Accessibility != AccessibilityHighContrast {
+		t.Errorf("Accessibility = %v, want %v", derived.Accessibility, AccessibilityHighContrast)
+	}
+
+	ApplyAccessibility(AccessibilityNone)
+	if !animation.Enabled() {
+		t.Error("ApplyAccessibility(AccessibilityNone) left animations off")
+	}
+	if New().ReducedMotion() {
+		t.Error("default theme reports ReducedMotion")
+	}
+}
@@ -2,6 +2,8 @@ package theme
 
 import (
 	"image/color"
+
+	"github.com/bnema/gio-shadcn/utils"
 )
 
 // ColorScheme represents the complete color palette for gio-shadcn themes.
@@ -14,6 +16,7 @@ import (
 // • Brand colors (primary, secondary).
 // • Content colors (muted, accent).
 // • State colors (destructive).
+// • Status colors (amber for warnings, blue for information, green for success).
 // • Border colors (border, input, ring).
 type ColorScheme struct {
 	// Core colors
@@ -44,6 +47,14 @@ type ColorScheme struct {
 	Destructive   color.NRGBA // --destructive
 	DestructiveFg color.NRGBA // --destructive-foreground
 
+	// Status colors
+	Amber   color.NRGBA // --amber
+	AmberFg color.NRGBA // --amber-foreground
+	Blue    color.NRGBA // --blue
+	BlueFg  color.NRGBA // --blue-foreground
+	Green   color.NRGBA // --green
+	GreenFg color.NRGBA // --green-foreground
+
 	// Border colors
 	Border color.NRGBA // --border
 	Input  color.NRGBA // --input
@@ -64,25 +75,31 @@ type ColorScheme struct {
 //nolint:dupl // Light and dark color schemes are intentionally similar but different
 func LightColorScheme() ColorScheme {
 	return ColorScheme{
-		Background:    color.NRGBA{R: 255, G: 255, B: 255, A: 255}, // white
-		Foreground:    color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Card:          color.NRGBA{R: 255, G: 255, B: 255, A: 255}, // white
-		CardFg:        color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Popover:       color.NRGBA{R: 255, G: 255, B: 255, A: 255}, // white
-		PopoverFg:     color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Primary:       color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		PrimaryFg:     color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Secondary:     color.NRGBA{R: 244, G: 244, B: 245, A: 255}, // zinc-100
-		SecondaryFg:   color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Muted:         color.NRGBA{R: 244, G: 244, B: 245, A: 255}, // zinc-100
-		MutedFg:       color.NRGBA{R: 82, G: 82, B: 91, A: 255},    // zinc-500
-		Accent:        color.NRGBA{R: 244, G: 244, B: 245, A: 255}, // zinc-100
-		AccentFg:      color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Destructive:   color.NRGBA{R: 239, G: 68, B: 68, A: 255},   // red-500
-		DestructiveFg: color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Border:        color.NRGBA{R: 228, G: 228, B: 231, A: 255}, // zinc-200
-		Input:         color.NRGBA{R: 228, G: 228, B: 231, A: 255}, // zinc-200
-		Ring:          color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
+		Background:    utils.MustParseHex("#ffffff"), // white
+		Foreground:    utils.MustParseHex("#09090b"), // zinc-950
+		Card:          utils.MustParseHex("#ffffff"), // white
+		CardFg:        utils.MustParseHex("#09090b"), // zinc-950
+		Popover:       utils.MustParseHex("#ffffff"), // white
+		PopoverFg:     utils.MustParseHex("#09090b"), // zinc-950
+		Primary:       utils.MustParseHex("#09090b"), // zinc-950
+		PrimaryFg:     utils.MustParseHex("#fafafa"), // zinc-50
+		Secondary:     utils.MustParseHex("#f4f4f5"), // zinc-100
+		SecondaryFg:   utils.MustParseHex("#09090b"), // zinc-950
+		Muted:         utils.MustParseHex("#f4f4f5"), // zinc-100
+		MutedFg:       utils.MustParseHex("#52525b"), // zinc-500
+		Accent:        utils.MustParseHex("#f4f4f5"), // zinc-100
+		AccentFg:      utils.MustParseHex("#09090b"), // zinc-950
+		Destructive:   utils.MustParseHex("#ef4444"), // red-500
+		DestructiveFg: utils.MustParseHex("#fafafa"), // zinc-50
+		Amber:         utils.MustParseHex("#f59e0b"), // amber-500
+		AmberFg:       utils.MustParseHex("#451a03"), // amber-950
+		Blue:          utils.MustParseHex("#2563eb"), // blue-600
+		BlueFg:        utils.MustParseHex("#eff6ff"), // blue-50
+		Green:         utils.MustParseHex("#16a34a"), // green-600
+		GreenFg:       utils.MustParseHex("#f0fdf4"), // green-50
+		Border:        utils.MustParseHex("#e4e4e7"), // zinc-200
+		Input:         utils.MustParseHex("#e4e4e7"), // zinc-200
+		Ring:          utils.MustParseHex("#09090b"), // zinc-950
 	}
 }
 
@@ -100,24 +117,30 @@ func LightColorScheme() ColorScheme {
 //nolint:dupl // Light and dark color schemes are intentionally similar but different
 func DarkColorScheme() ColorScheme {
 	return ColorScheme{
-		Background:    color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Foreground:    color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Card:          color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		CardFg:        color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Popover:       color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		PopoverFg:     color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Primary:       color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		PrimaryFg:     color.NRGBA{R: 9, G: 9, B: 11, A: 255},      // zinc-950
-		Secondary:     color.NRGBA{R: 39, G: 39, B: 42, A: 255},    // zinc-800
-		SecondaryFg:   color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Muted:         color.NRGBA{R: 39, G: 39, B: 42, A: 255},    // zinc-800
-		MutedFg:       color.NRGBA{R: 161, G: 161, B: 170, A: 255}, // zinc-400
-		Accent:        color.NRGBA{R: 39, G: 39, B: 42, A: 255},    // zinc-800
-		AccentFg:      color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Destructive:   color.NRGBA{R: 127, G: 29, B: 29, A: 255},   // red-900
-		DestructiveFg: color.NRGBA{R: 250, G: 250, B: 250, A: 255}, // zinc-50
-		Border:        color.NRGBA{R: 39, G: 39, B: 42, A: 255},    // zinc-800
-		Input:         color.NRGBA{R: 39, G: 39, B: 42, A: 255},    // zinc-800
-		Ring:          color.NRGBA{R: 212, G: 212, B: 216, A: 255}, // zinc-300
+		Background:    utils.MustParseHex("#09090b"), // zinc-950
+		Foreground:    utils.MustParseHex("#fafafa"), // zinc-50
+		Card:          utils.MustParseHex("#09090b"), // zinc-950
+		CardFg:        utils.MustParseHex("#fafafa"), // zinc-50
+		Popover:       utils.MustParseHex("#09090b"), // zinc-950
+		PopoverFg:     utils.MustParseHex("#fafafa"), // zinc-50
+		Primary:       utils.MustParseHex("#fafafa"), // zinc-50
+		PrimaryFg:     utils.MustParseHex("#09090b"), // zinc-950
+		Secondary:     utils.MustParseHex("#27272a"), // zinc-800
+		SecondaryFg:   utils.MustParseHex("#fafafa"), // zinc-50
+		Muted:         utils.MustParseHex("#27272a"), // zinc-800
+		MutedFg:       utils.MustParseHex("#a1a1aa"), // zinc-400
+		Accent:        utils.MustParseHex("#27272a"), // zinc-800
+		AccentFg:      utils.MustParseHex("#fafafa"), // zinc-50
+		Destructive:   utils.MustParseHex("#7f1d1d"), // red-900
+		DestructiveFg: utils.MustParseHex("#fafafa"), // zinc-50
+		Amber:         utils.MustParseHex("#f59e0b"), // amber-500
+		AmberFg:       utils.MustParseHex("#451a03"), // amber-950
+		Blue:          utils.MustParseHex("#60a5fa"), // blue-400
+		BlueFg:        utils.MustParseHex("#172554"), // blue-950
+		Green:         utils.MustParseHex("#4ade80"), // green-400
+		GreenFg:       utils.MustParseHex("#052e16"), // green-950
+		Border:        utils.MustParseHex("#27272a"), // zinc-800
+		Input:         utils.MustParseHex("#27272a"), // zinc-800
+		Ring:          utils.MustParseHex("#d4d4d8"), // zinc-300
 	}
 }
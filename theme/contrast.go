@@ -0,0 +1,115 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+)
+
+// WCAG contrast requirements.
+const (
+	ContrastAA      = 4.5 // Normal text
+	ContrastAALarge = 3.0 // Large text and secondary content
+)
+
+// ValidationWarning reports a foreground/background pair with insufficient contrast.
+type ValidationWarning struct {
+	Pair     string
+	Ratio    float64
+	Required float64
+	Level    string
+}
+
+// String returns a human-readable description of the warning.
+func (w ValidationWarning) String() string {
+	return fmt.Sprintf("%s contrast %.2f:1 is below %.1f:1 (WCAG %s)", w.Pair, w.Ratio, w.Required, w.Level)
+}
+
+// Validate checks the WCAG contrast ratio of every foreground/background pair.
+// It returns a warning for each pair below its required ratio; an empty result
+// means the scheme passes. Muted text only needs the large-text ratio, since it
+// is used for secondary content.
+func (cs *ColorScheme) Validate() []ValidationWarning {
+	pairs := []struct {
+		name     string
+		bg, fg   color.NRGBA
+		required float64
+	}{
+		{"background/foreground", cs.Background, cs.Foreground, ContrastAA},
+		{"card/card-foreground", cs.Card, cs.CardFg, ContrastAA},
+		{"popover/popover-foreground", cs.Popover, cs.PopoverFg, ContrastAA},
+		{"primary/primary-foreground", cs.Primary, cs.PrimaryFg, ContrastAA},
+		{"secondary/secondary-foreground", cs.Secondary, cs.SecondaryFg, ContrastAA},
+		{"muted/muted-foreground", cs.Muted, cs.MutedFg, ContrastAALarge},
+		{"accent/accent-foreground", cs.Accent, cs.AccentFg, ContrastAA},
+		{"destructive/destructive-foreground", cs.Destructive, cs.DestructiveFg, ContrastAA},
+	}
+
+	var warnings []ValidationWarning
+	for _, p := range pairs {
+		ratio := ContrastRatio(p.bg, p.fg)
+		if ratio >= p.required {
+			continue
+		}
+
+		level := "AA"
+		if p.required == ContrastAALarge {
+			level = "AA Large"
+		}
+		warnings = append(warnings, ValidationWarning{
+			Pair:     p.name,
+			Ratio:    ratio,
+			Required: p.required,
+			Level:    level,
+		})
+	}
+
+	return warnings
+}
+
+// ContrastRatio returns the WCAG 2.x contrast ratio between two colors,
+// from 1 (no contrast) to 21 (black on white). Alpha is ignored.
+func ContrastRatio(a, b color.NRGBA) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// relativeLuminance returns the WCAG relative luminance of a color.
+func relativeLuminance(c color.NRGBA) float64 {
+	channel := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(c.R) + 0.7152*channel(c.G) + 0.0722*channel(c.B)
+}
+
+// ValidateOption configures ValidateTheme.
+type ValidateOption func(*validateConfig)
+
+type validateConfig struct {
+	strict bool
+}
+
+// StrictValidation makes ValidateTheme treat contrast warnings as errors.
+func StrictValidation(strict bool) ValidateOption {
+	return func(c *validateConfig) {
+		c.strict = strict
+	}
+}
+
+// contrastError joins contrast warnings into a single error.
+func contrastError(warnings []ValidationWarning) error {
+	messages := make([]string, len(warnings))
+	for i, w := range warnings {
+		messages[i] = w.String()
+	}
+	return fmt.Errorf("insufficient contrast: %s", strings.Join(messages, "; "))
+}
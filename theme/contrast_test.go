@@ -0,0 +1,35 @@
+package theme
+
+import "testing"
+
+func TestValidateReportsLowContrast(t *testing.T) {
+	cs := LightColorScheme()
+	warnings := cs.Validate()
+
+	// Red-500 under near-white text is below the AA ratio for normal text
+	if len(warnings) != 1 {
+		t.Fatalf("light scheme warnings = %v, want only the destructive pair", warnings)
+	}
+	w := warnings[0]
+	if w.Pair != "destructive/destructive-foreground" || w.Level != "AA" || w.Required != ContrastAA {
+		t.Errorf("warning = %+v, want destructive/destructive-foreground at WCAG AA", w)
+	}
+	if w.Ratio >= ContrastAA || w.Ratio != ContrastRatio(cs.Destructive, cs.DestructiveFg) {
+		t.Errorf("warning ratio = %.2f, want the pair's ratio below %.1f", w.Ratio, ContrastAA)
+	}
+
+	cs.Destructive = PaletteRed[Shade700]
+	if warnings := cs.Validate(); len(warnings) != 0 {
+		t.Errorf("warnings after darkening destructive = %v, want none", warnings)
+	}
+}
+
+func TestStrictValidationRejectsLowContrast(t *testing.T) {
+	th := New()
+	if err := ValidateTheme(th); err != nil {
+		t.Errorf("lenient validation: %v", err)
+	}
+	if err := ValidateTheme(th, StrictValidation(true)); err == nil {
+		t.Error("strict validation accepted a destructive pair below WCAG AA")
+	}
+}
@@ -0,0 +1,146 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bnema/gio-shadcn/utils"
+)
+
+// Environment variables read by NewFromEnv.
+const (
+	envColorPrefix = "GIO_COLOR_"
+	envDark        = "GIO_DARK"
+	envScale       = "GIO_SCALE"
+)
+
+// NewFromEnv creates a theme configured by environment variables, for kiosk
+// apps, digital signage and CI screenshots that can't change source files.
+//
+// The supported variables are:
+//
+//	GIO_DARK=1                       start from NewDark instead of New
+//	GIO_SCALE=1.5                    apply Scale with the given factor
+//	GIO_COLOR_BACKGROUND             override Colors.Background
+//	GIO_COLOR_FOREGROUND             override Colors.Foreground
+//	GIO_COLOR_CARD                   override Colors.Card
+//	GIO_COLOR_CARD_FOREGROUND        override Colors.CardFg
+//	GIO_COLOR_POPOVER                override Colors.Popover
+//	GIO_COLOR_POPOVER_FOREGROUND     override Colors.PopoverFg
+//	GIO_COLOR_PRIMARY                override Colors.Primary
+//	GIO_COLOR_PRIMARY_FOREGROUND     override Colors.PrimaryFg
+//	GIO_COLOR_SECONDARY              override Colors.Secondary
+//	GIO_COLOR_SECONDARY_FOREGROUND   override Colors.SecondaryFg
+//	GIO_COLOR_MUTED                  override Colors.Muted
+//	GIO_COLOR_MUTED_FOREGROUND       override Colors.MutedFg
+//	GIO_COLOR_ACCENT                 override Colors.Accent
+//	GIO_COLOR_ACCENT_FOREGROUND      override Colors.AccentFg
+//	GIO_COLOR_DESTRUCTIVE            override Colors.Destructive
+//	GIO_COLOR_DESTRUCTIVE_FOREGROUND override Colors.DestructiveFg
+//	GIO_COLOR_BORDER                 override Colors.Border
+//	GIO_COLOR_INPUT                  override Colors.Input
+//	GIO_COLOR_RING                   override Colors.Ring
+//
+// Color names follow the shadcn/ui CSS variables, and values are parsed with
+// ParseColor. Colors apply to the active scheme. Unset variables and values
+// that don't parse are ignored.
+//
+// Example:.
+//
+//	// GIO_DARK=1 GIO_COLOR_PRIMARY=#2563eb ./app
+//	th := theme.NewFromEnv()
+func NewFromEnv() *Theme {
+	th := New()
+	if os.Getenv(envDark) == "1" {
+		th = NewDark()
+	}
+
+	for _, f := range colorFields {
+		value, ok := os.LookupEnv(colorEnvName(f.name))
+		if !ok {
+			continue
+		}
+		if c, err := ParseColor(value); err == nil {
+			*f.field(&th.Colors) = c
+		}
+	}
+
+	if factor := parseFloat(os.Getenv(envScale)); factor > 0 {
+		th = th.Scale(float32(factor))
+	}
+
+	return th
+}
+
+// EnvOverrides returns every GIO_COLOR_* environment variable that is set,
+// keyed by name, to debug what NewFromEnv picked up.
+func EnvOverrides() map[string]string {
+	overrides := make(map[string]string)
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		if strings.HasPrefix(name, envColorPrefix) {
+			overrides[name] = value
+		}
+	}
+	return overrides
+}
+
+// colorEnvName returns the environment variable of a JSON color name, such as
+// GIO_COLOR_CARD_FOREGROUND for "card-foreground".
+func colorEnvName(name string) string {
+	return envColorPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// ParseColor parses a CSS color: a hex color ("#rgb", "#rrggbb" or
+// "#rrggbbaa"), "rgb(r, g, b)" or "rgba(r, g, b, a)" with an alpha between 0
+// and 1.
+//
+// Example:.
+//
+//	primary, err := theme.ParseColor("rgb(37, 99, 235)")
+func ParseColor(s string) (color.NRGBA, error) {
+	s = strings.TrimSpace(s)
+
+	var args string
+	switch lower := strings.ToLower(s); {
+	case strings.HasPrefix(lower, "rgba(") && strings.HasSuffix(lower, ")"):
+		args = s[len("rgba(") : len(s)-1]
+	case strings.HasPrefix(lower, "rgb(") && strings.HasSuffix(lower, ")"):
+		args = s[len("rgb(") : len(s)-1]
+	default:
+		return utils.ParseHex(s)
+	}
+
+	parts := strings.Split(args, ",")
+	if len(parts) != 3 && len(parts) != 4 {
+		return color.NRGBA{}, fmt.Errorf("invalid color format: %s", s)
+	}
+
+	var channels [3]uint8
+	for i := range channels {
+		v, err := strconv.ParseUint(strings.TrimSpace(parts[i]), 10, 8)
+		if err != nil {
+			return color.NRGBA{}, fmt.Errorf("invalid color %s: %w", s, err)
+		}
+		channels[i] = uint8(v)
+	}
+
+	alpha := 1.0
+	if len(parts) == 4 {
+		a, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+		if err != nil || a < 0 || a > 1 {
+			return color.NRGBA{}, fmt.Errorf("invalid alpha in color %s", s)
+		}
+		alpha = a
+	}
+
+	return color.NRGBA{
+		R: channels[0],
+		G: channels[1],
+		B: channels[2],
+		A: uint8(alpha*255 + 0.5),
+	}, nil
+}
@@ -0,0 +1,179 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"gioui.org/unit"
+)
+
+// colorFields maps JSON color names to ColorScheme fields.
+var colorFields = []struct {
+	name  string
+	field func(cs *ColorScheme) *color.NRGBA
+}{
+	{"background", func(cs *ColorScheme) *color.NRGBA { return &cs.Background }},
+	{"foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.Foreground }},
+	{"card", func(cs *ColorScheme) *color.NRGBA { return &cs.Card }},
+	{"card-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.CardFg }},
+	{"popover", func(cs *ColorScheme) *color.NRGBA { return &cs.Popover }},
+	{"popover-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.PopoverFg }},
+	{"primary", func(cs *ColorScheme) *color.NRGBA { return &cs.Primary }},
+	{"primary-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.PrimaryFg }},
+	{"secondary", func(cs *ColorScheme) *color.NRGBA { return &cs.Secondary }},
+	{"secondary-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.SecondaryFg }},
+	{"muted", func(cs *ColorScheme) *color.NRGBA { return &cs.Muted }},
+	{"muted-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.MutedFg }},
+	{"accent", func(cs *ColorScheme) *color.NRGBA { return &cs.Accent }},
+	{"accent-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.AccentFg }},
+	{"destructive", func(cs *ColorScheme) *color.NRGBA { return &cs.Destructive }},
+	{"destructive-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.DestructiveFg }},
+	{"amber", func(cs *ColorScheme) *color.NRGBA { return &cs.Amber }},
+	{"amber-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.AmberFg }},
+	{"blue", func(cs *ColorScheme) *color.NRGBA { return &cs.Blue }},
+	{"blue-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.BlueFg }},
+	{"green", func(cs *ColorScheme) *color.NRGBA { return &cs.Green }},
+	{"green-foreground", func(cs *ColorScheme) *color.NRGBA { return &cs.GreenFg }},
+	{"border", func(cs *ColorScheme) *color.NRGBA { return &cs.Border }},
+	{"input", func(cs *ColorScheme) *color.NRGBA { return &cs.Input }},
+	{"ring", func(cs *ColorScheme) *color.NRGBA { return &cs.Ring }},
+}
+
+// fontSizeFields maps JSON font size names to Typography fields.
+var fontSizeFields = []struct {
+	name  string
+	field func(t *Typography) *unit.Sp
+}{
+	{"xs", func(t *Typography) *unit.Sp { return &t.FontSizeXS }},
+	{"sm", func(t *Typography) *unit.Sp { return &t.FontSizeSM }},
+	{"base", func(t *Typography) *unit.Sp { return &t.FontSizeBase }},
+	{"lg", func(t *Typography) *unit.Sp { return &t.FontSizeLG }},
+	{"xl", func(t *Typography) *unit.Sp { return &t.FontSizeXL }},
+	{"2xl", func(t *Typography) *unit.Sp { return &t.FontSize2XL }},
+	{"3xl", func(t *Typography) *unit.Sp { return &t.FontSize3XL }},
+	{"4xl", func(t *Typography) *unit.Sp { return &t.FontSize4XL }},
+}
+
+// radiusField pairs a Config radius string with its RadiusScale value.
+type radiusField struct {
+	value *string
+	dp    *unit.Dp
+}
+
+// radiusFields maps Config radius strings to RadiusScale fields.
+func radiusFields(config *Config, r *RadiusScale) []radiusField {
+	return []radiusField{
+		{&config.Radius.None, &r.RadiusNone},
+		{&config.Radius.SM, &r.RadiusSM},
+		{&config.Radius.Base, &r.RadiusBase},
+		{&config.Radius.MD, &r.RadiusMD},
+		{&config.Radius.LG, &r.RadiusLG},
+		{&config.Radius.XL, &r.RadiusXL},
+		{&config.Radius.XXL, &r.Radius2XL},
+		{&config.Radius.XXXL, &r.Radius3XL},
+		{&config.Radius.Full, &r.RadiusFull},
+	}
+}
+
+// JSON serializes the theme to the JSON format read by NewThemeFromJSON.
+// Colors are written as 6-digit hex strings, radius values as whole pixels
+// and font sizes as whole points. The light and dark schemes are written to
+// their own sections regardless of which one is active, and the active one is
+// recorded as the mode so NewThemeFromJSON restores it.
+//
+// Example:.
+//
+//	data, err := th.JSON()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (t *Theme) JSON() ([]byte, error) {
+	light, dark := t.Colors, t.DarkColors
+	if t.IsDark {
+		light, dark = dark, light
+	}
+
+	var config Config
+	config.Name = "Custom Theme"
+	config.Version = "1.0.0"
+	config.Mode = ModeLight
+	if t.IsDark {
+		config.Mode = ModeDark
+	}
+	config.Colors.Light = colorSchemeToMap(&light)
+	config.Colors.Dark = colorSchemeToMap(&dark)
+
+	radius := t.Radius
+	for _, f := range radiusFields(&config, &radius) {
+		*f.value = strconv.Itoa(int(math.Round(float64(*f.dp)))) + "px"
+	}
+
+	typography := t.Typography
+	config.Typography.FontSize = make(map[string]int, len(fontSizeFields))
+	for _, f := range fontSizeFields {
+		config.Typography.FontSize[f.name] = int(math.Round(float64(*f.field(&typography))))
+	}
+
+	data, err := json.MarshalIndent(&config, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize theme: %w", err)
+	}
+	return data, nil
+}
+
+// SaveToFile writes the theme JSON to path.
+//
+// Example:.
+//
+//	if err := th.SaveToFile("theme-custom.json"); err != nil {
+//		log.Printf("Failed to save theme: %v", err)
+//	}
+func (t *Theme) SaveToFile(path string) error {
+	data, err := t.JSON()
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec // File path is intended to be user-provided for theme saving
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write theme file: %w", err)
+	}
+	return nil
+}
+
+// applyScales applies the radius and font size values present in config to th.
+// Missing or invalid values keep the theme's current values.
+func (config *Config) applyScales(th *Theme) {
+	for _, f := range radiusFields(config, &th.Radius) {
+		px, err := strconv.Atoi(strings.TrimSuffix(*f.value, "px"))
+		if err == nil {
+			*f.dp = unit.Dp(px)
+		}
+	}
+
+	for _, f := range fontSizeFields {
+		if size, ok := config.Typography.FontSize[f.name]; ok && size > 0 {
+			*f.field(&th.Typography) = unit.Sp(size)
+		}
+	}
+}
+
+// colorSchemeToMap converts a color scheme to JSON color names and hex values.
+func colorSchemeToMap(cs *ColorScheme) map[string]string {
+	colors := make(map[string]string, len(colorFields))
+	for _, f := range colorFields {
+		colors[f.name] = nrgbaToHex(*f.field(cs))
+	}
+	return colors
+}
+
+// nrgbaToHex converts a color to a "#rrggbb" string, the inverse of hexToNRGBA.
+// Alpha is not represented.
+func nrgbaToHex(c color.NRGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
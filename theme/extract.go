@@ -0,0 +1,268 @@
+package theme
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"  // Register GIF decoding for ExtractFromFile
+	_ "image/jpeg" // Register JPEG decoding for ExtractFromFile
+	_ "image/png"  // Register PNG decoding for ExtractFromFile
+	"os"
+	"sort"
+
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// Color extraction settings.
+const (
+	// extractColors is the number of colors the image is quantized to.
+	extractColors = 16
+	// extractSamples caps the pixels sampled from large images.
+	extractSamples = 1 << 16
+	// minForegroundContrast is the contrast below which an extracted
+	// foreground is replaced by black or white.
+	minForegroundContrast = 4.5
+)
+
+// GenerateThemeFromScreenshot creates a theme whose colors are taken from an
+// image such as a screenshot or logo. It is meant for rapid prototyping; the
+// result is a starting point rather than a finished theme.
+//
+// The image is quantized to 16 colors with median cut: the sampled pixels
+// start in one box, and the box with the widest channel range is repeatedly
+// split at the median of that channel. Each box's average becomes a palette
+// color. The palette is then mapped to roles:
+//
+// • Background - the lightest color
+// • Foreground - the dark color with the least chroma, or black or white when
+// it lacks contrast against the background
+// • Primary and Ring - the color with the most chroma
+// • PrimaryFg - black or white, depending on colorutil.IsLight(Primary)
+//
+// The remaining surfaces are mixes of these colors. The inactive scheme swaps
+// background and foreground. Mostly transparent pixels are ignored; an image
+// without opaque pixels yields New().
+//
+// Example:.
+//
+//	img, _, err := image.Decode(file)
+//	if err != nil {
+//		return err
+//	}
+//	th := theme.GenerateThemeFromScreenshot(img)
+func GenerateThemeFromScreenshot(img image.Image) *Theme {
+	palette := quantizeMedianCut(samplePixels(img), extractColors)
+	if len(palette) == 0 {
+		return New()
+	}
+
+	bg, fg, primary := assignRoles(palette)
+	light := colorutil.IsLight(bg)
+	active := schemeFromRoles(bg, fg, primary, light)
+	inactive := schemeFromRoles(fg, bg, primary, !light)
+
+	return &Theme{
+		Colors:     active,
+		DarkColors: inactive,
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     !light,
+		Locale:     i18n.LocaleEN,
+	}
+}
+
+// ExtractFromFile reads a PNG, JPEG or GIF image and creates a theme from its
+// colors with GenerateThemeFromScreenshot.
+//
+// Example:.
+//
+//	th, err := theme.ExtractFromFile("logo.png")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	err = th.SaveToFile("theme.json")
+func ExtractFromFile(path string) (*Theme, error) {
+	file, err := os.Open(path) // #nosec G304 - path is provided by the caller
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return GenerateThemeFromScreenshot(img), nil
+}
+
+// paletteColor is a quantized color and the number of pixels it stands for.
+type paletteColor struct {
+	color color.NRGBA
+	count int
+}
+
+// samplePixels returns the opaque colors of img, sampling on a grid when the
+// image has more than extractSamples pixels.
+func samplePixels(img image.Image) []color.NRGBA {
+	bounds := img.Bounds()
+	step := 1
+	for (bounds.Dx()/step)*(bounds.Dy()/step) > extractSamples {
+		step++
+	}
+
+	var pixels []color.NRGBA
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c, ok := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if !ok || c.A < 128 {
+				continue
+			}
+			c.A = 255
+			pixels = append(pixels, c)
+		}
+	}
+	return pixels
+}
+
+// quantizeMedianCut reduces pixels to at most n colors with the median cut
+// algorithm, ordered by pixel count.
+func quantizeMedianCut(pixels []color.NRGBA, n int) []paletteColor {
+	if len(pixels) == 0 {
+		return nil
+	}
+
+	boxes := [][]color.NRGBA{pixels}
+	for len(boxes) < n {
+		// Split the box with the widest channel range
+		widest, channel, span := -1, 0, 0
+		for idx, box := range boxes {
+			if len(box) < 2 {
+				continue
+			}
+			if ch, sp := widestChannel(box); sp > span {
+				widest, channel, span = idx, ch, sp
+			}
+		}
+		if widest < 0 {
+			break
+		}
+
+		box := boxes[widest]
+		sort.Slice(box, func(i, j int) bool {
+			return channelValue(box[i], channel) < channelValue(box[j], channel)
+		})
+		mid := len(box) / 2
+		boxes[widest] = box[:mid]
+		boxes = append(boxes, box[mid:])
+	}
+
+	palette := make([]paletteColor, 0, len(boxes))
+	for _, box := range boxes {
+		palette = append(palette, paletteColor{color: averageColor(box), count: len(box)})
+	}
+	sort.SliceStable(palette, func(i, j int) bool { return palette[i].count > palette[j].count })
+	return palette
+}
+
+// widestChannel returns the RGB channel (0-2) with the widest range in box.
+func widestChannel(box []color.NRGBA) (channel, span int) {
+	for ch := 0; ch < 3; ch++ {
+		lo, hi := 255, 0
+		for _, c := range box {
+			v := channelValue(c, ch)
+			lo, hi = min(lo, v), max(hi, v)
+		}
+		if hi-lo > span {
+			channel, span = ch, hi-lo
+		}
+	}
+	return channel, span
+}
+
+func channelValue(c color.NRGBA, channel int) int {
+	switch channel {
+	case 0:
+		return int(c.R)
+	case 1:
+		return int(c.G)
+	default:
+		return int(c.B)
+	}
+}
+
+func averageColor(box []color.NRGBA) color.NRGBA {
+	var r, g, b int
+	for _, c := range box {
+		r += int(c.R)
+		g += int(c.G)
+		b += int(c.B)
+	}
+	n := len(box)
+	return color.NRGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: 255} // #nosec G115 - averages of uint8 values
+}
+
+// chroma returns the colorfulness of c, from 0 (gray) to 255.
+func chroma(c color.NRGBA) int {
+	hi := max(c.R, c.G, c.B)
+	lo := min(c.R, c.G, c.B)
+	return int(hi) - int(lo)
+}
+
+// assignRoles picks the background, foreground and primary colors from a
+// quantized palette.
+func assignRoles(palette []paletteColor) (bg, fg, primary color.NRGBA) {
+	bg = palette[0].color
+	primary = palette[0].color
+	fg = palette[0].color
+	fgScore := -1.0
+	for _, p := range palette {
+		c := p.color
+		if relativeLuminance(c) > relativeLuminance(bg) {
+			bg = c
+		}
+		if chroma(c) > chroma(primary) {
+			primary = c
+		}
+		// Prefer dark, neutral colors for text
+		if score := (1 - relativeLuminance(c)) * (1 - float64(chroma(c))/255); score > fgScore {
+			fg, fgScore = c, score
+		}
+	}
+
+	if ContrastRatio(fg, bg) < minForegroundContrast {
+		fg = color.NRGBA{A: 255}
+		if !colorutil.IsLight(bg) {
+			fg = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+		}
+	}
+	return bg, fg, primary
+}
+
+// schemeFromRoles derives a full color scheme from a background, foreground
+// and primary color. light selects the default destructive colors.
+func schemeFromRoles(bg, fg, primary color.NRGBA, light bool) ColorScheme {
+	cs := DarkColorScheme()
+	if light {
+		cs = LightColorScheme()
+	}
+
+	primaryFg := color.NRGBA{A: 255}
+	if !colorutil.IsLight(primary) {
+		primaryFg = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	}
+	subtle := colorutil.Mix(bg, fg, 0.06)
+	border := colorutil.Mix(bg, fg, 0.15)
+
+	cs.Background, cs.Foreground = bg, fg
+	cs.Card, cs.CardFg = bg, fg
+	cs.Popover, cs.PopoverFg = bg, fg
+	cs.Primary, cs.PrimaryFg = primary, primaryFg
+	cs.Secondary, cs.SecondaryFg = subtle, fg
+	cs.Muted, cs.MutedFg = subtle, colorutil.Mix(bg, fg, 0.55)
+	cs.Accent, cs.AccentFg = colorutil.Mix(bg, primary, 0.12), fg
+	cs.Border, cs.Input = border, border
+	cs.Ring = primary
+	return cs
+}
@@ -0,0 +1,80 @@
+package theme
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// repeatColor returns n copies of c.
+func repeatColor(c color.NRGBA, n int) []color.NRGBA {
+	pixels := make([]color.NRGBA, n)
+	for i := range pixels {
+		pixels[i] = c
+	}
+	return pixels
+}
+
+func TestQuantizeMedianCut(t *testing.T) {
+	red := color.NRGBA{R: 255, A: 255}
+	green := color.NRGBA{G: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+
+	var pixels []color.NRGBA
+	pixels = append(pixels, repeatColor(blue, 20)...)
+	pixels = append(pixels, repeatColor(red, 40)...)
+	pixels = append(pixels, repeatColor(green, 20)...)
+
+	// Boxes of a single color can't be split, so only three colors come out
+	palette := quantizeMedianCut(pixels, 16)
+	if len(palette) != 3 {
+		t.Fatalf("palette = %v, want 3 colors", palette)
+	}
+	if palette[0].color != red || palette[0].count != 40 {
+		t.Errorf("most frequent color = %+v, want red with 40 pixels", palette[0])
+	}
+	rest := map[color.NRGBA]int{palette[1].color: palette[1].count, palette[2].color: palette[2].count}
+	if rest[green] != 20 || rest[blue] != 20 {
+		t.Errorf("other colors = %v, want green and blue with 20 pixels each", rest)
+	}
+
+	// Averages stand for the split boxes
+	if palette := quantizeMedianCut(pixels, 2); len(palette) != 2 || palette[0].count != 40 {
+		t.Errorf("two-color palette = %v, want two boxes of 40 pixels", palette)
+	}
+
+	if palette := quantizeMedianCut(nil, 16); palette != nil {
+		t.Errorf("palette of no pixels = %v, want nil", palette)
+	}
+}
+
+func TestGenerateThemeFromScreenshot(t *testing.T) {
+	white := color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	accent := color.NRGBA{R: 37, G: 99, B: 235, A: 255}
+
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 40))
+	for y := range 40 {
+		for x := range 40 {
+			c := white
+			if x < 10 {
+				c = accent
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	th := GenerateThemeFromScreenshot(img)
+	if th.IsDark {
+		t.Error("theme from a white image is dark")
+	}
+	if th.Colors.Background != white {
+		t.Errorf("background = %v, want %v", th.Colors.Background, white)
+	}
+	if th.Colors.Primary != accent {
+		t.Errorf("primary = %v, want %v", th.Colors.Primary, accent)
+	}
+
+	if got := GenerateThemeFromScreenshot(image.NewNRGBA(image.Rect(0, 0, 4, 4))); got.Colors != New().Colors {
+		t.Error("transparent image did not yield the default theme")
+	}
+}
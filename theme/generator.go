@@ -3,11 +3,17 @@ package theme
 import (
 	"encoding/json"
 	"fmt"
+	"go/format"
 	"image/color"
 	"io"
 	"os"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/utils/i18n"
 )
 
 // Config represents a complete theme configuration that can be loaded from JSON.
@@ -33,12 +39,17 @@ import (
 //				"primary": "#60a5fa"
 //			}
 //		},
+//		"radius-preset": "rounded",
 //		"radius": {
 //			"sm": "2px",
+//			"base": "3px",
 //			"md": "4px",
 //			"lg": "8px"
 //		}
 //	}
+//
+// The radius-preset field selects a whole RadiusPreset scale; values in the
+// radius object override it.
 type Config struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -50,6 +61,7 @@ type Config struct {
 	Radius struct {
 		None string `json:"none"`
 		SM   string `json:"sm"`
+		Base string `json:"base"`
 		MD   string `json:"md"`
 		LG   string `json:"lg"`
 		XL   string `json:"xl"`
@@ -57,6 +69,9 @@ type Config struct {
 		XXXL string `json:"3xl"`
 		Full string `json:"full"`
 	} `json:"radius"`
+	RadiusPreset RadiusPreset `json:"radius-preset,omitempty"`
+	// Mode is the active color scheme, "light" or "dark". Empty means light.
+	Mode       string         `json:"mode,omitempty"`
 	Spacing    map[string]int `json:"spacing"`
 	Typography struct {
 		FontFamily map[string][]string `json:"fontFamily"`
@@ -66,6 +81,12 @@ type Config struct {
 	} `json:"typography"`
 }
 
+// Color scheme modes written to Config.Mode.
+const (
+	ModeLight = "light"
+	ModeDark  = "dark"
+)
+
 // LoadThemeFromJSON loads a theme configuration from a JSON file.
 // This function reads and parses a JSON theme file, returning a Config.
 // struct that can be used to generate Theme instances. The JSON should follow
@@ -127,119 +148,26 @@ func hexToNRGBA(hex string) (color.NRGBA, error) {
 // converts them to the internal ColorScheme format used by gio-shadcn themes.
 // Set isDark to true for dark mode colors, false for light mode colors.
 //
-// Returns an error if any required colors are missing or invalid hex values.
+// Colors missing from the configuration are left as zero values; use
+// WithFallback to fill them in. Returns an error for invalid hex values.
 func (config *Config) ToColorScheme(isDark bool) (ColorScheme, error) {
-	var colorMap map[string]string
+	colorMap := config.Colors.Light
 	if isDark {
 		colorMap = config.Colors.Dark
-	} else {
-		colorMap = config.Colors.Light
 	}
 
 	cs := ColorScheme{}
-
-	// Helper function to convert hex to NRGBA and handle errors
-	convertColor := func(name, hex string) (color.NRGBA, error) {
-		if hex == "" {
-			return color.NRGBA{}, fmt.Errorf("missing color for %s", name)
+	for _, f := range colorFields {
+		hex, ok := colorMap[f.name]
+		if !ok || hex == "" {
+			continue
 		}
-		return hexToNRGBA(hex)
-	}
-
-	var err error
-	cs.Background, err = convertColor("background", colorMap["background"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Foreground, err = convertColor("foreground", colorMap["foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Card, err = convertColor("card", colorMap["card"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.CardFg, err = convertColor("card-foreground", colorMap["card-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Popover, err = convertColor("popover", colorMap["popover"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.PopoverFg, err = convertColor("popover-foreground", colorMap["popover-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Primary, err = convertColor("primary", colorMap["primary"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.PrimaryFg, err = convertColor("primary-foreground", colorMap["primary-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Secondary, err = convertColor("secondary", colorMap["secondary"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.SecondaryFg, err = convertColor("secondary-foreground", colorMap["secondary-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Muted, err = convertColor("muted", colorMap["muted"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.MutedFg, err = convertColor("muted-foreground", colorMap["muted-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Accent, err = convertColor("accent", colorMap["accent"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.AccentFg, err = convertColor("accent-foreground", colorMap["accent-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Destructive, err = convertColor("destructive", colorMap["destructive"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.DestructiveFg, err = convertColor("destructive-foreground", colorMap["destructive-foreground"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Border, err = convertColor("border", colorMap["border"])
-	if err != nil {
-		return cs, err
-	}
-
-	cs.Input, err = convertColor("input", colorMap["input"])
-	if err != nil {
-		return cs, err
-	}
 
-	cs.Ring, err = convertColor("ring", colorMap["ring"])
-	if err != nil {
-		return cs, err
+		c, err := hexToNRGBA(hex)
+		if err != nil {
+			return cs, fmt.Errorf("invalid color for %s: %w", f.name, err)
+		}
+		*f.field(&cs) = c
 	}
 
 	return cs, nil
@@ -248,8 +176,13 @@ func (config *Config) ToColorScheme(isDark bool) (ColorScheme, error) {
 // NewThemeFromJSON creates a complete Theme instance from a JSON configuration file.
 // This is the main function for loading external themes. It loads the JSON config,
 // converts both light and dark color schemes, and creates a fully functional Theme.
-// with default typography and spacing. The resulting theme can be used immediately
-// with all gio-shadcn components.
+// with default typography, spacing and radius, overridden by any radius and font
+// size values in the file. Colors missing from the file fall back to the default
+// theme, so partial theme files are valid. The resulting theme can be used immediately with all
+// gio-shadcn components. Theme.JSON produces files in the same format.
+//
+// When validation options are given, the theme is checked with ValidateTheme;
+// pass StrictValidation(true) to reject themes with insufficient contrast.
 //
 // Example usage:.
 //
@@ -259,7 +192,7 @@ func (config *Config) ToColorScheme(isDark bool) (ColorScheme, error) {
 //	}
 //	// Use theme with components
 //	button.Layout(gtx, theme)
-func NewThemeFromJSON(path string) (*Theme, error) {
+func NewThemeFromJSON(path string, options ...ValidateOption) (*Theme, error) {
 	config, err := LoadThemeFromJSON(path)
 	if err != nil {
 		return nil, err
@@ -275,13 +208,42 @@ func NewThemeFromJSON(path string) (*Theme, error) {
 		return nil, fmt.Errorf("failed to parse dark colors: %w", err)
 	}
 
-	return &Theme{
+	th := &Theme{
 		Colors:     lightColors,
 		DarkColors: darkColors,
 		Typography: DefaultTypography(),
 		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
 		IsDark:     false,
-	}, nil
+		Locale:     i18n.LocaleEN,
+	}
+	if config.RadiusPreset != "" {
+		scale, ok := config.RadiusPreset.Scale()
+		if !ok {
+			return nil, fmt.Errorf("invalid radius preset: %s", config.RadiusPreset)
+		}
+		th.Radius = scale
+	}
+	config.applyScales(th)
+
+	// Partial theme files only override some colors
+	th = WithFallback(th, New())
+
+	switch config.Mode {
+	case "", ModeLight:
+	case ModeDark:
+		th.ToggleDark()
+	default:
+		return nil, fmt.Errorf("invalid mode: %s", config.Mode)
+	}
+
+	if len(options) > 0 {
+		if err := ValidateTheme(th, options...); err != nil {
+			return nil, err
+		}
+	}
+
+	return th, nil
 }
 
 // GenerateThemeConstants generates Go source code with color constants from a theme config.
@@ -321,3 +283,154 @@ func GenerateThemeConstants(config *Config) string {
 
 	return sb.String()
 }
+
+// SerializeToGo generates a Go source file that reproduces a theme as literals.
+// The generated file declares the given package and a DefaultTheme function
+// returning a *theme.Theme with every color, spacing, radius and typography
+// value spelled out, along with the locale, accessibility mode and border
+// width scale, so a theme built at runtime can be shipped as code instead of a
+// theme.json file. Font faces cannot be expressed as literals and are left
+// unset, which makes components fall back to the system default fonts. The
+// variant registry holds functions and is left unset too.
+//
+// The output is formatted with go/format. If formatting fails the unformatted
+// source is returned so the problem can be inspected.
+//
+// Example usage:.
+//
+//	src := theme.SerializeToGo(th, "branding")
+//	_ = os.WriteFile("theme_generated.go", []byte(src), 0o600)
+func SerializeToGo(th *Theme, packageName string) string {
+	var sb strings.Builder
+
+	// Inside package theme the types are referenced unqualified
+	qualifier := "theme."
+	if packageName == "theme" {
+		qualifier = ""
+	}
+
+	sb.WriteString("// Code generated by theme.SerializeToGo. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&sb, "package %s\n\n", packageName)
+	sb.WriteString("import (\n")
+	sb.WriteString("\t\"image/color\"\n\n")
+	sb.WriteString("\t\"gioui.org/unit\"\n")
+	if qualifier != "" {
+		sb.WriteString("\t\"github.com/bnema/gio-shadcn/theme\"\n")
+	}
+	if th.Locale != nil {
+		sb.WriteString("\t\"github.com/bnema/gio-shadcn/utils/i18n\"\n")
+	}
+	sb.WriteString(")\n\n")
+
+	sb.WriteString("// DefaultTheme returns the serialized theme configuration.\n")
+	fmt.Fprintf(&sb, "func DefaultTheme() *%sTheme {\n", qualifier)
+	fmt.Fprintf(&sb, "\treturn &%sTheme{\n", qualifier)
+	writeGoStruct(&sb, "Colors", qualifier+"ColorScheme", reflect.ValueOf(th.Colors))
+	writeGoStruct(&sb, "DarkColors", qualifier+"ColorScheme", reflect.ValueOf(th.DarkColors))
+	writeGoStruct(&sb, "Typography", qualifier+"Typography", reflect.ValueOf(th.Typography))
+	writeGoStruct(&sb, "Spacing", qualifier+"SpacingScale", reflect.ValueOf(th.Spacing))
+	writeGoStruct(&sb, "Radius", qualifier+"RadiusScale", reflect.ValueOf(th.Radius))
+	fmt.Fprintf(&sb, "IsDark: %t,\n", th.IsDark)
+	if th.Locale != nil {
+		fmt.Fprintf(&sb, "Locale: %s,\n", goLocale(th.Locale))
+	}
+	if th.Accessibility != AccessibilityNone {
+		fmt.Fprintf(&sb, "Accessibility: %s%s,\n", qualifier, accessibilityNames[th.Accessibility])
+	}
+	if th.BorderWidthScale != 0 {
+		fmt.Fprintf(&sb, "BorderWidthScale: %s,\n", formatFloat(th.BorderWidthScale))
+	}
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n")
+
+	src := sb.String()
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return src
+	}
+
+	return string(formatted)
+}
+
+// accessibilityNames maps accessibility modes to their constant names.
+var accessibilityNames = map[AccessibilityMode]string{
+	AccessibilityNone:          "AccessibilityNone",
+	AccessibilityHighContrast:  "AccessibilityHighContrast",
+	AccessibilityReducedMotion: "AccessibilityReducedMotion",
+	AccessibilityLargePrint:    "AccessibilityLargePrint",
+}
+
+// builtinLocales maps the i18n built-in locales to their variable names.
+var builtinLocales = map[*i18n.Locale]string{
+	i18n.LocaleEN: "i18n.LocaleEN",
+	i18n.LocaleES: "i18n.LocaleES",
+	i18n.LocaleFR: "i18n.LocaleFR",
+	i18n.LocaleDE: "i18n.LocaleDE",
+	i18n.LocaleZH: "i18n.LocaleZH",
+}
+
+// goLocale returns the Go source of a locale: the variable name of a built-in
+// locale, or a composite literal with its translations sorted by key.
+func goLocale(l *i18n.Locale) string {
+	if name, ok := builtinLocales[l]; ok {
+		return name
+	}
+
+	var sb strings.Builder
+	sb.WriteString("&i18n.Locale{\n")
+	fmt.Fprintf(&sb, "Name: %s,\n", strconv.Quote(l.Name))
+	if len(l.Translations) > 0 {
+		keys := make([]string, 0, len(l.Translations))
+		for key := range l.Translations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		sb.WriteString("Translations: map[string]string{\n")
+		for _, key := range keys {
+			fmt.Fprintf(&sb, "%s: %s,\n", strconv.Quote(key), strconv.Quote(l.Translations[key]))
+		}
+		sb.WriteString("},\n")
+	}
+	if l.Fallback != nil {
+		fmt.Fprintf(&sb, "Fallback: %s,\n", goLocale(l.Fallback))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// writeGoStruct writes a struct-valued field of Theme as a composite literal.
+// Fields whose type has no literal representation (such as font faces) are skipped.
+func writeGoStruct(sb *strings.Builder, field, typeName string, v reflect.Value) {
+	fmt.Fprintf(sb, "%s: %s{\n", field, typeName)
+
+	for i := 0; i < v.NumField(); i++ {
+		literal, ok := goLiteral(v.Field(i).Interface())
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(sb, "%s: %s,\n", v.Type().Field(i).Name, literal)
+	}
+
+	sb.WriteString("},\n")
+}
+
+// goLiteral returns the Go source representation of a theme value.
+func goLiteral(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case color.NRGBA:
+		return fmt.Sprintf("color.NRGBA{R: %d, G: %d, B: %d, A: %d}", v.R, v.G, v.B, v.A), true
+	case unit.Dp:
+		return fmt.Sprintf("unit.Dp(%s)", formatFloat(float32(v))), true
+	case unit.Sp:
+		return fmt.Sprintf("unit.Sp(%s)", formatFloat(float32(v))), true
+	case float32:
+		return formatFloat(v), true
+	default:
+		return "", false
+	}
+}
+
+func formatFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
@@ -0,0 +1,161 @@
+package theme
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// writeThemeFile writes content to a theme file in a temporary directory
+// and returns its path.
+func writeThemeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewThemeFromJSONFallback(t *testing.T) {
+	path := writeThemeFile(t, `{
+		"name": "minimal",
+		"colors": {
+			"light": {"background": "#102030", "foreground": "#f0e0d0"}
+		}
+	}`)
+
+	th, err := NewThemeFromJSON(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defaults := New()
+	for _, f := range colorFields {
+		got := *f.field(&th.Colors)
+		want := *f.field(&defaults.Colors)
+		switch f.name {
+		case "background":
+			want = rgb(0x102030)
+		case "foreground":
+			want = rgb(0xf0e0d0)
+		}
+		if got != want {
+			t.Errorf("light %s = %v, want %v", f.name, got, want)
+		}
+
+		if got, want := *f.field(&th.DarkColors), *f.field(&defaults.DarkColors); got != want {
+			t.Errorf("dark %s = %v, want %v", f.name, got, want)
+		}
+	}
+}
+
+func TestSerializeToGo(t *testing.T) {
+	th := New(WithLocale(i18n.LocaleDE)).WithAccessibility(AccessibilityHighContrast)
+	th.BorderWidthScale = 2
+
+	src := SerializeToGo(th, "branding")
+	if _, err := parser.ParseFile(token.NewFileSet(), "theme_generated.go", src, 0); err != nil {
+		t.Fatalf("generated source does not parse: %v\n%s", err, src)
+	}
+	for _, want := range []string{
+		"func DefaultTheme() *theme.Theme {",
+		"RadiusBase: unit.Dp(4),",
+		"Locale:           i18n.LocaleDE,",
+		"Accessibility:    theme.AccessibilityHighContrast,",
+		"BorderWidthScale: 2,",
+		`"github.com/bnema/gio-shadcn/utils/i18n"`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated source lacks %q:\n%s", want, src)
+		}
+	}
+
+	// Inside package theme, the types need no qualifier or import
+	src = SerializeToGo(th, "theme")
+	body := src[strings.Index(src, "package"):]
+	if strings.Contains(body, "theme.") {
+		t.Errorf("source for package theme refers to theme.:\n%s", src)
+	}
+	if !strings.Contains(src, "func DefaultTheme() *Theme {") {
+		t.Errorf("source for package theme lacks an unqualified DefaultTheme:\n%s", src)
+	}
+}
+
+func TestSerializeToGoCustomLocale(t *testing.T) {
+	th := New(WithLocale(&i18n.Locale{
+		Name:         "pirate",
+		Translations: map[string]string{i18n.KeyCancel: "Belay", i18n.KeyClose: "Scuttle"},
+		Fallback:     i18n.LocaleEN,
+	}))
+
+	src := SerializeToGo(th, "branding")
+	for _, want := range []string{`Name: "pirate",`, `"cancel": "Belay",`, "Fallback: i18n.LocaleEN,"} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated source lacks %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestRadiusRoundTrip(t *testing.T) {
+	scale := RadiusScale{
+		RadiusNone: 0,
+		RadiusSM:   3,
+		RadiusBase: 5,
+		RadiusMD:   7,
+		RadiusLG:   10,
+		RadiusXL:   14,
+		Radius2XL:  18,
+		Radius3XL:  26,
+		RadiusFull: 9999,
+	}
+	path := filepath.Join(t.TempDir(), "theme.json")
+	if err := New().WithCustomRadius(scale).SaveToFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	th, err := NewThemeFromJSON(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Radius != scale {
+		t.Errorf("radius after a round trip = %+v, want %+v", th.Radius, scale)
+	}
+}
+
+func TestDarkModeRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "theme.json")
+	if err := New().SaveToFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	th, err := NewThemeFromJSON(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	th.ToggleDark()
+	want := *th
+
+	saved := filepath.Join(dir, "theme-custom.json")
+	if err := th.SaveToFile(saved); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := NewThemeFromJSON(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !restored.IsDark {
+		t.Fatal("restored theme is in light mode, want dark")
+	}
+	if restored.Colors != want.Colors || restored.DarkColors != want.DarkColors {
+		t.Errorf("restored colors = %+v / %+v, want %+v / %+v",
+			restored.Colors, restored.DarkColors, want.Colors, want.DarkColors)
+	}
+}
@@ -0,0 +1,120 @@
+package theme
+
+import (
+	"gioui.org/layout"
+	"gioui.org/unit"
+)
+
+// SpacingKey names a step of the spacing scale, such as "4" for Space4.
+// Using keys instead of raw unit.Dp values keeps layouts consistent with the
+// theme and lets the whole app follow changes to the spacing scale.
+type SpacingKey string
+
+// Get returns the spacing value for a key. Unknown keys return 0.
+//
+//nolint:gocyclo // This function has high complexity but is a straightforward key lookup
+func (s *SpacingScale) Get(key SpacingKey) unit.Dp {
+	switch key {
+	case "1":
+		return s.Space1
+	case "2":
+		return s.Space2
+	case "3":
+		return s.Space3
+	case "4":
+		return s.Space4
+	case "5":
+		return s.Space5
+	case "6":
+		return s.Space6
+	case "7":
+		return s.Space7
+	case "8":
+		return s.Space8
+	case "9":
+		return s.Space9
+	case "10":
+		return s.Space10
+	case "11":
+		return s.Space11
+	case "12":
+		return s.Space12
+	case "14":
+		return s.Space14
+	case "16":
+		return s.Space16
+	case "20":
+		return s.Space20
+	case "24":
+		return s.Space24
+	case "28":
+		return s.Space28
+	case "32":
+		return s.Space32
+	case "36":
+		return s.Space36
+	case "40":
+		return s.Space40
+	case "44":
+		return s.Space44
+	case "48":
+		return s.Space48
+	case "52":
+		return s.Space52
+	case "56":
+		return s.Space56
+	case "60":
+		return s.Space60
+	case "64":
+		return s.Space64
+	case "72":
+		return s.Space72
+	case "80":
+		return s.Space80
+	case "96":
+		return s.Space96
+	default:
+		return s.Space0
+	}
+}
+
+// Centered centers a widget within theme-spaced padding.
+type Centered struct {
+	Inset layout.Inset
+}
+
+// Layout centers w in the space left after applying the inset.
+func (c Centered) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	return c.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Center.Layout(gtx, w)
+	})
+}
+
+// Center returns a centering layout padded on every side by the given spacing.
+// It replaces direct uses of layout.Center when the content needs breathing room.
+//
+// Example:.
+//
+//	theme.Center(th, "4").Layout(gtx, content)
+func Center(th *Theme, padding SpacingKey) Centered {
+	return Centered{Inset: layout.UniformInset(th.Spacing.Get(padding))}
+}
+
+// InsetN builds an inset from spacing keys, in CSS order.
+//
+// Example:.
+//
+//	theme.InsetN(th, "4", "8", "8", "8").Layout(gtx, content)
+func InsetN(th *Theme, top, right, bottom, left SpacingKey) layout.Inset {
+	return layout.Inset{
+		Top:    th.Spacing.Get(top),
+		Right:  th.Spacing.Get(right),
+		Bottom: th.Spacing.Get(bottom),
+		Left:   th.Spacing.Get(left),
+	}
+}
+
+// PageInset returns the standard page padding of Space8 on every side.
+func PageInset(th *Theme) layout.Inset {
+	return InsetN(th, "8", "8", "8", "8")
+}
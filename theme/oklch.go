@@ -0,0 +1,134 @@
+package theme
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+)
+
+// OklchToken is a color in the Oklch color space, as written with the CSS
+// oklch() function. L is the lightness from 0 to 1, C the chroma from 0
+// (gray) upwards, and H the hue angle in degrees. A is the alpha from 0 to 1;
+// zero is treated as opaque, since alpha is optional in CSS.
+type OklchToken struct {
+	L float32
+	C float32
+	H float32
+	A float32
+}
+
+// OklchToNRGBA converts an Oklch color to sRGB, going through Oklab and
+// linear sRGB with Björn Ottosson's public domain formulas. Colors outside
+// the sRGB gamut are clipped per channel.
+//
+// Example:.
+//
+//	blue := theme.OklchToNRGBA(theme.OklchToken{L: 0.546, C: 0.245, H: 262.88})
+func OklchToNRGBA(t OklchToken) color.NRGBA {
+	// Oklch to Oklab
+	hue := float64(t.H) * math.Pi / 180
+	lightness := float64(t.L)
+	a := float64(t.C) * math.Cos(hue)
+	b := float64(t.C) * math.Sin(hue)
+
+	// Oklab to linear sRGB
+	l := cube(lightness + 0.3963377774*a + 0.2158037573*b)
+	m := cube(lightness - 0.1055613458*a - 0.0638541728*b)
+	s := cube(lightness - 0.0894841775*a - 1.2914855480*b)
+
+	red := +4.0767416621*l - 3.3077115913*m + 0.2309699292*s
+	green := -1.2684380046*l + 2.6097574011*m - 0.3413193965*s
+	blue := -0.0041960863*l - 0.7034186147*m + 1.7076147010*s
+
+	alpha := float64(t.A)
+	if alpha == 0 {
+		alpha = 1
+	}
+
+	return color.NRGBA{
+		R: toByte(linearToSRGB(red)),
+		G: toByte(linearToSRGB(green)),
+		B: toByte(linearToSRGB(blue)),
+		A: toByte(alpha),
+	}
+}
+
+// BuildFromOklch creates a light theme from Oklch tokens keyed by the color
+// names of the theme JSON format, such as "primary" or "muted-foreground".
+// Colors missing from tokens and the dark color scheme come from the default
+// theme. Returns an error for unknown names and out-of-range tokens.
+//
+// Example usage:.
+//
+//	th, err := theme.BuildFromOklch(map[string]theme.OklchToken{
+//		"primary":            {L: 0.546, C: 0.245, H: 262.88},
+//		"primary-foreground": {L: 0.985, C: 0, H: 0},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func BuildFromOklch(tokens map[string]OklchToken) (*Theme, error) {
+	fields := make(map[string]func(cs *ColorScheme) *color.NRGBA, len(colorFields))
+	for _, f := range colorFields {
+		fields[f.name] = f.field
+	}
+
+	cs := ColorScheme{}
+	for name, token := range tokens {
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown color name: %s", name)
+		}
+		if err := token.validate(); err != nil {
+			return nil, fmt.Errorf("invalid color for %s: %w", name, err)
+		}
+		*field(&cs) = OklchToNRGBA(token)
+	}
+
+	th := &Theme{
+		Colors:     cs,
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     false,
+	}
+
+	// Only some colors may be given
+	return WithFallback(th, New()), nil
+}
+
+// validate checks that the token components are in range.
+func (t OklchToken) validate() error {
+	for _, v := range []float32{t.L, t.C, t.H, t.A} {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Errorf("oklch(%g %g %g / %g) is not a number", t.L, t.C, t.H, t.A)
+		}
+	}
+	if t.L < 0 || t.L > 1 {
+		return fmt.Errorf("lightness %g is outside 0–1", t.L)
+	}
+	if t.C < 0 {
+		return fmt.Errorf("chroma %g is negative", t.C)
+	}
+	if t.A < 0 || t.A > 1 {
+		return fmt.Errorf("alpha %g is outside 0–1", t.A)
+	}
+	return nil
+}
+
+func cube(x float64) float64 {
+	return x * x * x
+}
+
+// linearToSRGB applies the sRGB transfer function to a linear channel.
+func linearToSRGB(x float64) float64 {
+	if x <= 0.0031308 {
+		return 12.92 * x
+	}
+	return 1.055*math.Pow(x, 1/2.4) - 0.055
+}
+
+// toByte converts a channel from 0–1 to 0–255, clipping it to that range.
+func toByte(x float64) uint8 {
+	return uint8(math.Round(max(0, min(x, 1)) * 255))
+}
@@ -0,0 +1,173 @@
+package theme
+
+import (
+	"image/color"
+)
+
+// Palette represents a full Tailwind-compatible color scale.
+// Each palette holds eleven shades ordered from lightest to darkest:
+// 50, 100, 200, 300, 400, 500, 600, 700, 800, 900 and 950. Use the
+// Shade constants to index into a palette by its Tailwind shade name.
+//
+// Example:.
+//
+//	bg := theme.PaletteSlate[theme.Shade50]
+//	fg := theme.PaletteSlate[theme.Shade950]
+type Palette = [11]color.NRGBA
+
+// Shade indices for Palette values, named after the Tailwind shade they select.
+const (
+	Shade50 = iota
+	Shade100
+	Shade200
+	Shade300
+	Shade400
+	Shade500
+	Shade600
+	Shade700
+	Shade800
+	Shade900
+	Shade950
+)
+
+// Built-in palettes matching the Tailwind CSS default color scales.
+var (
+	PaletteSlate = Palette{
+		rgb(0xf8fafc), rgb(0xf1f5f9), rgb(0xe2e8f0), rgb(0xcbd5e1), rgb(0x94a3b8), rgb(0x64748b),
+		rgb(0x475569), rgb(0x334155), rgb(0x1e293b), rgb(0x0f172a), rgb(0x020617),
+	}
+	PaletteGray = Palette{
+		rgb(0xf9fafb), rgb(0xf3f4f6), rgb(0xe5e7eb), rgb(0xd1d5db), rgb(0x9ca3af), rgb(0x6b7280),
+		rgb(0x4b5563), rgb(0x374151), rgb(0x1f2937), rgb(0x111827), rgb(0x030712),
+	}
+	PaletteZinc = Palette{
+		rgb(0xfafafa), rgb(0xf4f4f5), rgb(0xe4e4e7), rgb(0xd4d4d8), rgb(0xa1a1aa), rgb(0x71717a),
+		rgb(0x52525b), rgb(0x3f3f46), rgb(0x27272a), rgb(0x18181b), rgb(0x09090b),
+	}
+	PaletteNeutral = Palette{
+		rgb(0xfafafa), rgb(0xf5f5f5), rgb(0xe5e5e5), rgb(0xd4d4d4), rgb(0xa3a3a3), rgb(0x737373),
+		rgb(0x525252), rgb(0x404040), rgb(0x262626), rgb(0x171717), rgb(0x0a0a0a),
+	}
+	PaletteStone = Palette{
+		rgb(0xfafaf9), rgb(0xf5f5f4), rgb(0xe7e5e4), rgb(0xd6d3d1), rgb(0xa8a29e), rgb(0x78716c),
+		rgb(0x57534e), rgb(0x44403c), rgb(0x292524), rgb(0x1c1917), rgb(0x0c0a09),
+	}
+	PaletteRed = Palette{
+		rgb(0xfef2f2), rgb(0xfee2e2), rgb(0xfecaca), rgb(0xfca5a5), rgb(0xf87171), rgb(0xef4444),
+		rgb(0xdc2626), rgb(0xb91c1c), rgb(0x991b1b), rgb(0x7f1d1d), rgb(0x450a0a),
+	}
+	PaletteOrange = Palette{
+		rgb(0xfff7ed), rgb(0xffedd5), rgb(0xfed7aa), rgb(0xfdba74), rgb(0xfb923c), rgb(0xf97316),
+		rgb(0xea580c), rgb(0xc2410c), rgb(0x9a3412), rgb(0x7c2d12), rgb(0x431407),
+	}
+	PaletteAmber = Palette{
+		rgb(0xfffbeb), rgb(0xfef3c7), rgb(0xfde68a), rgb(0xfcd34d), rgb(0xfbbf24), rgb(0xf59e0b),
+		rgb(0xd97706), rgb(0xb45309), rgb(0x92400e), rgb(0x78350f), rgb(0x451a03),
+	}
+	PaletteYellow = Palette{
+		rgb(0xfefce8), rgb(0xfef9c3), rgb(0xfef08a), rgb(0xfde047), rgb(0xfacc15), rgb(0xeab308),
+		rgb(0xca8a04), rgb(0xa16207), rgb(0x854d0e), rgb(0x713f12), rgb(0x422006),
+	}
+	PaletteGreen = Palette{
+		rgb(0xf0fdf4), rgb(0xdcfce7), rgb(0xbbf7d0), rgb(0x86efac), rgb(0x4ade80), rgb(0x22c55e),
+		rgb(0x16a34a), rgb(0x15803d), rgb(0x166534), rgb(0x14532d), rgb(0x052e16),
+	}
+	PaletteBlue = Palette{
+		rgb(0xeff6ff), rgb(0xdbeafe), rgb(0xbfdbfe), rgb(0x93c5fd), rgb(0x60a5fa), rgb(0x3b82f6),
+		rgb(0x2563eb), rgb(0x1d4ed8), rgb(0x1e40af), rgb(0x1e3a8a), rgb(0x172554),
+	}
+	PaletteViolet = Palette{
+		rgb(0xf5f3ff), rgb(0xede9fe), rgb(0xddd6fe), rgb(0xc4b5fd), rgb(0xa78bfa), rgb(0x8b5cf6),
+		rgb(0x7c3aed), rgb(0x6d28d9), rgb(0x5b21b6), rgb(0x4c1d95), rgb(0x2e1065),
+	}
+	PaletteRose = Palette{
+		rgb(0xfff1f2), rgb(0xffe4e6), rgb(0xfecdd3), rgb(0xfda4af), rgb(0xfb7185), rgb(0xf43f5e),
+		rgb(0xe11d48), rgb(0xbe123c), rgb(0x9f1239), rgb(0x881337), rgb(0x4c0519),
+	}
+)
+
+// LightColorSchemeFromPalette builds a light color scheme from Tailwind palettes.
+// The neutral palette drives backgrounds, foregrounds, borders and muted content,.
+// the primary palette drives brand colors and the focus ring, and the destructive
+// palette drives error and danger states.
+//
+// Example:.
+//
+//	cs := theme.LightColorSchemeFromPalette(theme.PaletteSlate, theme.PaletteBlue, theme.PaletteRed)
+//
+//nolint:dupl // Light and dark palette schemes are intentionally similar but different
+func LightColorSchemeFromPalette(neutral, primary, destructive Palette) ColorScheme {
+	return ColorScheme{
+		Background:    neutral[Shade50],
+		Foreground:    neutral[Shade950],
+		Card:          neutral[Shade50],
+		CardFg:        neutral[Shade950],
+		Popover:       neutral[Shade50],
+		PopoverFg:     neutral[Shade950],
+		Primary:       primary[Shade900],
+		PrimaryFg:     primary[Shade50],
+		Secondary:     neutral[Shade100],
+		SecondaryFg:   neutral[Shade900],
+		Muted:         neutral[Shade100],
+		MutedFg:       neutral[Shade500],
+		Accent:        neutral[Shade100],
+		AccentFg:      neutral[Shade900],
+		Destructive:   destructive[Shade500],
+		DestructiveFg: neutral[Shade50],
+		Amber:         PaletteAmber[Shade500],
+		AmberFg:       PaletteAmber[Shade950],
+		Blue:          PaletteBlue[Shade600],
+		BlueFg:        PaletteBlue[Shade50],
+		Green:         PaletteGreen[Shade600],
+		GreenFg:       PaletteGreen[Shade50],
+		Border:        neutral[Shade200],
+		Input:         neutral[Shade200],
+		Ring:          primary[Shade900],
+	}
+}
+
+// DarkColorSchemeFromPalette builds a dark color scheme from Tailwind palettes.
+// It mirrors LightColorSchemeFromPalette, selecting the darkest neutral shades.
+// for surfaces and the lightest for text, with a deep destructive shade that
+// stays readable against dark backgrounds.
+//
+//nolint:dupl // Light and dark palette schemes are intentionally similar but different
+func DarkColorSchemeFromPalette(neutral, primary, destructive Palette) ColorScheme {
+	return ColorScheme{
+		Background:    neutral[Shade950],
+		Foreground:    neutral[Shade50],
+		Card:          neutral[Shade950],
+		CardFg:        neutral[Shade50],
+		Popover:       neutral[Shade950],
+		PopoverFg:     neutral[Shade50],
+		Primary:       primary[Shade50],
+		PrimaryFg:     primary[Shade900],
+		Secondary:     neutral[Shade800],
+		SecondaryFg:   neutral[Shade50],
+		Muted:         neutral[Shade800],
+		MutedFg:       neutral[Shade400],
+		Accent:        neutral[Shade800],
+		AccentFg:      neutral[Shade50],
+		Destructive:   destructive[Shade900],
+		DestructiveFg: neutral[Shade50],
+		Amber:         PaletteAmber[Shade500],
+		AmberFg:       PaletteAmber[Shade950],
+		Blue:          PaletteBlue[Shade400],
+		BlueFg:        PaletteBlue[Shade950],
+		Green:         PaletteGreen[Shade400],
+		GreenFg:       PaletteGreen[Shade950],
+		Border:        neutral[Shade800],
+		Input:         neutral[Shade800],
+		Ring:          neutral[Shade300],
+	}
+}
+
+// rgb converts a 0xRRGGBB value into an opaque NRGBA color.
+func rgb(hex uint32) color.NRGBA {
+	return color.NRGBA{
+		R: uint8(hex >> 16),
+		G: uint8(hex >> 8),
+		B: uint8(hex),
+		A: 255,
+	}
+}
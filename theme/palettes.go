@@ -0,0 +1,167 @@
+package theme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// Additional built-in palettes matching the Tailwind CSS default color scales.
+var (
+	PaletteLime = Palette{
+		rgb(0xf7fee7), rgb(0xecfccb), rgb(0xd9f99d), rgb(0xbef264), rgb(0xa3e635), rgb(0x84cc16),
+		rgb(0x65a30d), rgb(0x4d7c0f), rgb(0x3f6212), rgb(0x365314), rgb(0x1a2e05),
+	}
+	PaletteEmerald = Palette{
+		rgb(0xecfdf5), rgb(0xd1fae5), rgb(0xa7f3d0), rgb(0x6ee7b7), rgb(0x34d399), rgb(0x10b981),
+		rgb(0x059669), rgb(0x047857), rgb(0x065f46), rgb(0x064e3b), rgb(0x022c22),
+	}
+	PaletteTeal = Palette{
+		rgb(0xf0fdfa), rgb(0xccfbf1), rgb(0x99f6e4), rgb(0x5eead4), rgb(0x2dd4bf), rgb(0x14b8a6),
+		rgb(0x0d9488), rgb(0x0f766e), rgb(0x115e59), rgb(0x134e4a), rgb(0x042f2e),
+	}
+	PaletteCyan = Palette{
+		rgb(0xecfeff), rgb(0xcffafe), rgb(0xa5f3fc), rgb(0x67e8f9), rgb(0x22d3ee), rgb(0x06b6d4),
+		rgb(0x0891b2), rgb(0x0e7490), rgb(0x155e75), rgb(0x164e63), rgb(0x083344),
+	}
+	PaletteSky = Palette{
+		rgb(0xf0f9ff), rgb(0xe0f2fe), rgb(0xbae6fd), rgb(0x7dd3fc), rgb(0x38bdf8), rgb(0x0ea5e9),
+		rgb(0x0284c7), rgb(0x0369a1), rgb(0x075985), rgb(0x0c4a6e), rgb(0x082f49),
+	}
+	PaletteIndigo = Palette{
+		rgb(0xeef2ff), rgb(0xe0e7ff), rgb(0xc7d2fe), rgb(0xa5b4fc), rgb(0x818cf8), rgb(0x6366f1),
+		rgb(0x4f46e5), rgb(0x4338ca), rgb(0x3730a3), rgb(0x312e81), rgb(0x1e1b4b),
+	}
+	PalettePurple = Palette{
+		rgb(0xfaf5ff), rgb(0xf3e8ff), rgb(0xe9d5ff), rgb(0xd8b4fe), rgb(0xc084fc), rgb(0xa855f7),
+		rgb(0x9333ea), rgb(0x7e22ce), rgb(0x6b21a8), rgb(0x581c87), rgb(0x3b0764),
+	}
+	PaletteFuchsia = Palette{
+		rgb(0xfdf4ff), rgb(0xfae8ff), rgb(0xf5d0fe), rgb(0xf0abfc), rgb(0xe879f9), rgb(0xd946ef),
+		rgb(0xc026d3), rgb(0xa21caf), rgb(0x86198f), rgb(0x701a75), rgb(0x4a044e),
+	}
+	PalettePink = Palette{
+		rgb(0xfdf2f8), rgb(0xfce7f3), rgb(0xfbcfe8), rgb(0xf9a8d4), rgb(0xf472b6), rgb(0xec4899),
+		rgb(0xdb2777), rgb(0xbe185d), rgb(0x9d174d), rgb(0x831843), rgb(0x500724),
+	}
+)
+
+// neutralPalettes are the palettes shadcn/ui offers as base colors.
+var neutralPalettes = map[string]Palette{
+	"slate":   PaletteSlate,
+	"gray":    PaletteGray,
+	"zinc":    PaletteZinc,
+	"neutral": PaletteNeutral,
+	"stone":   PaletteStone,
+}
+
+// accentPalettes are the palettes shadcn/ui offers as theme colors.
+var accentPalettes = map[string]Palette{
+	"red":     PaletteRed,
+	"rose":    PaletteRose,
+	"orange":  PaletteOrange,
+	"amber":   PaletteAmber,
+	"yellow":  PaletteYellow,
+	"lime":    PaletteLime,
+	"green":   PaletteGreen,
+	"emerald": PaletteEmerald,
+	"teal":    PaletteTeal,
+	"cyan":    PaletteCyan,
+	"sky":     PaletteSky,
+	"blue":    PaletteBlue,
+	"indigo":  PaletteIndigo,
+	"violet":  PaletteViolet,
+	"purple":  PalettePurple,
+	"fuchsia": PaletteFuchsia,
+	"pink":    PalettePink,
+}
+
+// PaletteByName returns the built-in palette with the given name, such as
+// "slate" or "blue". Names are case-insensitive.
+func PaletteByName(name string) (Palette, bool) {
+	name = strings.ToLower(name)
+	if p, ok := neutralPalettes[name]; ok {
+		return p, true
+	}
+	p, ok := accentPalettes[name]
+	return p, ok
+}
+
+// PaletteNames returns the names of all built-in palettes in alphabetical order.
+func PaletteNames() []string {
+	names := make([]string, 0, len(neutralPalettes)+len(accentPalettes))
+	for name := range neutralPalettes {
+		names = append(names, name)
+	}
+	for name := range accentPalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewFromPalette creates a theme from a neutral and an accent palette name.
+// The neutral palette provides backgrounds, text, borders and inputs. The
+// accent palette provides the primary and ring colors, following shadcn/ui:
+// shade 600 in light mode and 500 in dark mode, with dark text on light
+// accents such as yellow. When the accent is itself a neutral palette, the
+// primary color is the near-black (light) or near-white (dark) neutral shade.
+//
+// Example:.
+//
+//	th, err := theme.NewFromPalette("slate", "blue")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func NewFromPalette(neutralName, accentName string) (*Theme, error) {
+	neutral, ok := neutralPalettes[strings.ToLower(neutralName)]
+	if !ok {
+		return nil, fmt.Errorf("unknown neutral palette %q", neutralName)
+	}
+
+	accent, ok := PaletteByName(accentName)
+	if !ok {
+		return nil, fmt.Errorf("unknown accent palette %q", accentName)
+	}
+
+	light := LightColorSchemeFromPalette(neutral, accent, PaletteRed)
+	dark := DarkColorSchemeFromPalette(neutral, accent, PaletteRed)
+
+	if _, isNeutral := neutralPalettes[strings.ToLower(accentName)]; !isNeutral {
+		applyAccent(&light, accent, Shade600)
+		applyAccent(&dark, accent, Shade500)
+	}
+
+	return &Theme{
+		Colors:     light,
+		DarkColors: dark,
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     false,
+		Locale:     i18n.LocaleEN,
+	}, nil
+}
+
+// NewFromColorPalette creates a theme from a single shadcn/ui palette name.
+// Neutral names ("zinc", "slate", ...) produce a monochrome theme; accent names
+// ("blue", "rose", ...) are combined with the zinc neutral palette.
+func NewFromColorPalette(name string) (*Theme, error) {
+	if _, ok := neutralPalettes[strings.ToLower(name)]; ok {
+		return NewFromPalette(name, name)
+	}
+	return NewFromPalette("zinc", name)
+}
+
+// applyAccent sets the primary and ring colors of a scheme from an accent shade.
+func applyAccent(cs *ColorScheme, accent Palette, shade int) {
+	cs.Primary = accent[shade]
+	cs.PrimaryFg = accent[Shade50]
+	if colorutil.IsLight(cs.Primary) {
+		cs.PrimaryFg = accent[Shade950]
+	}
+	cs.Ring = accent[shade]
+}
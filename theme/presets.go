@@ -0,0 +1,153 @@
+package theme
+
+import (
+	"github.com/bnema/gio-shadcn/utils"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// WarmDarkAccents is the accent palette of the warm themes. Its shades drive
+// the primary and ring colors of WarmDark and of NewWarm's light scheme.
+var WarmDarkAccents = PaletteAmber
+
+// WarmDark returns a dark color scheme with brown-tinted surfaces and amber
+// accents, for users who find the default zinc dark scheme too cold.
+//
+//nolint:dupl // Preset color schemes are intentionally similar but different
+func WarmDark() ColorScheme {
+	return ColorScheme{
+		Background:    utils.MustParseHex("#0f0d0b"),
+		Foreground:    utils.MustParseHex("#f5f0eb"),
+		Card:          utils.MustParseHex("#171411"),
+		CardFg:        utils.MustParseHex("#f5f0eb"),
+		Popover:       utils.MustParseHex("#171411"),
+		PopoverFg:     utils.MustParseHex("#f5f0eb"),
+		Primary:       WarmDarkAccents[Shade500],
+		PrimaryFg:     utils.MustParseHex("#1c1917"), // stone-900
+		Secondary:     utils.MustParseHex("#26211c"),
+		SecondaryFg:   utils.MustParseHex("#f5f0eb"),
+		Muted:         utils.MustParseHex("#26211c"),
+		MutedFg:       utils.MustParseHex("#a8a29e"), // stone-400
+		Accent:        utils.MustParseHex("#2e2822"),
+		AccentFg:      utils.MustParseHex("#f5f0eb"),
+		Destructive:   utils.MustParseHex("#7f1d1d"), // red-900
+		DestructiveFg: utils.MustParseHex("#f5f0eb"),
+		Amber:         PaletteAmber[Shade500],
+		AmberFg:       PaletteAmber[Shade950],
+		Blue:          PaletteBlue[Shade400],
+		BlueFg:        PaletteBlue[Shade950],
+		Green:         PaletteGreen[Shade400],
+		GreenFg:       PaletteGreen[Shade950],
+		Border:        utils.MustParseHex("#2e2822"),
+		Input:         utils.MustParseHex("#2e2822"),
+		Ring:          WarmDarkAccents[Shade500],
+	}
+}
+
+// CoolLight returns a light color scheme with slightly blue-tinted surfaces
+// and blue accents.
+//
+//nolint:dupl // Preset color schemes are intentionally similar but different
+func CoolLight() ColorScheme {
+	return ColorScheme{
+		Background:    utils.MustParseHex("#f8f9fb"),
+		Foreground:    utils.MustParseHex("#0f172a"), // slate-900
+		Card:          utils.MustParseHex("#ffffff"),
+		CardFg:        utils.MustParseHex("#0f172a"), // slate-900
+		Popover:       utils.MustParseHex("#ffffff"),
+		PopoverFg:     utils.MustParseHex("#0f172a"), // slate-900
+		Primary:       utils.MustParseHex("#2563eb"), // blue-600
+		PrimaryFg:     utils.MustParseHex("#f8fafc"), // slate-50
+		Secondary:     utils.MustParseHex("#eef1f6"),
+		SecondaryFg:   utils.MustParseHex("#1e293b"), // slate-800
+		Muted:         utils.MustParseHex("#eef1f6"),
+		MutedFg:       utils.MustParseHex("#64748b"), // slate-500
+		Accent:        utils.MustParseHex("#e6ebf3"),
+		AccentFg:      utils.MustParseHex("#1e293b"), // slate-800
+		Destructive:   utils.MustParseHex("#ef4444"), // red-500
+		DestructiveFg: utils.MustParseHex("#f8fafc"), // slate-50
+		Amber:         PaletteAmber[Shade500],
+		AmberFg:       PaletteAmber[Shade950],
+		Blue:          PaletteBlue[Shade600],
+		BlueFg:        PaletteBlue[Shade50],
+		Green:         PaletteGreen[Shade600],
+		GreenFg:       PaletteGreen[Shade50],
+		Border:        utils.MustParseHex("#dde3ec"),
+		Input:         utils.MustParseHex("#dde3ec"),
+		Ring:          utils.MustParseHex("#2563eb"), // blue-600
+	}
+}
+
+// NewWarm creates a theme that starts in WarmDark. Toggling switches to a
+// stone light scheme with the same amber accents.
+//
+// Example:.
+//
+//	th := theme.NewWarm()
+func NewWarm() *Theme {
+	light := LightColorSchemeFromPalette(PaletteStone, PaletteStone, PaletteRed)
+	applyAccent(&light, WarmDarkAccents, Shade600)
+
+	return &Theme{
+		Colors:     WarmDark(),
+		DarkColors: light,
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     true,
+		Locale:     i18n.LocaleEN,
+	}
+}
+
+// NewCool creates a theme that starts in CoolLight. Toggling switches to a
+// slate dark scheme with blue accents.
+//
+// Example:.
+//
+//	th := theme.NewCool()
+func NewCool() *Theme {
+	dark := DarkColorSchemeFromPalette(PaletteSlate, PaletteSlate, PaletteRed)
+	applyAccent(&dark, PaletteBlue, Shade500)
+
+	return &Theme{
+		Colors:     CoolLight(),
+		DarkColors: dark,
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     false,
+		Locale:     i18n.LocaleEN,
+	}
+}
+
+// NewSlate creates a light theme from the Tailwind slate palette, a blue-gray
+// alternative to the default zinc.
+//
+// Example:.
+//
+//	th := theme.NewSlate()
+func NewSlate() *Theme {
+	return newNeutralTheme(PaletteSlate)
+}
+
+// NewStone creates a light theme from the Tailwind stone palette, a warm gray
+// alternative to the default zinc.
+//
+// Example:.
+//
+//	th := theme.NewStone()
+func NewStone() *Theme {
+	return newNeutralTheme(PaletteStone)
+}
+
+// newNeutralTheme creates a light theme whose colors all come from neutral.
+func newNeutralTheme(neutral Palette) *Theme {
+	return &Theme{
+		Colors:     LightColorSchemeFromPalette(neutral, neutral, PaletteRed),
+		DarkColors: DarkColorSchemeFromPalette(neutral, neutral, PaletteRed),
+		Typography: DefaultTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     DefaultRadius(),
+		IsDark:     false,
+		Locale:     i18n.LocaleEN,
+	}
+}
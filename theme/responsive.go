@@ -0,0 +1,120 @@
+package theme
+
+import (
+	"reflect"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+)
+
+// Default breakpoints, matching Tailwind's screen widths.
+const (
+	BreakpointSM unit.Dp = 640
+	BreakpointMD unit.Dp = 768
+	BreakpointLG unit.Dp = 1024
+	BreakpointXL unit.Dp = 1280
+)
+
+// ResponsiveTheme selects a theme based on the available width, so small
+// windows can use tighter spacing and smaller text. Each breakpoint theme
+// applies from its width upwards; Base applies below SM. A nil breakpoint
+// theme falls back to the next narrower one.
+//
+// Example usage:.
+//
+//	rt := theme.NewResponsiveTheme(theme.New())
+//	rt.Layout(gtx, func(th *theme.Theme) layout.Dimensions {
+//		return card.Layout(gtx, th)
+//	})
+type ResponsiveTheme struct {
+	// Breakpoint widths
+	SM, MD, LG, XL unit.Dp
+
+	// Themes for each breakpoint
+	Base    *Theme
+	SMTheme *Theme
+	MDTheme *Theme
+	LGTheme *Theme
+	XLTheme *Theme
+}
+
+// NewResponsiveTheme creates a responsive theme with the default breakpoints.
+// base is used from MD upwards, base.Scale(0.85) between SM and MD, and
+// base.Scale(0.75) below SM.
+func NewResponsiveTheme(base *Theme) *ResponsiveTheme {
+	return &ResponsiveTheme{
+		SM:      BreakpointSM,
+		MD:      BreakpointMD,
+		LG:      BreakpointLG,
+		XL:      BreakpointXL,
+		Base:    base.Scale(0.75),
+		SMTheme: base.Scale(0.85),
+		MDTheme: base,
+	}
+}
+
+// ForWidth returns the theme for a window width in dp.
+func (r *ResponsiveTheme) ForWidth(w int) *Theme {
+	width := unit.Dp(w)
+	breakpoints := []struct {
+		min unit.Dp
+		th  *Theme
+	}{
+		{r.XL, r.XLTheme},
+		{r.LG, r.LGTheme},
+		{r.MD, r.MDTheme},
+		{r.SM, r.SMTheme},
+	}
+
+	for _, bp := range breakpoints {
+		if bp.th != nil && width >= bp.min {
+			return bp.th
+		}
+	}
+	return r.Base
+}
+
+// Layout calls fn with the theme for the maximum width of gtx.
+func (r *ResponsiveTheme) Layout(gtx layout.Context, fn func(th *Theme) layout.Dimensions) layout.Dimensions {
+	pxPerDp := gtx.Metric.PxPerDp
+	if pxPerDp <= 0 {
+		pxPerDp = 1
+	}
+	return fn(r.ForWidth(int(float32(gtx.Constraints.Max.X) / pxPerDp)))
+}
+
+// ToggleDark switches every breakpoint theme between light and dark mode.
+func (r *ResponsiveTheme) ToggleDark() {
+	seen := make(map[*Theme]bool)
+	for _, th := range []*Theme{r.Base, r.SMTheme, r.MDTheme, r.LGTheme, r.XLTheme} {
+		if th == nil || seen[th] {
+			continue
+		}
+		seen[th] = true
+		th.ToggleDark()
+	}
+}
+
+// Scale returns a copy of the theme with spacing and font sizes multiplied by
+// factor. Colors, radius and line heights are unchanged.
+//
+// Example:.
+//
+//	compact := th.Scale(0.85)
+func (t *Theme) Scale(factor float32) *Theme {
+	th := *t
+
+	spacing := reflect.ValueOf(&th.Spacing).Elem()
+	for i := range spacing.NumField() {
+		if f := spacing.Field(i); f.Type() == reflect.TypeOf(unit.Dp(0)) {
+			f.SetFloat(f.Float() * float64(factor))
+		}
+	}
+
+	for _, f := range fontSizeFields {
+		size := f.field(&th.Typography)
+		*size = unit.Sp(float32(*size) * factor)
+	}
+
+	return &th
+}
@@ -129,3 +129,55 @@ func DefaultRadius() RadiusScale {
 		RadiusFull: unit.Dp(9999),
 	}
 }
+
+// RadiusPreset names a whole radius scale, for brands that want sharper or
+// rounder corners than the default.
+type RadiusPreset string
+
+// Available radius presets.
+const (
+	// RadiusPresetSharp removes rounding from every corner.
+	RadiusPresetSharp RadiusPreset = "sharp"
+	// RadiusPresetDefault is DefaultRadius.
+	RadiusPresetDefault RadiusPreset = "default"
+	// RadiusPresetRounded doubles every default radius.
+	RadiusPresetRounded RadiusPreset = "rounded"
+	// RadiusPresetPill rounds every corner fully, for pill-shaped elements.
+	RadiusPresetPill RadiusPreset = "pill"
+)
+
+// Scale returns the radius scale of the preset, and false for unknown
+// presets. RadiusNone stays 0 in every preset.
+func (p RadiusPreset) Scale() (RadiusScale, bool) {
+	r := DefaultRadius()
+	switch p {
+	case RadiusPresetDefault:
+		return r, true
+	case RadiusPresetSharp:
+		return RadiusScale{}, true
+	case RadiusPresetRounded:
+		return RadiusScale{
+			RadiusSM:   2 * r.RadiusSM,
+			RadiusBase: 2 * r.RadiusBase,
+			RadiusMD:   2 * r.RadiusMD,
+			RadiusLG:   2 * r.RadiusLG,
+			RadiusXL:   2 * r.RadiusXL,
+			Radius2XL:  2 * r.Radius2XL,
+			Radius3XL:  2 * r.Radius3XL,
+			RadiusFull: r.RadiusFull,
+		}, true
+	case RadiusPresetPill:
+		return RadiusScale{
+			RadiusSM:   r.RadiusFull,
+			RadiusBase: r.RadiusFull,
+			RadiusMD:   r.RadiusFull,
+			RadiusLG:   r.RadiusFull,
+			RadiusXL:   r.RadiusFull,
+			Radius2XL:  r.RadiusFull,
+			Radius3XL:  r.RadiusFull,
+			RadiusFull: r.RadiusFull,
+		}, true
+	default:
+		return r, false
+	}
+}
@@ -0,0 +1,112 @@
+package theme
+
+import (
+	"image/color"
+	"os"
+
+	"gioui.org/font"
+	"gioui.org/font/gofont"
+	"gioui.org/unit"
+	"github.com/bnema/gio-shadcn/utils/i18n"
+)
+
+// testEnv is the environment variable that enables test mode.
+const testEnv = "GGTEST"
+
+// Pure colors of the test theme.
+var (
+	testBlack  = color.NRGBA{A: 255}
+	testWhite  = color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	testBlue   = color.NRGBA{B: 255, A: 255}
+	testRed    = color.NRGBA{R: 255, A: 255}
+	testYellow = color.NRGBA{R: 255, G: 255, A: 255}
+	testGreen  = color.NRGBA{G: 255, A: 255}
+)
+
+// TestTheme returns a deterministic theme for golden-image tests. It uses pure
+// black, white, blue and red colors, square corners, the Go Mono font and a
+// single 14sp font size, which keeps rendering differences between platforms
+// to a minimum. The dark scheme swaps black and white.
+//
+// Example:.
+//
+//	th := theme.TestTheme()
+//	dims := btn.Layout(gtx, th)
+func TestTheme() *Theme {
+	return &Theme{
+		Colors:     testColorScheme(testWhite, testBlack),
+		DarkColors: testColorScheme(testBlack, testWhite),
+		Typography: testTypography(),
+		Spacing:    DefaultSpacing(),
+		Radius:     RadiusScale{},
+		IsDark:     false,
+		Locale:     i18n.LocaleEN,
+	}
+}
+
+// IsTest reports whether test mode is enabled with GGTEST=1.
+func IsTest() bool {
+	return os.Getenv(testEnv) == "1"
+}
+
+// Auto returns TestTheme in test mode and New otherwise.
+//
+// Example:.
+//
+//	// GGTEST=1 go test ./...
+//	th := theme.Auto()
+func Auto() *Theme {
+	if IsTest() {
+		return TestTheme()
+	}
+	return New()
+}
+
+// testColorScheme returns a scheme that uses only bg, fg and pure accents.
+func testColorScheme(bg, fg color.NRGBA) ColorScheme {
+	return ColorScheme{
+		Background:    bg,
+		Foreground:    fg,
+		Card:          bg,
+		CardFg:        fg,
+		Popover:       bg,
+		PopoverFg:     fg,
+		Primary:       testBlue,
+		PrimaryFg:     testWhite,
+		Secondary:     bg,
+		SecondaryFg:   fg,
+		Muted:         bg,
+		MutedFg:       fg,
+		Accent:        bg,
+		AccentFg:      fg,
+		Destructive:   testRed,
+		DestructiveFg: testWhite,
+		Amber:         testYellow,
+		AmberFg:       testBlack,
+		Blue:          testBlue,
+		BlueFg:        testWhite,
+		Green:         testGreen,
+		GreenFg:       testBlack,
+		Border:        fg,
+		Input:         fg,
+		Ring:          testBlue,
+	}
+}
+
+// testTypography returns the default typography with every font family set
+// to Go Mono and every size set to 14sp.
+func testTypography() Typography {
+	var mono []font.Face
+	for _, face := range gofont.Collection() {
+		if face.Font.Typeface == "Go Mono" {
+			mono = append(mono, face.Face)
+		}
+	}
+
+	size := unit.Sp(14)
+	t := DefaultTypography()
+	t.FontSans, t.FontMono, t.FontSerif = mono, mono, mono
+	t.FontSizeXS, t.FontSizeSM, t.FontSizeBase, t.FontSizeLG = size, size, size, size
+	t.FontSizeXL, t.FontSize2XL, t.FontSize3XL, t.FontSize4XL = size, size, size, size
+	return t
+}
@@ -15,6 +15,10 @@ Create a dark theme:
 
 	th := theme.NewDark()
 
+Start from a preset with a different tint:
+
+	th := theme.NewWarm()  // Warm dark, or NewCool, NewSlate, NewStone
+
 Toggle between light and dark modes:
 
 	th.ToggleDark()
@@ -25,7 +29,8 @@ A theme consists of:
 • Colors - Primary, secondary, background colors with light/dark variants
 • Typography - Font sizes, weights, and styling
 • Spacing - Consistent spacing scale for layout
-• Radius - Border radius scale for rounded corners
+• Radius - Border radius scale for rounded corners, with sharp, rounded and pill presets
+• Locale - Translations for text that components render themselves
 
 # Component Integration
 
@@ -34,10 +39,32 @@ All gio-shadcn components accept a *Theme parameter in their Layout method:
 	button := button.New(button.Config{Text: "Click me"})
 	dims := button.Layout(gtx, th)
 
+# Responsive Themes
+
+ResponsiveTheme picks a scaled theme for the available width:
+
+	rt := theme.NewResponsiveTheme(theme.New())
+	rt.Layout(gtx, func(th *theme.Theme) layout.Dimensions {
+		return content.Layout(gtx, th)
+	})
+
+# Accessibility
+
+Apply a high-contrast, reduced-motion or large-print preset, or follow the
+operating system settings:
+
+	mode := theme.AccessibilityFromSystem()
+	theme.ApplyAccessibility(mode)
+	th := theme.New(theme.WithAccessibility(mode))
+
+WithAccessibility only changes the theme it returns. ApplyAccessibility sets
+the global animation switch, so call it once for the whole application.
+
 # Customization
 
 Themes can be customized programmatically or loaded from JSON configuration files.
 See the theme JSON format in the project's theme.json example file.
+Colors written in CSS oklch() notation can be used directly with BuildFromOklch.
 */
 package theme
 
@@ -46,6 +73,7 @@ import (
 	"image/color"
 
 	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/utils/i18n"
 )
 
 // Theme represents the complete theme configuration for gio-shadcn components.
@@ -65,6 +93,35 @@ type Theme struct {
 	Spacing    SpacingScale
 	Radius     RadiusScale
 	IsDark     bool
+
+	// Registry holds theme-specific custom variants. It is consulted before
+	// the global DefaultRegistry, so custom variants don't leak across themes.
+	Registry *VariantRegistry
+
+	// Locale translates the text components render themselves. A nil locale
+	// uses English.
+	Locale *i18n.Locale
+
+	// Accessibility is the mode applied by WithAccessibility.
+	Accessibility AccessibilityMode
+
+	// BorderWidthScale multiplies the border width of variants. Zero means 1.
+	BorderWidthScale float32
+}
+
+// Option is a functional option for configuring themes created by New and
+// NewDark.
+type Option func(*Theme)
+
+// WithLocale sets the locale used for component text.
+//
+// Example:.
+//
+//	th := theme.New(theme.WithLocale(i18n.LocaleDE))
+func WithLocale(locale *i18n.Locale) Option {
+	return func(t *Theme) {
+		t.Locale = locale
+	}
 }
 
 // New creates a new theme with light colors by default.
@@ -76,15 +133,22 @@ type Theme struct {
 //
 //	th := theme.New()
 //	// Theme is ready to use with all components
-func New() *Theme {
-	return &Theme{
+func New(options ...Option) *Theme {
+	th := &Theme{
 		Colors:     LightColorScheme(),
 		DarkColors: DarkColorScheme(),
 		Typography: DefaultTypography(),
 		Spacing:    DefaultSpacing(),
 		Radius:     DefaultRadius(),
 		IsDark:     false,
+		Locale:     i18n.LocaleEN,
+	}
+
+	for _, option := range options {
+		option(th)
 	}
+
+	return th
 }
 
 // NewDark creates a new theme with dark colors by default.
@@ -95,15 +159,71 @@ func New() *Theme {
 //
 //	th := theme.NewDark()
 //	// Theme starts in dark mode
-func NewDark() *Theme {
-	return &Theme{
+func NewDark(options ...Option) *Theme {
+	th := &Theme{
 		Colors:     DarkColorScheme(),
 		DarkColors: LightColorScheme(),
 		Typography: DefaultTypography(),
 		Spacing:    DefaultSpacing(),
 		Radius:     DefaultRadius(),
 		IsDark:     true,
+		Locale:     i18n.LocaleEN,
+	}
+
+	for _, option := range options {
+		option(th)
 	}
+
+	return th
+}
+
+// NewWithRadiusPreset creates a light theme like New with the radius scale of
+// preset. Unknown presets keep the default radius.
+//
+// Example:.
+//
+//	th := theme.NewWithRadiusPreset(theme.RadiusPresetPill)
+func NewWithRadiusPreset(preset RadiusPreset, options ...Option) *Theme {
+	th := New(options...)
+	if scale, ok := preset.Scale(); ok {
+		th.Radius = scale
+	}
+	return th
+}
+
+// WithCustomRadius replaces the radius scale of the theme and returns the
+// theme, for chaining after a constructor.
+//
+// Example:.
+//
+//	scale, _ := theme.RadiusPresetRounded.Scale()
+//	th := theme.NewDark().WithCustomRadius(scale)
+func (t *Theme) WithCustomRadius(scale RadiusScale) *Theme {
+	t.Radius = scale
+	return t
+}
+
+// ButtonVariant returns the button variant configuration for the active colors.
+// The theme Registry takes precedence over DefaultRegistry and built-in variants.
+func (t *Theme) ButtonVariant(variant Variant) VariantConfig {
+	if config, ok := t.Registry.Resolve(variant, &t.Colors); ok {
+		return t.scaleBorder(config)
+	}
+
+	return t.scaleBorder(GetButtonVariant(variant, &t.Colors))
+}
+
+// CardVariant returns the card variant configuration for the active colors.
+func (t *Theme) CardVariant(variant Variant) VariantConfig {
+	return t.scaleBorder(GetCardVariant(variant, &t.Colors))
+}
+
+// scaleBorder applies BorderWidthScale to config.
+func (t *Theme) scaleBorder(config VariantConfig) VariantConfig {
+	if t.BorderWidthScale > 0 {
+		config.BorderWidth *= t.BorderWidthScale
+	}
+	return config
 }
 
 // ToggleDark switches between light and dark color schemes.
@@ -129,12 +249,45 @@ func (t *Theme) ToggleDark() {
 	}
 }
 
+// WithFallback returns a copy of primary in which every color with zero alpha
+// is taken from fallback. Light colors fall back to fallback's light colors and
+// dark colors to its dark colors, whichever mode each theme is in. This lets
+// partial theme files override only some colors.
+//
+// Example:.
+//
+//	th := theme.WithFallback(loaded, theme.New())
+func WithFallback(primary, fallback *Theme) *Theme {
+	th := *primary
+
+	// Active colors of fallback in the same mode as primary
+	active, inactive := fallback.Colors, fallback.DarkColors
+	if primary.IsDark != fallback.IsDark {
+		active, inactive = inactive, active
+	}
+
+	fillColors(&th.Colors, &active)
+	fillColors(&th.DarkColors, &inactive)
+	return &th
+}
+
+// fillColors replaces the zero-alpha colors of cs with those of fallback.
+func fillColors(cs, fallback *ColorScheme) {
+	for _, f := range colorFields {
+		if c := f.field(cs); c.A == 0 {
+			*c = *f.field(fallback)
+		}
+	}
+}
+
 // ValidateTheme validates that a theme has all required fields and valid colors.
 // This function checks that the theme is not nil and that all color schemes.
 // have valid colors with non-zero alpha values. Use this when loading themes
 // from external sources or after programmatic modifications.
 //
-// Returns an error if validation fails, nil if the theme is valid.
+// Returns an error if validation fails, nil if the theme is valid. With
+// StrictValidation(true), insufficient contrast reported by ColorScheme.Validate
+// is also an error.
 //
 // Example:.
 //
@@ -142,11 +295,16 @@ func (t *Theme) ToggleDark() {
 //	if err := theme.ValidateTheme(th); err != nil {
 //		log.Printf("Theme validation failed: %v", err)
 //	}
-func ValidateTheme(t *Theme) error {
+func ValidateTheme(t *Theme, options ...ValidateOption) error {
 	if t == nil {
 		return fmt.Errorf("theme cannot be nil")
 	}
 
+	var config validateConfig
+	for _, option := range options {
+		option(&config)
+	}
+
 	// Validate color scheme
 	if err := validateColorScheme(&t.Colors); err != nil {
 		return fmt.Errorf("invalid light colors: %w", err)
@@ -156,6 +314,15 @@ func ValidateTheme(t *Theme) error {
 		return fmt.Errorf("invalid dark colors: %w", err)
 	}
 
+	if config.strict {
+		if warnings := t.Colors.Validate(); len(warnings) > 0 {
+			return fmt.Errorf("invalid light colors: %w", contrastError(warnings))
+		}
+		if warnings := t.DarkColors.Validate(); len(warnings) > 0 {
+			return fmt.Errorf("invalid dark colors: %w", contrastError(warnings))
+		}
+	}
+
 	return nil
 }
 
@@ -217,6 +384,17 @@ type Variant string
 // Sizes provide consistent scaling across the component system.
 type Size string
 
+// BorderStyle represents the line style used to draw a component border.
+type BorderStyle string
+
+// Standard border styles supported by bordered components such as Card and Input.
+const (
+	BorderSolid  BorderStyle = "solid"  // Continuous line (default)
+	BorderDashed BorderStyle = "dashed" // Evenly spaced dashes, e.g. for drop zones
+	BorderDotted BorderStyle = "dotted" // Square dots, e.g. for optional or preview states
+	BorderNone   BorderStyle = "none"   // No border
+)
+
 // Standard component variants used across the gio-shadcn component library.
 // These variants provide consistent semantic meaning and visual styling.
 const (
@@ -226,6 +404,9 @@ const (
 	VariantSecondary   Variant = "secondary"   // Less prominent than default
 	VariantGhost       Variant = "ghost"       // Minimal styling, appears on hover
 	VariantLink        Variant = "link"        // Styled like a hyperlink
+	VariantWarning     Variant = "warning"     // Cautionary messages, amber theme
+	VariantInfo        Variant = "info"        // Informational messages, blue theme
+	VariantSuccess     Variant = "success"     // Completed actions, green theme
 )
 
 // Standard component sizes used across the gio-shadcn component library.
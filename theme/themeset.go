@@ -0,0 +1,127 @@
+package theme
+
+import (
+	"sort"
+	"sync"
+)
+
+// ThemeSet manages multiple named themes, such as "light", "dark" and
+// "high-contrast", and tracks which one is active. Components keep taking a
+// *Theme; pass them ThemeSet.Current() when laying out.
+//
+// Example usage:.
+//
+//	set := theme.NewThemeSet(nil, "")
+//	set.Add("light", theme.New())
+//	set.Add("dark", theme.NewDark())
+//	set.ToggleDark()              // Switches to "dark"
+//	button.Layout(gtx, set.Current())
+//
+//nolint:revive // ThemeSet is clearer than Set at call sites
+type ThemeSet struct {
+	Themes map[string]*Theme
+
+	mu      sync.RWMutex
+	order   []string
+	current string
+}
+
+// NewThemeSet creates a theme set from named themes with initial active.
+// Themes from the map are added in name order; use Add to control the cycling
+// order of ToggleDark. When initial is empty or unknown, the first added theme
+// becomes active.
+func NewThemeSet(themes map[string]*Theme, initial string) *ThemeSet {
+	s := &ThemeSet{Themes: make(map[string]*Theme, len(themes))}
+
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.Add(name, themes[name])
+	}
+
+	if _, ok := s.Themes[initial]; ok {
+		s.current = initial
+	}
+	return s
+}
+
+// Add registers a theme under name, replacing any theme with the same name.
+// The first theme added becomes active if no theme is active yet.
+func (s *ThemeSet) Add(name string, t *Theme) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.Themes[name]; !exists {
+		s.order = append(s.order, name)
+	}
+	s.Themes[name] = t
+	if s.current == "" {
+		s.current = name
+	}
+}
+
+// Get returns the theme registered under name.
+func (s *ThemeSet) Get(name string) (*Theme, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.Themes[name]
+	return t, ok
+}
+
+// Set switches the active theme to name. Unknown names are ignored.
+func (s *ThemeSet) Set(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.Themes[name]; ok {
+		s.current = name
+	}
+}
+
+// Current returns the active theme, or nil if the set is empty.
+func (s *ThemeSet) Current() *Theme {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.Themes[s.current]
+}
+
+// CurrentName returns the name of the active theme.
+func (s *ThemeSet) CurrentName() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.current
+}
+
+// Names returns the theme names in the order they were added.
+func (s *ThemeSet) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]string(nil), s.order...)
+}
+
+// ToggleDark cycles to the next theme in the order the themes were added,
+// wrapping around after the last one. With exactly a light and a dark theme
+// this behaves like Theme.ToggleDark. Call window.Invalidate() afterwards to
+// force a UI redraw.
+func (s *ThemeSet) ToggleDark() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.order) == 0 {
+		return
+	}
+	for i, name := range s.order {
+		if name == s.current {
+			s.current = s.order[(i+1)%len(s.order)]
+			return
+		}
+	}
+	s.current = s.order[0]
+}
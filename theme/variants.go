@@ -2,6 +2,9 @@ package theme
 
 import (
 	"image/color"
+	"sync"
+
+	colorutil "github.com/bnema/gio-shadcn/utils/color"
 )
 
 // Common colors.
@@ -31,9 +34,9 @@ func createSolidVariant(bg, fg color.NRGBA, colors *ColorScheme) VariantConfig {
 		Foreground:  fg,
 		Border:      bg,
 		BorderWidth: 0,
-		HoverBg:     darken(bg, 0.1),
+		HoverBg:     colorutil.Darken(bg, 0.1),
 		HoverFg:     fg,
-		ActiveBg:    darken(bg, 0.2),
+		ActiveBg:    colorutil.Darken(bg, 0.2),
 		ActiveFg:    fg,
 		DisabledBg:  colors.Muted,
 		DisabledFg:  colors.MutedFg,
@@ -70,8 +73,100 @@ func createTransparentVariantWithOpacity(fg color.NRGBA, colors *ColorScheme, wi
 	return config
 }
 
+// VariantResolver builds a variant configuration from a color scheme.
+type VariantResolver func(colors *ColorScheme) VariantConfig
+
+// VariantRegistry maps variant names to their configuration, allowing
+// applications to define custom variants alongside the built-in ones.
+// It is safe for concurrent use.
+//
+// Example usage:.
+//
+//	reg := theme.NewVariantRegistry()
+//	reg.Register("brand", theme.VariantConfig{Background: teal, Foreground: white})
+//	th.Registry = reg
+type VariantRegistry struct {
+	mu       sync.RWMutex
+	variants map[Variant]VariantResolver
+}
+
+// NewVariantRegistry creates an empty variant registry.
+func NewVariantRegistry() *VariantRegistry {
+	return &VariantRegistry{
+		variants: make(map[Variant]VariantResolver),
+	}
+}
+
+// DefaultRegistry is the global variant registry consulted by GetButtonVariant.
+// It is initialized with the built-in button variants.
+var DefaultRegistry = newBuiltinRegistry()
+
+func newBuiltinRegistry() *VariantRegistry {
+	r := NewVariantRegistry()
+	for _, v := range []Variant{
+		VariantDefault, VariantDestructive, VariantOutline,
+		VariantSecondary, VariantGhost, VariantLink,
+		VariantWarning, VariantInfo, VariantSuccess,
+	} {
+		r.RegisterFunc(v, func(colors *ColorScheme) VariantConfig {
+			return builtinButtonVariant(v, colors)
+		})
+	}
+	return r
+}
+
+// Register adds or replaces a variant with a fixed configuration.
+func (r *VariantRegistry) Register(name Variant, config VariantConfig) {
+	r.RegisterFunc(name, func(*ColorScheme) VariantConfig {
+		return config
+	})
+}
+
+// RegisterFunc adds or replaces a variant whose configuration depends on the
+// active color scheme, so it follows light and dark mode switches.
+func (r *VariantRegistry) RegisterFunc(name Variant, resolve VariantResolver) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.variants[name] = resolve
+}
+
+// Lookup returns the configuration registered for name.
+// Color-dependent variants are resolved against the light color scheme;
+// use Resolve to resolve against a specific scheme.
+func (r *VariantRegistry) Lookup(name Variant) (VariantConfig, bool) {
+	colors := LightColorScheme()
+	return r.Resolve(name, &colors)
+}
+
+// Resolve returns the configuration registered for name, resolved against colors.
+func (r *VariantRegistry) Resolve(name Variant, colors *ColorScheme) (VariantConfig, bool) {
+	if r == nil {
+		return VariantConfig{}, false
+	}
+
+	r.mu.RLock()
+	resolve, ok := r.variants[name]
+	r.mu.RUnlock()
+	if !ok {
+		return VariantConfig{}, false
+	}
+
+	return resolve(colors), true
+}
+
 // GetButtonVariant returns the color configuration for a button variant.
+// DefaultRegistry is consulted first, so registered custom variants take
+// precedence; unknown variants fall back to the default variant.
 func GetButtonVariant(variant Variant, colors *ColorScheme) VariantConfig {
+	if config, ok := DefaultRegistry.Resolve(variant, colors); ok {
+		return config
+	}
+
+	return builtinButtonVariant(variant, colors)
+}
+
+// builtinButtonVariant returns the built-in configuration for a button variant.
+func builtinButtonVariant(variant Variant, colors *ColorScheme) VariantConfig {
 	switch variant {
 	case VariantDefault:
 		return createSolidVariant(colors.Primary, colors.PrimaryFg, colors)
@@ -88,6 +183,15 @@ func GetButtonVariant(variant Variant, colors *ColorScheme) VariantConfig {
 	case VariantGhost:
 		return createTransparentVariant(colors.Foreground, colors, false)
 
+	case VariantWarning:
+		return createSolidVariant(colors.Amber, colors.AmberFg, colors)
+
+	case VariantInfo:
+		return createSolidVariant(colors.Blue, colors.BlueFg, colors)
+
+	case VariantSuccess:
+		return createSolidVariant(colors.Green, colors.GreenFg, colors)
+
 	case VariantLink:
 		return VariantConfig{
 			Background:  transparent,
@@ -95,16 +199,16 @@ func GetButtonVariant(variant Variant, colors *ColorScheme) VariantConfig {
 			Border:      transparent,
 			BorderWidth: 0,
 			HoverBg:     transparent,
-			HoverFg:     darken(colors.Primary, 0.1),
+			HoverFg:     colorutil.Darken(colors.Primary, 0.1),
 			ActiveBg:    transparent,
-			ActiveFg:    darken(colors.Primary, 0.2),
+			ActiveFg:    colorutil.Darken(colors.Primary, 0.2),
 			DisabledBg:  transparent,
 			DisabledFg:  colors.MutedFg,
 			FocusRing:   colors.Ring,
 		}
 
 	default:
-		return GetButtonVariant(VariantDefault, colors)
+		return builtinButtonVariant(VariantDefault, colors)
 	}
 }
 
@@ -156,8 +260,8 @@ func GetInputVariant(variant Variant, colors *ColorScheme) VariantConfig {
 
 	case VariantDestructive:
 		config := createInputVariant(colors.Input, colors.Foreground, colors.Destructive, colors)
-		config.HoverBg = lighten(colors.Input, 0.05)
-		config.ActiveBg = lighten(colors.Input, 0.1)
+		config.HoverBg = colorutil.Lighten(colors.Input, 0.05)
+		config.ActiveBg = colorutil.Lighten(colors.Input, 0.1)
 		config.FocusRing = colors.Destructive
 		return config
 
@@ -166,9 +270,9 @@ func GetInputVariant(variant Variant, colors *ColorScheme) VariantConfig {
 
 	case VariantSecondary:
 		config := createInputVariant(colors.Secondary, colors.SecondaryFg, colors.Border, colors)
-		config.HoverBg = lighten(colors.Secondary, 0.05)
+		config.HoverBg = colorutil.Lighten(colors.Secondary, 0.05)
 		config.HoverFg = colors.SecondaryFg
-		config.ActiveBg = lighten(colors.Secondary, 0.1)
+		config.ActiveBg = colorutil.Lighten(colors.Secondary, 0.1)
 		config.ActiveFg = colors.SecondaryFg
 		return config
 
@@ -207,23 +311,3 @@ func GetTitleBarVariant(variant Variant, colors *ColorScheme) VariantConfig {
 		return GetTitleBarVariant(VariantDefault, colors)
 	}
 }
-
-// Helper function to darken a color.
-func darken(c color.NRGBA, factor float32) color.NRGBA {
-	return color.NRGBA{
-		R: uint8(float32(c.R) * (1 - factor)),
-		G: uint8(float32(c.G) * (1 - factor)),
-		B: uint8(float32(c.B) * (1 - factor)),
-		A: c.A,
-	}
-}
-
-// Helper function to lighten a color.
-func lighten(c color.NRGBA, factor float32) color.NRGBA {
-	return color.NRGBA{
-		R: uint8(float32(c.R) + (255-float32(c.R))*factor),
-		G: uint8(float32(c.G) + (255-float32(c.G))*factor),
-		B: uint8(float32(c.B) + (255-float32(c.B))*factor),
-		A: c.A,
-	}
-}
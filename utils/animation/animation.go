@@ -0,0 +1,184 @@
+/*
+Package animation provides time-based value animation for gio-shadcn components.
+
+Gio redraws frames on demand, so components that animate need to track their own
+progress and request new frames while an animation is running. The Animator type
+encapsulates that bookkeeping: it interpolates a float32 value between a start and
+a target over a duration, applies an easing curve, and invalidates the frame until
+the target is reached.
+
+# Quick Start
+
+Fade a component in:
+
+	var fade animation.Animator
+	fade.Duration = 200 * time.Millisecond
+	fade.Animate(1)
+
+	// In Layout:
+	opacity := fade.Value(gtx)
+
+# Easing
+
+Available easing functions:
+• Linear - Constant speed
+• EaseIn - Starts slow, ends fast
+• EaseOut - Starts fast, ends slow
+• EaseInOut - Slow at both ends
+
+# Reduced Motion
+
+SetEnabled(false) turns every Animator into an instant transition, for users
+who prefer reduced motion:
+
+	animation.SetEnabled(false)
+*/
+package animation
+
+import (
+	"sync/atomic"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// DefaultDuration is the animation duration used when an Animator has none set.
+const DefaultDuration = 150 * time.Millisecond
+
+// disabled is the global reduced-motion switch.
+var disabled atomic.Bool
+
+// SetEnabled turns animations on or off for every Animator. Disabled
+// animators jump straight to their target.
+func SetEnabled(enabled bool) {
+	disabled.Store(!enabled)
+}
+
+// Enabled reports whether animations are enabled.
+func Enabled() bool {
+	return !disabled.Load()
+}
+
+// Easing maps linear progress in [0, 1] to eased progress in [0, 1].
+type Easing func(t float32) float32
+
+// Linear progresses at a constant rate.
+func Linear(t float32) float32 {
+	return t
+}
+
+// EaseIn starts slowly and accelerates towards the end.
+func EaseIn(t float32) float32 {
+	return t * t
+}
+
+// EaseOut starts quickly and decelerates towards the end.
+func EaseOut(t float32) float32 {
+	return t * (2 - t)
+}
+
+// EaseInOut accelerates through the first half and decelerates through the second.
+func EaseInOut(t float32) float32 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// Animator interpolates a single value towards a target over time.
+// The zero value is ready to use, starts at 0 and uses DefaultDuration
+// with EaseOut easing.
+type Animator struct {
+	// Configuration
+	Duration time.Duration
+	Easing   Easing
+
+	// Internal
+	from    float32
+	to      float32
+	current float32
+	start   time.Time
+	running bool
+}
+
+// Animate starts animating from the current value towards target.
+// The animation clock starts on the next call to Value.
+func (a *Animator) Animate(target float32) {
+	if target == a.to && (a.running || a.current == target) {
+		return
+	}
+
+	a.from = a.current
+	a.to = target
+	a.start = time.Time{}
+	a.running = true
+}
+
+// Set jumps to value immediately, cancelling any running animation.
+func (a *Animator) Set(value float32) {
+	a.from = value
+	a.to = value
+	a.current = value
+	a.running = false
+}
+
+// Value advances the animation to gtx.Now and returns the current value.
+// While the animation is running it requests another frame.
+func (a *Animator) Value(gtx layout.Context) float32 {
+	if !a.running {
+		return a.current
+	}
+	if disabled.Load() {
+		a.Set(a.to)
+		return a.current
+	}
+
+	if a.start.IsZero() {
+		a.start = gtx.Now
+	}
+
+	duration := a.Duration
+	if duration <= 0 {
+		duration = DefaultDuration
+	}
+
+	progress := float32(gtx.Now.Sub(a.start)) / float32(duration)
+	if progress >= 1 {
+		a.current = a.to
+		a.running = false
+		return a.current
+	}
+
+	easing := a.Easing
+	if easing == nil {
+		easing = EaseOut
+	}
+
+	a.current = a.from + (a.to-a.from)*easing(progress)
+	gtx.Execute(op.InvalidateCmd{})
+
+	return a.current
+}
+
+// Target returns the value the animator is moving towards.
+func (a *Animator) Target() float32 {
+	return a.to
+}
+
+// Running returns true while the animation has not reached its target.
+func (a *Animator) Running() bool {
+	return a.running
+}
+
+// Config describes the timing of an animation.
+type Config struct {
+	Duration time.Duration
+	Easing   Easing
+}
+
+// Configure sets the animator's duration and easing from config.
+func (a *Animator) Configure(config Config) {
+	a.Duration = config.Duration
+	a.Easing = config.Easing
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// borderSegment is a straight edge or corner arc of a rounded rectangle outline.
+type borderSegment struct {
+	length float32
+	draw   func(from, to float32)
+}
+
+// DrawDashedBorder draws a dashed border strokeWidth thick along the inside of
+// rect. Corners follow the given radius. Dashes are distributed evenly so the
+// pattern closes seamlessly around the perimeter; use dashLen == gapLen for a
+// dotted look.
+func DrawDashedBorder(gtx layout.Context, rect image.Rectangle, radius, strokeWidth unit.Dp, col color.NRGBA, dashLen, gapLen unit.Dp) {
+	width := float32(rect.Dx())
+	height := float32(rect.Dy())
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	thickness := float32(max(1, gtx.Dp(strokeWidth)))
+	dash := float32(gtx.Dp(dashLen))
+	gap := float32(gtx.Dp(gapLen))
+	if dash <= 0 {
+		return
+	}
+
+	r := float32(gtx.Dp(radius))
+	r = min(r, width/2, height/2)
+
+	segments := borderSegments(gtx, rect, r, thickness, col)
+
+	var perimeter float32
+	for _, seg := range segments {
+		perimeter += seg.length
+	}
+
+	// Stretch the period slightly so a whole number of dashes fits the perimeter
+	period := dash + gap
+	count := max(1, int(math.Round(float64(perimeter/period))))
+	period = perimeter / float32(count)
+	dash = period * dash / (dash + gap)
+
+	for i := 0; i < count; i++ {
+		start := float32(i) * period
+		drawBorderSpan(segments, start, start+dash)
+	}
+}
+
+// drawBorderSpan draws the part of the outline between the given perimeter offsets.
+func drawBorderSpan(segments []borderSegment, from, to float32) {
+	var offset float32
+	for _, seg := range segments {
+		segFrom := max(from, offset)
+		segTo := min(to, offset+seg.length)
+		if segTo > segFrom {
+			seg.draw(segFrom-offset, segTo-offset)
+		}
+		offset += seg.length
+	}
+}
+
+// borderSegments splits a rounded rectangle outline into clockwise segments,
+// starting at the top-left end of the top edge.
+func borderSegments(gtx layout.Context, rect image.Rectangle, r, thickness float32, col color.NRGBA) []borderSegment {
+	x0, y0 := float32(rect.Min.X), float32(rect.Min.Y)
+	x1, y1 := float32(rect.Max.X), float32(rect.Max.Y)
+	arc := float32(math.Pi) * r / 2
+
+	fill := func(minX, minY, maxX, maxY float32) {
+		area := clip.Rect{
+			Min: image.Pt(int(minX), int(minY)),
+			Max: image.Pt(int(math.Ceil(float64(maxX))), int(math.Ceil(float64(maxY)))),
+		}
+		paint.FillShape(gtx.Ops, col, area.Op())
+	}
+
+	// corner draws part of a quarter arc as small squares, one per pixel of length.
+	corner := func(cx, cy float32, startAngle float64) func(from, to float32) {
+		return func(from, to float32) {
+			if r <= 0 {
+				return
+			}
+			mid := r - thickness/2
+			for s := from; s < to; s++ {
+				angle := startAngle + float64(s/r)
+				px := cx + mid*float32(math.Cos(angle))
+				py := cy + mid*float32(math.Sin(angle))
+				fill(px-thickness/2, py-thickness/2, px+thickness/2, py+thickness/2)
+			}
+		}
+	}
+
+	return []borderSegment{
+		// Top edge, left to right
+		{x1 - x0 - 2*r, func(from, to float32) {
+			fill(x0+r+from, y0, x0+r+to, y0+thickness)
+		}},
+		{arc, corner(x1-r, y0+r, -math.Pi/2)},
+		// Right edge, top to bottom
+		{y1 - y0 - 2*r, func(from, to float32) {
+			fill(x1-thickness, y0+r+from, x1, y0+r+to)
+		}},
+		{arc, corner(x1-r, y1-r, 0)},
+		// Bottom edge, right to left
+		{x1 - x0 - 2*r, func(from, to float32) {
+			fill(x1-r-to, y1-thickness, x1-r-from, y1)
+		}},
+		{arc, corner(x0+r, y1-r, math.Pi/2)},
+		// Left edge, bottom to top
+		{y1 - y0 - 2*r, func(from, to float32) {
+			fill(x0, y1-r-to, x0+thickness, y1-r-from)
+		}},
+		{arc, corner(x0+r, y0+r, math.Pi)},
+	}
+}
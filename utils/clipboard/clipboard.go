@@ -0,0 +1,142 @@
+/*
+Package clipboard provides direct access to the system clipboard for
+gio-shadcn components.
+
+Gio's clipboard works through commands and events delivered on later frames,
+which some platforms and window managers don't deliver reliably. Components
+that accept an Interface can use System instead, which runs the platform's
+clipboard tools, or a custom implementation such as an in-memory clipboard in
+tests.
+
+Because the package name matches gioui.org/io/clipboard, import it under an
+alias where both are used:
+
+	import clipboardutil "github.com/bnema/gio-shadcn/utils/clipboard"
+
+# Quick Start
+
+Give an input a context menu backed by the system clipboard:
+
+	in := input.NewInput(input.WithClipboard(clipboard.NewSystem()))
+
+# Platform Tools
+
+• macOS - pbcopy and pbpaste
+• Linux - xclip, then xsel, then wl-copy and wl-paste on Wayland, using
+the first one installed
+• Windows - clip to write, PowerShell Get-Clipboard to read, since clip
+cannot read
+*/
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnavailable is returned when no clipboard tool is available.
+var ErrUnavailable = errors.New("clipboard: no clipboard tool available")
+
+// Interface reads and writes clipboard text.
+type Interface interface {
+	Read() (string, error)
+	Write(s string) error
+}
+
+// command is a clipboard tool invocation.
+type command struct {
+	name string
+	args []string
+}
+
+// System is the system clipboard, accessed through platform commands.
+//
+// Example usage:.
+//
+//	cb := clipboard.NewSystem()
+//	if err := cb.Write("hello"); err != nil {
+//		log.Println(err)
+//	}
+type System struct {
+	read  []command
+	write []command
+}
+
+// NewSystem returns the clipboard of the current platform.
+func NewSystem() *System {
+	switch runtime.GOOS {
+	case "darwin":
+		return &System{
+			read:  []command{{"pbpaste", nil}},
+			write: []command{{"pbcopy", nil}},
+		}
+	case "windows":
+		return &System{
+			read:  []command{{"powershell.exe", []string{"-NoProfile", "-Command", "Get-Clipboard -Raw"}}},
+			write: []command{{"clip", nil}},
+		}
+	default:
+		return &System{
+			read: []command{
+				{"xclip", []string{"-selection", "clipboard", "-out"}},
+				{"xsel", []string{"--clipboard", "--output"}},
+				{"wl-paste", []string{"--no-newline"}},
+			},
+			write: []command{
+				{"xclip", []string{"-selection", "clipboard", "-in"}},
+				{"xsel", []string{"--clipboard", "--input"}},
+				{"wl-copy", nil},
+			},
+		}
+	}
+}
+
+// Read returns the clipboard text.
+func (s *System) Read() (string, error) {
+	cmd, err := lookup(s.read)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	c := exec.Command(cmd.name, cmd.args...) // #nosec G204 - fixed clipboard tools
+	c.Stdout = &out
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("clipboard: %s failed: %w", cmd.name, err)
+	}
+
+	text := out.String()
+	if runtime.GOOS == "windows" {
+		text = strings.TrimSuffix(text, "\r\n")
+	}
+	return text, nil
+}
+
+// Write replaces the clipboard text with text.
+func (s *System) Write(text string) error {
+	cmd, err := lookup(s.write)
+	if err != nil {
+		return err
+	}
+
+	c := exec.Command(cmd.name, cmd.args...) // #nosec G204 - fixed clipboard tools
+	c.Stdin = strings.NewReader(text)
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("clipboard: %s failed: %w", cmd.name, err)
+	}
+	return nil
+}
+
+// lookup returns the first command whose tool is installed.
+func lookup(commands []command) (command, error) {
+	for _, cmd := range commands {
+		if _, err := exec.LookPath(cmd.name); err == nil {
+			return cmd, nil
+		}
+	}
+	return command{}, ErrUnavailable
+}
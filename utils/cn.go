@@ -12,7 +12,11 @@ CSS-like styling patterns while maintaining Go's type safety and performance.
 • Padding, margin, border, and styling utilities
 • Color parsing for common color names
 • Border radius and opacity parsing
+• Dashed and dotted border drawing
 • Component variant management
+• Global caching of parsed class strings
+• Hex color parsing (ParseHex, MustParseHex)
+• Spaced flex rows and columns (FlexRow, FlexColumn)
 
 # Quick Start
 
@@ -103,6 +107,8 @@ package utils
 import (
 	"image/color"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"gioui.org/layout"
 	"gioui.org/unit"
@@ -137,22 +143,52 @@ type BorderStyle struct {
 	Width unit.Dp
 }
 
+// MaxClassCacheEntries bounds the number of class strings kept in ClassCache.
+// When a new entry would exceed it, the cache is emptied and refills with the
+// class strings still in use, so class strings built at runtime can't grow it
+// without limit.
+const MaxClassCacheEntries = 1024
+
+// ClassCache caches parsed StyleUtility values keyed by canonical class string.
+// StyleUtility holds only values, so cached entries are safe to share between
+// components and goroutines. It holds at most about MaxClassCacheEntries
+// entries.
+var ClassCache sync.Map
+
+// classCacheEntries counts the entries stored in ClassCache since it was last
+// emptied.
+var classCacheEntries atomic.Int64
+
 // ParseClasses parses Tailwind-like utility classes.
+// Results are cached in ClassCache, so components can call it on every frame.
 func ParseClasses(classes ...string) StyleUtility {
+	classList := strings.Fields(ClassNames(classes...))
+	key := strings.Join(classList, " ")
+
+	if cached, ok := ClassCache.Load(key); ok {
+		return cached.(StyleUtility)
+	}
+
 	style := StyleUtility{
 		Opacity: 1.0,
 	}
 
-	classString := ClassNames(classes...)
-	classList := strings.Fields(classString)
-
 	for _, class := range classList {
 		parseClass(class, &style)
 	}
 
+	if _, loaded := ClassCache.LoadOrStore(key, style); !loaded && classCacheEntries.Add(1) > MaxClassCacheEntries {
+		ClearClassCache()
+	}
 	return style
 }
 
+// ClearClassCache removes all entries from ClassCache.
+func ClearClassCache() {
+	ClassCache.Clear()
+	classCacheEntries.Store(0)
+}
+
 //nolint:gocyclo // This function has high complexity but is straightforward switch-based parsing
 func parseClass(class string, style *StyleUtility) {
 	switch {
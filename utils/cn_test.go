@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+const benchClasses = "px-4 py-2 bg-blue-500 text-white rounded-md border"
+
+func BenchmarkParseClassesCached(b *testing.B) {
+	ClearClassCache()
+	ParseClasses(benchClasses)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		ParseClasses(benchClasses)
+	}
+}
+
+func BenchmarkParseClassesUncached(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		ClearClassCache()
+		ParseClasses(benchClasses)
+	}
+}
+
+func TestClassCacheIsBounded(t *testing.T) {
+	ClearClassCache()
+	t.Cleanup(ClearClassCache)
+
+	for i := range 3 * MaxClassCacheEntries {
+		ParseClasses(fmt.Sprintf("p-4 w-%d", i))
+	}
+
+	entries := 0
+	ClassCache.Range(func(_, _ any) bool {
+		entries++
+		return true
+	})
+	if entries > MaxClassCacheEntries {
+		t.Errorf("cache holds %d entries, want at most %d", entries, MaxClassCacheEntries)
+	}
+
+	if got := ParseClasses("p-4 w-0"); got.Padding.Top != 16 {
+		t.Errorf("padding after eviction = %v, want 16dp", got.Padding.Top)
+	}
+}
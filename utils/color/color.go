@@ -0,0 +1,194 @@
+/*
+Package color provides color manipulation utilities for gio-shadcn components.
+
+The helpers in this package operate on image/color.NRGBA values, the color type
+used throughout Gio and the gio-shadcn theme system. They cover the common needs
+of component styling: deriving hover and active shades, adjusting transparency,
+blending colors, deriving harmonious colors, and picking readable text colors.
+
+Because the package name shadows image/color, import it under an alias:
+
+	import colorutil "github.com/bnema/gio-shadcn/utils/color"
+
+# Quick Start
+
+Derive hover and active shades:
+
+	hover := colorutil.Darken(th.Colors.Primary, 0.1)
+	active := colorutil.Darken(th.Colors.Primary, 0.2)
+
+Pick a readable foreground for a background:
+
+	fg := th.Colors.Foreground
+	if !colorutil.IsLight(bg) {
+		fg = th.Colors.Background
+	}
+*/
+package color
+
+import (
+	"image/color"
+	"math"
+)
+
+// Darken darkens a color by the given factor (0 = unchanged, 1 = black).
+// The factor is clamped to [0, 1]; the alpha channel is preserved.
+func Darken(c color.NRGBA, factor float32) color.NRGBA {
+	factor = clamp01(factor)
+	return color.NRGBA{
+		R: uint8(float32(c.R) * (1 - factor)),
+		G: uint8(float32(c.G) * (1 - factor)),
+		B: uint8(float32(c.B) * (1 - factor)),
+		A: c.A,
+	}
+}
+
+// Lighten lightens a color by the given factor (0 = unchanged, 1 = white).
+// The factor is clamped to [0, 1]; the alpha channel is preserved.
+func Lighten(c color.NRGBA, factor float32) color.NRGBA {
+	factor = clamp01(factor)
+	return color.NRGBA{
+		R: uint8(float32(c.R) + (255-float32(c.R))*factor),
+		G: uint8(float32(c.G) + (255-float32(c.G))*factor),
+		B: uint8(float32(c.B) + (255-float32(c.B))*factor),
+		A: c.A,
+	}
+}
+
+// WithAlpha returns the color with its alpha channel replaced by alpha.
+func WithAlpha(c color.NRGBA, alpha uint8) color.NRGBA {
+	c.A = alpha
+	return c
+}
+
+// Mix linearly interpolates between a and b, including alpha.
+// A t of 0 returns a, a t of 1 returns b; t is clamped to [0, 1].
+func Mix(a, b color.NRGBA, t float32) color.NRGBA {
+	t = clamp01(t)
+	return color.NRGBA{
+		R: mixChannel(a.R, b.R, t),
+		G: mixChannel(a.G, b.G, t),
+		B: mixChannel(a.B, b.B, t),
+		A: mixChannel(a.A, b.A, t),
+	}
+}
+
+// Complementary returns the color on the opposite side of the color wheel.
+// Saturation, lightness and alpha are preserved.
+func Complementary(c color.NRGBA) color.NRGBA {
+	return rotateHue(c, 180)
+}
+
+// Triadic returns the two colors that form a triadic harmony with c,
+// rotated 120 and 240 degrees around the color wheel.
+func Triadic(c color.NRGBA) (color.NRGBA, color.NRGBA) {
+	return rotateHue(c, 120), rotateHue(c, 240)
+}
+
+// IsLight reports whether a color is perceived as light.
+// It uses the perceived luminance formula (0.299 R + 0.587 G + 0.114 B),
+// which makes it suitable for choosing dark or light text on a background.
+func IsLight(c color.NRGBA) bool {
+	luminance := 0.299*float32(c.R) + 0.587*float32(c.G) + 0.114*float32(c.B)
+	return luminance > 127.5
+}
+
+func mixChannel(a, b uint8, t float32) uint8 {
+	return uint8(math.Round(float64(float32(a) + (float32(b)-float32(a))*t)))
+}
+
+func clamp01(v float32) float32 {
+	switch {
+	case v < 0:
+		return 0
+	case v > 1:
+		return 1
+	default:
+		return v
+	}
+}
+
+// rotateHue rotates the hue of a color by the given number of degrees.
+func rotateHue(c color.NRGBA, degrees float64) color.NRGBA {
+	h, s, l := rgbToHSL(c)
+	h = math.Mod(h+degrees, 360)
+	r, g, b := hslToRGB(h, s, l)
+	return color.NRGBA{R: r, G: g, B: b, A: c.A}
+}
+
+// rgbToHSL converts a color to hue (0-360), saturation (0-1) and lightness (0-1).
+func rgbToHSL(c color.NRGBA) (h, s, l float64) {
+	r := float64(c.R) / 255
+	g := float64(c.G) / 255
+	b := float64(c.B) / 255
+
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	l = (maxC + minC) / 2
+
+	if maxC == minC {
+		return 0, 0, l
+	}
+
+	d := maxC - minC
+	if l > 0.5 {
+		s = d / (2 - maxC - minC)
+	} else {
+		s = d / (maxC + minC)
+	}
+
+	switch maxC {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+
+	return h * 60, s, l
+}
+
+// hslToRGB converts hue (0-360), saturation (0-1) and lightness (0-1) to RGB.
+func hslToRGB(h, s, l float64) (r, g, b uint8) {
+	if s == 0 {
+		v := uint8(math.Round(l * 255))
+		return v, v, v
+	}
+
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	hk := h / 360
+
+	toChannel := func(t float64) uint8 {
+		if t < 0 {
+			t++
+		}
+		if t > 1 {
+			t--
+		}
+
+		var v float64
+		switch {
+		case t < 1.0/6:
+			v = p + (q-p)*6*t
+		case t < 0.5:
+			v = q
+		case t < 2.0/3:
+			v = p + (q-p)*(2.0/3-t)*6
+		default:
+			v = p
+		}
+		return uint8(math.Round(v * 255))
+	}
+
+	return toChannel(hk + 1.0/3), toChannel(hk), toChannel(hk - 1.0/3)
+}
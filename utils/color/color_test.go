@@ -0,0 +1,56 @@
+package color
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestDarkenLighten(t *testing.T) {
+	c := color.NRGBA{R: 100, G: 150, B: 200, A: 128}
+	black := color.NRGBA{A: 128}
+	white := color.NRGBA{R: 255, G: 255, B: 255, A: 128}
+
+	tests := []struct {
+		name   string
+		fn     func(color.NRGBA, float32) color.NRGBA
+		factor float32
+		want   color.NRGBA
+	}{
+		{"darken zero", Darken, 0, c},
+		{"darken half", Darken, 0.5, color.NRGBA{R: 50, G: 75, B: 100, A: 128}},
+		{"darken one", Darken, 1, black},
+		{"darken below zero", Darken, -0.5, c},
+		{"darken above one", Darken, 2, black},
+		{"lighten zero", Lighten, 0, c},
+		{"lighten one", Lighten, 1, white},
+		{"lighten below zero", Lighten, -1, c},
+		{"lighten above one", Lighten, 3, white},
+	}
+
+	for _, tt := range tests {
+		if got := tt.fn(c, tt.factor); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMixClamps(t *testing.T) {
+	a := color.NRGBA{R: 0, G: 0, B: 0, A: 0}
+	b := color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+
+	tests := []struct {
+		t    float32
+		want color.NRGBA
+	}{
+		{-1, a},
+		{0, a},
+		{1, b},
+		{2, b},
+	}
+
+	for _, tt := range tests {
+		if got := Mix(a, b, tt.t); got != tt.want {
+			t.Errorf("Mix(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
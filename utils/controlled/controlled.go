@@ -0,0 +1,68 @@
+/*
+Package controlled provides a controlled-component wrapper for gio-shadcn inputs.
+
+Components such as input.Input keep their value in internal state, which is
+convenient for simple forms ("uncontrolled"). Applications that keep form data
+in an external store can wrap those components in Controlled instead: on every
+frame the external value is pushed into the component, and user edits are
+reported through OnChange rather than applied directly ("controlled"), similar
+to controlled components in React.
+
+# Quick Start
+
+Bind an input to a field of an external store:
+
+	email := controlled.New[string](input.NewInput(), store.Email, func(v string) {
+		store.Email = v
+	})
+
+	// In Layout:
+	email.Value = store.Email
+	dims := email.Layout(gtx, th)
+
+If OnChange does not update Value, the edit is reverted on the next frame.
+*/
+package controlled
+
+import (
+	"gioui.org/layout"
+	"github.com/bnema/gio-shadcn/theme"
+)
+
+// Component is a component whose value can be controlled from outside.
+// GetValue is used rather than Value because several components already
+// expose their value as a field.
+type Component[T comparable] interface {
+	SetValue(value T)
+	GetValue() T
+	Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions
+}
+
+// Controlled wraps a component so that its displayed value always matches Value.
+type Controlled[T comparable] struct {
+	Component Component[T]
+	Value     T
+	OnChange  func(T)
+}
+
+// New creates a controlled wrapper around component with an initial value.
+func New[T comparable](component Component[T], value T, onChange func(T)) *Controlled[T] {
+	return &Controlled[T]{
+		Component: component,
+		Value:     value,
+		OnChange:  onChange,
+	}
+}
+
+// Layout pushes Value into the component, renders it, and reports any change
+// made during the frame through OnChange.
+func (c *Controlled[T]) Layout(gtx layout.Context, th *theme.Theme) layout.Dimensions {
+	c.Component.SetValue(c.Value)
+	dims := c.Component.Layout(gtx, th)
+
+	if value := c.Component.GetValue(); value != c.Value && c.OnChange != nil {
+		c.OnChange(value)
+	}
+
+	return dims
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// Debouncer delays calls to a function until they stop: each Call restarts
+// the delay, and fn runs once with the last value when it elapses. fn runs on
+// a timer goroutine, so UI state it touches must be synchronized, or the
+// window invalidated for the next frame to pick up the result.
+//
+// Example usage:.
+//
+//	search := utils.NewDebouncer(300*time.Millisecond, func(query string) {
+//		results = lookup(query)
+//		w.Invalidate()
+//	})
+//	search.Call(text)
+type Debouncer[T any] struct {
+	delay time.Duration
+	fn    func(T)
+
+	mu    sync.Mutex
+	timer *time.Timer
+	// generation invalidates timers that were stopped too late
+	generation uint64
+}
+
+// NewDebouncer creates a Debouncer that calls fn delay after the last Call.
+func NewDebouncer[T any](delay time.Duration, fn func(T)) *Debouncer[T] {
+	return &Debouncer[T]{delay: delay, fn: fn}
+}
+
+// Call starts, or restarts, the delay before fn is called with value.
+func (d *Debouncer[T]) Call(value T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stop()
+	generation := d.generation
+	d.timer = time.AfterFunc(d.delay, func() {
+		d.mu.Lock()
+		current := generation == d.generation
+		if current {
+			d.timer = nil
+		}
+		d.mu.Unlock()
+
+		if current {
+			d.fn(value)
+		}
+	})
+}
+
+// Cancel drops the pending call, if any.
+func (d *Debouncer[T]) Cancel() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stop()
+}
+
+// stop stops the pending timer. d.mu must be held.
+func (d *Debouncer[T]) stop() {
+	d.generation++
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+// Throttler limits calls to a function to one per period. The first Call runs
+// fn immediately; later calls within the period are merged, and fn runs with
+// the most recent value when the period ends. Like Debouncer, fn may run on a
+// timer goroutine.
+//
+// Example usage:.
+//
+//	resize := utils.NewThrottler(100*time.Millisecond, func(width int) {
+//		relayout(width)
+//	})
+//	resize.Call(gtx.Constraints.Max.X)
+type Throttler[T any] struct {
+	period time.Duration
+	fn     func(T)
+
+	mu         sync.Mutex
+	timer      *time.Timer
+	pending    bool
+	latest     T
+	generation uint64
+}
+
+// NewThrottler creates a Throttler that calls fn at most once per period.
+func NewThrottler[T any](period time.Duration, fn func(T)) *Throttler[T] {
+	return &Throttler[T]{period: period, fn: fn}
+}
+
+// Call calls fn with value now if no period is running, or with the most
+// recent value when the current period ends.
+func (t *Throttler[T]) Call(value T) {
+	t.mu.Lock()
+	if t.timer != nil {
+		t.latest = value
+		t.pending = true
+		t.mu.Unlock()
+		return
+	}
+	t.startPeriod()
+	t.mu.Unlock()
+
+	t.fn(value)
+}
+
+// Reset ends the current period and drops the pending value, so the next Call
+// runs immediately.
+func (t *Throttler[T]) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.pending = false
+	var zero T
+	t.latest = zero
+}
+
+// startPeriod starts a period that ends by flushing the pending value. t.mu
+// must be held.
+func (t *Throttler[T]) startPeriod() {
+	generation := t.generation
+	t.timer = time.AfterFunc(t.period, func() {
+		t.mu.Lock()
+		if generation != t.generation {
+			t.mu.Unlock()
+			return
+		}
+		t.timer = nil
+		if !t.pending {
+			t.mu.Unlock()
+			return
+		}
+		value := t.latest
+		t.pending = false
+		t.startPeriod()
+		t.mu.Unlock()
+
+		t.fn(value)
+	})
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitEmojiText splits a leading emoji from the rest of s, so the two can be
+// laid out separately. The emoji must be followed by whitespace or end the
+// string; otherwise emoji is empty and text is s.
+//
+// Example:.
+//
+//	emoji, text := utils.SplitEmojiText("🌙 Dark Mode") // "🌙", "Dark Mode"
+func SplitEmojiText(s string) (emoji, text string) {
+	end := 0
+	for i, r := range s {
+		if !isEmojiRune(r) {
+			break
+		}
+		end = i + len(string(r))
+	}
+	if end == 0 {
+		return "", s
+	}
+
+	rest := s[end:]
+	trimmed := strings.TrimLeftFunc(rest, unicode.IsSpace)
+	if rest != "" && trimmed == rest {
+		// Symbol glued to a word, e.g. "→Next"; leave it alone
+		return "", s
+	}
+	return s[:end], trimmed
+}
+
+// isEmojiRune reports whether r is a pictographic symbol or one of the
+// modifiers that join symbols into a single emoji.
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Emoticons, pictographs, flags, skin tones
+		return true
+	case r >= 0x2190 && r <= 0x2BFF: // Arrows, technical, shapes, dingbats
+		return true
+	case r == 0x200D, r == 0x20E3: // Zero-width joiner, keycap
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F: // Variation selectors
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // Tag sequences
+		return true
+	default:
+		return false
+	}
+}
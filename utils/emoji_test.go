@@ -0,0 +1,33 @@
+package utils
+
+import "testing"
+
+func TestSplitEmojiText(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		wantEmoji string
+		wantText  string
+	}{
+		{"emoji only", "🌙", "🌙", ""},
+		{"leading emoji", "🌙 Dark Mode", "🌙", "Dark Mode"},
+		{"trailing emoji", "Ship it 🚀", "", "Ship it 🚀"},
+		{"text only", "Save", "", "Save"},
+		{"glued to text", "→Next", "", "→Next"},
+		{"zwj sequence", "👨‍👩‍👧 Family", "👨‍👩‍👧", "Family"},
+		{"variation selector", "❤️ Like", "❤️", "Like"},
+		{"skin tone", "👍🏽 Approve", "👍🏽", "Approve"},
+		{"flag", "🇫🇷 Français", "🇫🇷", "Français"},
+		{"dingbat", "✔ Done", "✔", "Done"},
+		{"empty", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emoji, text := SplitEmojiText(tt.s)
+			if emoji != tt.wantEmoji || text != tt.wantText {
+				t.Errorf("SplitEmojiText(%q) = %q, %q, want %q, %q", tt.s, emoji, text, tt.wantEmoji, tt.wantText)
+			}
+		})
+	}
+}
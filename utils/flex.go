@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"gioui.org/layout"
+	"gioui.org/unit"
+)
+
+// FlexSpaced returns a rigid flex child that lays out widgets along axis with
+// gap between each pair.
+//
+// Example:.
+//
+//	layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+//		utils.FlexSpaced(layout.Horizontal, th.Spacing.Space2, saveBtn.Layout, cancelBtn.Layout),
+//	)
+func FlexSpaced(axis layout.Axis, gap unit.Dp, widgets ...layout.Widget) layout.FlexChild {
+	return layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+		return layoutSpaced(gtx, axis, gap, widgets)
+	})
+}
+
+// FlexRow returns a rigid flex child laying out widgets horizontally with gap between them.
+func FlexRow(gap unit.Dp, widgets ...layout.Widget) layout.FlexChild {
+	return FlexSpaced(layout.Horizontal, gap, widgets...)
+}
+
+// FlexColumn returns a rigid flex child laying out widgets vertically with gap between them.
+func FlexColumn(gap unit.Dp, widgets ...layout.Widget) layout.FlexChild {
+	return FlexSpaced(layout.Vertical, gap, widgets...)
+}
+
+// layoutSpaced lays out widgets in a flex along axis, separated by gap spacers.
+func layoutSpaced(gtx layout.Context, axis layout.Axis, gap unit.Dp, widgets []layout.Widget) layout.Dimensions {
+	spacer := layout.Spacer{Width: gap}
+	if axis == layout.Vertical {
+		spacer = layout.Spacer{Height: gap}
+	}
+
+	children := make([]layout.FlexChild, 0, 2*len(widgets))
+	for idx, w := range widgets {
+		if idx > 0 {
+			children = append(children, layout.Rigid(spacer.Layout))
+		}
+		children = append(children, layout.Rigid(w))
+	}
+
+	return layout.Flex{Axis: axis}.Layout(gtx, children...)
+}
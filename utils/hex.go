@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// ParseHex parses a CSS-style hex color: "#rgb", "#rrggbb" or "#rrggbbaa".
+// The leading '#' is optional. Colors without an alpha component are opaque.
+func ParseHex(hex string) (color.NRGBA, error) {
+	digits := strings.TrimPrefix(hex, "#")
+
+	if len(digits) == 3 {
+		digits = string([]byte{
+			digits[0], digits[0],
+			digits[1], digits[1],
+			digits[2], digits[2],
+		})
+	}
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+	if len(digits) != 8 {
+		return color.NRGBA{}, fmt.Errorf("invalid hex color format: %s", hex)
+	}
+
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid hex color %s: %w", hex, err)
+	}
+
+	return color.NRGBA{
+		R: uint8(value >> 24),
+		G: uint8(value >> 16),
+		B: uint8(value >> 8),
+		A: uint8(value),
+	}, nil
+}
+
+// MustParseHex is like ParseHex but panics if hex is invalid. It is meant for
+// static color definitions, where an invalid value is a programming error.
+//
+// Example:.
+//
+//	white := utils.MustParseHex("#ffffff")
+func MustParseHex(hex string) color.NRGBA {
+	c, err := ParseHex(hex)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
@@ -0,0 +1,386 @@
+/*
+Package i18n provides translations for the text that gio-shadcn components
+render themselves, such as button labels and status messages.
+
+# Quick Start
+
+Select a built-in locale on the theme:
+
+	th := theme.New(theme.WithLocale(i18n.LocaleFR))
+
+Components then look up their text through the theme:
+
+	text := th.Locale.T(i18n.KeyCopy) // "Copier"
+
+Create a custom locale that falls back to English for missing keys:
+
+	pirate := &i18n.Locale{
+		Translations: map[string]string{i18n.KeyCopy: "Plunder"},
+		Fallback:     i18n.LocaleEN,
+	}
+
+# Features
+
+• Built-in English, Spanish, French, German and Chinese locales
+• Fallback chains for partial translations
+• Nil-safe lookups that default to English
+• Month and short weekday names through MonthKey and WeekdayKey
+*/
+package i18n
+
+import "time"
+
+// Keys of the strings rendered by gio-shadcn components. Keys commented with
+// a format are fmt format strings.
+const (
+	KeyCancel             = "cancel"
+	KeySubmit             = "submit"
+	KeyClose              = "close"
+	KeyPrevious           = "previous"
+	KeyNext               = "next"
+	KeyCopy               = "copy"
+	KeyCut                = "cut"
+	KeyPaste              = "paste"
+	KeySelectAll          = "select_all"
+	KeyYes                = "yes"
+	KeyNo                 = "no"
+	KeyAreYouSure         = "are_you_sure"
+	KeyShowMore           = "show_more"
+	KeyLoading            = "loading"
+	KeyNoResults          = "no_results"
+	KeyStepOf             = "step_of"      // Step %d of %d
+	KeyPageSummary        = "page_summary" // %d–%d of %d · Page %d of %d
+	KeyStrengthWeak       = "strength_weak"
+	KeyStrengthFair       = "strength_fair"
+	KeyStrengthStrong     = "strength_strong"
+	KeyStrengthVeryStrong = "strength_very_strong"
+	KeyRequired           = "required"
+	KeyMinLength          = "min_length" // must be at least %d characters
+	KeyInvalidEmail       = "invalid_email"
+)
+
+// Keys of month names and of the short weekday names shown above calendar
+// columns. Use MonthKey and WeekdayKey to look them up by time value.
+const (
+	KeyJanuary        = "month_january"
+	KeyFebruary       = "month_february"
+	KeyMarch          = "month_march"
+	KeyApril          = "month_april"
+	KeyMay            = "month_may"
+	KeyJune           = "month_june"
+	KeyJuly           = "month_july"
+	KeyAugust         = "month_august"
+	KeySeptember      = "month_september"
+	KeyOctober        = "month_october"
+	KeyNovember       = "month_november"
+	KeyDecember       = "month_december"
+	KeySundayShort    = "weekday_short_sunday"
+	KeyMondayShort    = "weekday_short_monday"
+	KeyTuesdayShort   = "weekday_short_tuesday"
+	KeyWednesdayShort = "weekday_short_wednesday"
+	KeyThursdayShort  = "weekday_short_thursday"
+	KeyFridayShort    = "weekday_short_friday"
+	KeySaturdayShort  = "weekday_short_saturday"
+	KeyMonthYear      = "month_year" // %s %d: month name, year
+)
+
+// Locale is a set of translations with an optional fallback locale.
+type Locale struct {
+	Name         string
+	Translations map[string]string
+	Fallback     *Locale
+}
+
+// T returns the translation of key, looking through the fallback chain. When
+// no locale translates key, the key itself is returned. A nil locale uses
+// LocaleEN.
+func (l *Locale) T(key string) string {
+	if l == nil {
+		l = LocaleEN
+	}
+	for locale := l; locale != nil; locale = locale.Fallback {
+		if text, ok := locale.Translations[key]; ok {
+			return text
+		}
+	}
+	return key
+}
+
+// Built-in locales. All but LocaleEN fall back to LocaleEN.
+var (
+	LocaleEN = &Locale{
+		Name: "en",
+		Translations: map[string]string{
+			KeyCancel:             "Cancel",
+			KeySubmit:             "Submit",
+			KeyClose:              "Close",
+			KeyPrevious:           "Previous",
+			KeyNext:               "Next",
+			KeyCopy:               "Copy",
+			KeyCut:                "Cut",
+			KeyPaste:              "Paste",
+			KeySelectAll:          "Select All",
+			KeyYes:                "Yes",
+			KeyNo:                 "No",
+			KeyAreYouSure:         "Are you sure?",
+			KeyShowMore:           "Show more",
+			KeyLoading:            "Loading...",
+			KeyNoResults:          "No results.",
+			KeyStepOf:             "Step %d of %d",
+			KeyPageSummary:        "%d–%d of %d · Page %d of %d",
+			KeyStrengthWeak:       "Weak",
+			KeyStrengthFair:       "Fair",
+			KeyStrengthStrong:     "Strong",
+			KeyStrengthVeryStrong: "Very Strong",
+			KeyRequired:           "this field is required",
+			KeyMinLength:          "must be at least %d characters",
+			KeyInvalidEmail:       "must be a valid email address",
+			KeyJanuary:            "January",
+			KeyFebruary:           "February",
+			KeyMarch:              "March",
+			KeyApril:              "April",
+			KeyMay:                "May",
+			KeyJune:               "June",
+			KeyJuly:               "July",
+			KeyAugust:             "August",
+			KeySeptember:          "September",
+			KeyOctober:            "October",
+			KeyNovember:           "November",
+			KeyDecember:           "December",
+			KeySundayShort:        "Su",
+			KeyMondayShort:        "Mo",
+			KeyTuesdayShort:       "Tu",
+			KeyWednesdayShort:     "We",
+			KeyThursdayShort:      "Th",
+			KeyFridayShort:        "Fr",
+			KeySaturdayShort:      "Sa",
+			KeyMonthYear:          "%s %d",
+		},
+	}
+
+	LocaleES = &Locale{
+		Name: "es",
+		Translations: map[string]string{
+			KeyCancel:             "Cancelar",
+			KeySubmit:             "Enviar",
+			KeyClose:              "Cerrar",
+			KeyPrevious:           "Anterior",
+			KeyNext:               "Siguiente",
+			KeyCopy:               "Copiar",
+			KeyCut:                "Cortar",
+			KeyPaste:              "Pegar",
+			KeySelectAll:          "Seleccionar todo",
+			KeyYes:                "Sí",
+			KeyNo:                 "No",
+			KeyAreYouSure:         "¿Estás seguro?",
+			KeyShowMore:           "Mostrar más",
+			KeyLoading:            "Cargando...",
+			KeyNoResults:          "Sin resultados.",
+			KeyStepOf:             "Paso %d de %d",
+			KeyPageSummary:        "%d–%d de %d · Página %d de %d",
+			KeyStrengthWeak:       "Débil",
+			KeyStrengthFair:       "Aceptable",
+			KeyStrengthStrong:     "Fuerte",
+			KeyStrengthVeryStrong: "Muy fuerte",
+			KeyRequired:           "este campo es obligatorio",
+			KeyMinLength:          "debe tener al menos %d caracteres",
+			KeyInvalidEmail:       "debe ser una dirección de correo válida",
+			KeyJanuary:            "enero",
+			KeyFebruary:           "febrero",
+			KeyMarch:              "marzo",
+			KeyApril:              "abril",
+			KeyMay:                "mayo",
+			KeyJune:               "junio",
+			KeyJuly:               "julio",
+			KeyAugust:             "agosto",
+			KeySeptember:          "septiembre",
+			KeyOctober:            "octubre",
+			KeyNovember:           "noviembre",
+			KeyDecember:           "diciembre",
+			KeySundayShort:        "do",
+			KeyMondayShort:        "lu",
+			KeyTuesdayShort:       "ma",
+			KeyWednesdayShort:     "mi",
+			KeyThursdayShort:      "ju",
+			KeyFridayShort:        "vi",
+			KeySaturdayShort:      "sá",
+			KeyMonthYear:          "%s de %d",
+		},
+		Fallback: LocaleEN,
+	}
+
+	LocaleFR = &Locale{
+		Name: "fr",
+		Translations: map[string]string{
+			KeyCancel:             "Annuler",
+			KeySubmit:             "Envoyer",
+			KeyClose:              "Fermer",
+			KeyPrevious:           "Précédent",
+			KeyNext:               "Suivant",
+			KeyCopy:               "Copier",
+			KeyCut:                "Couper",
+			KeyPaste:              "Coller",
+			KeySelectAll:          "Tout sélectionner",
+			KeyYes:                "Oui",
+			KeyNo:                 "Non",
+			KeyAreYouSure:         "Êtes-vous sûr ?",
+			KeyShowMore:           "Afficher plus",
+			KeyLoading:            "Chargement...",
+			KeyNoResults:          "Aucun résultat.",
+			KeyStepOf:             "Étape %d sur %d",
+			KeyPageSummary:        "%d–%d sur %d · Page %d sur %d",
+			KeyStrengthWeak:       "Faible",
+			KeyStrengthFair:       "Moyen",
+			KeyStrengthStrong:     "Fort",
+			KeyStrengthVeryStrong: "Très fort",
+			KeyRequired:           "ce champ est obligatoire",
+			KeyMinLength:          "doit contenir au moins %d caractères",
+			KeyInvalidEmail:       "doit être une adresse e-mail valide",
+			KeyJanuary:            "janvier",
+			KeyFebruary:           "février",
+			KeyMarch:              "mars",
+			KeyApril:              "avril",
+			KeyMay:                "mai",
+			KeyJune:               "juin",
+			KeyJuly:               "juillet",
+			KeyAugust:             "août",
+			KeySeptember:          "septembre",
+			KeyOctober:            "octobre",
+			KeyNovember:           "novembre",
+			KeyDecember:           "décembre",
+			KeySundayShort:        "di",
+			KeyMondayShort:        "lu",
+			KeyTuesdayShort:       "ma",
+			KeyWednesdayShort:     "me",
+			KeyThursdayShort:      "je",
+			KeyFridayShort:        "ve",
+			KeySaturdayShort:      "sa",
+			KeyMonthYear:          "%s %d",
+		},
+		Fallback: LocaleEN,
+	}
+
+	LocaleDE = &Locale{
+		Name: "de",
+		Translations: map[string]string{
+			KeyCancel:             "Abbrechen",
+			KeySubmit:             "Absenden",
+			KeyClose:              "Schließen",
+			KeyPrevious:           "Zurück",
+			KeyNext:               "Weiter",
+			KeyCopy:               "Kopieren",
+			KeyCut:                "Ausschneiden",
+			KeyPaste:              "Einfügen",
+			KeySelectAll:          "Alles auswählen",
+			KeyYes:                "Ja",
+			KeyNo:                 "Nein",
+			KeyAreYouSure:         "Sind Sie sicher?",
+			KeyShowMore:           "Mehr anzeigen",
+			KeyLoading:            "Wird geladen...",
+			KeyNoResults:          "Keine Ergebnisse.",
+			KeyStepOf:             "Schritt %d von %d",
+			KeyPageSummary:        "%d–%d von %d · Seite %d von %d",
+			KeyStrengthWeak:       "Schwach",
+			KeyStrengthFair:       "Mittel",
+			KeyStrengthStrong:     "Stark",
+			KeyStrengthVeryStrong: "Sehr stark",
+			KeyRequired:           "dieses Feld ist erforderlich",
+			KeyMinLength:          "muss mindestens %d Zeichen lang sein",
+			KeyInvalidEmail:       "muss eine gültige E-Mail-Adresse sein",
+			KeyJanuary:            "Januar",
+			KeyFebruary:           "Februar",
+			KeyMarch:              "März",
+			KeyApril:              "April",
+			KeyMay:                "Mai",
+			KeyJune:               "Juni",
+			KeyJuly:               "Juli",
+			KeyAugust:             "August",
+			KeySeptember:          "September",
+			KeyOctober:            "Oktober",
+			KeyNovember:           "November",
+			KeyDecember:           "Dezember",
+			KeySundayShort:        "So",
+			KeyMondayShort:        "Mo",
+			KeyTuesdayShort:       "Di",
+			KeyWednesdayShort:     "Mi",
+			KeyThursdayShort:      "Do",
+			KeyFridayShort:        "Fr",
+			KeySaturdayShort:      "Sa",
+			KeyMonthYear:          "%s %d",
+		},
+		Fallback: LocaleEN,
+	}
+
+	LocaleZH = &Locale{
+		Name: "zh",
+		Translations: map[string]string{
+			KeyCancel:             "取消",
+			KeySubmit:             "提交",
+			KeyClose:              "关闭",
+			KeyPrevious:           "上一步",
+			KeyNext:               "下一步",
+			KeyCopy:               "复制",
+			KeyCut:                "剪切",
+			KeyPaste:              "粘贴",
+			KeySelectAll:          "全选",
+			KeyYes:                "是",
+			KeyNo:                 "否",
+			KeyAreYouSure:         "确定吗？",
+			KeyShowMore:           "显示更多",
+			KeyLoading:            "加载中...",
+			KeyNoResults:          "无结果。",
+			KeyStepOf:             "第 %d 步，共 %d 步",
+			KeyPageSummary:        "%d–%d，共 %d 项 · 第 %d 页，共 %d 页",
+			KeyStrengthWeak:       "弱",
+			KeyStrengthFair:       "一般",
+			KeyStrengthStrong:     "强",
+			KeyStrengthVeryStrong: "非常强",
+			KeyRequired:           "此字段为必填项",
+			KeyMinLength:          "至少需要 %d 个字符",
+			KeyInvalidEmail:       "必须是有效的电子邮件地址",
+			KeyJanuary:            "1月",
+			KeyFebruary:           "2月",
+			KeyMarch:              "3月",
+			KeyApril:              "4月",
+			KeyMay:                "5月",
+			KeyJune:               "6月",
+			KeyJuly:               "7月",
+			KeyAugust:             "8月",
+			KeySeptember:          "9月",
+			KeyOctober:            "10月",
+			KeyNovember:           "11月",
+			KeyDecember:           "12月",
+			KeySundayShort:        "日",
+			KeyMondayShort:        "一",
+			KeyTuesdayShort:       "二",
+			KeyWednesdayShort:     "三",
+			KeyThursdayShort:      "四",
+			KeyFridayShort:        "五",
+			KeySaturdayShort:      "六",
+			KeyMonthYear:          "%[2]d年%[1]s",
+		},
+		Fallback: LocaleEN,
+	}
+)
+
+// monthKeys and weekdayKeys are indexed by time.Month-1 and time.Weekday.
+var (
+	monthKeys = [...]string{
+		KeyJanuary, KeyFebruary, KeyMarch, KeyApril, KeyMay, KeyJune,
+		KeyJuly, KeyAugust, KeySeptember, KeyOctober, KeyNovember, KeyDecember,
+	}
+	weekdayKeys = [...]string{
+		KeySundayShort, KeyMondayShort, KeyTuesdayShort, KeyWednesdayShort,
+		KeyThursdayShort, KeyFridayShort, KeySaturdayShort,
+	}
+)
+
+// MonthKey returns the key of the name of month.
+func MonthKey(month time.Month) string {
+	return monthKeys[(month-1+12)%12]
+}
+
+// WeekdayKey returns the key of the short name of day.
+func WeekdayKey(day time.Weekday) string {
+	return weekdayKeys[(day%7+7)%7]
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "image/color"
+
+// ApplyOpacity scales the alpha of c by opacity, clamped to [0, 1]. Unlike
+// setting the alpha directly, it keeps existing transparency: a half
+// transparent color at opacity 0.5 becomes a quarter opaque.
+//
+// Example:.
+//
+//	dimmed := utils.ApplyOpacity(th.Colors.Primary, 0.5)
+func ApplyOpacity(c color.NRGBA, opacity float32) color.NRGBA {
+	opacity = max(0, min(opacity, 1))
+	c.A = uint8(float32(c.A) * opacity)
+	return c
+}
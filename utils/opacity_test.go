@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestApplyOpacity(t *testing.T) {
+	white := color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+	halfWhite := color.NRGBA{R: 255, G: 255, B: 255, A: 127}
+
+	tests := []struct {
+		name    string
+		c       color.NRGBA
+		opacity float32
+		want    uint8
+	}{
+		{"opaque at half", white, 0.5, 127},
+		{"half transparent at half", halfWhite, 0.5, 63},
+		{"zero", white, 0, 0},
+		{"one", halfWhite, 1, 127},
+		{"clamped below zero", white, -0.5, 0},
+		{"clamped above one", halfWhite, 2, 127},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyOpacity(tt.c, tt.opacity)
+			if got.A != tt.want {
+				t.Errorf("ApplyOpacity(%v, %v).A = %d, want %d", tt.c, tt.opacity, got.A, tt.want)
+			}
+			if got.R != tt.c.R || got.G != tt.c.G || got.B != tt.c.B {
+				t.Errorf("ApplyOpacity(%v, %v) changed the color channels to %v", tt.c, tt.opacity, got)
+			}
+		})
+	}
+}
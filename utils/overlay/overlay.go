@@ -0,0 +1,87 @@
+/*
+Package overlay provides the floating layer shared by gio-shadcn popup
+components such as popovers, select boxes, menu bar dropdowns and context
+menus.
+
+Gio draws deferred operations after everything else in the frame, so a
+popup recorded with op.Record and deferred appears above the rest of the
+window. Defer adds an optional full-window dismiss layer beneath the popup,
+which reports presses outside the popup; Block stops presses on the popup
+itself from reaching that layer.
+
+# Quick Start
+
+Defer a panel below a trigger and close it on outside presses:
+
+	if overlay.Dismissed(gtx, &p.dismiss) {
+		p.open = false
+	}
+	macro := op.Record(gtx.Ops)
+	dims := layoutPanel(gtx)
+	overlay.Block(gtx, p, dims.Size)
+	overlay.Defer(gtx, image.Pt(0, triggerHeight), &p.dismiss, macro.Stop())
+
+# Features
+
+• Popups drawn above all other content
+• Outside-press dismissal through a full-window layer
+• Input blocking over the popup area
+*/
+package overlay
+
+import (
+	"image"
+
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+)
+
+// far is how far the dismiss layer extends in every direction, enough to
+// cover any window.
+const far = 1 << 20
+
+// Defer draws content offset from the current origin above all other content.
+// When dismiss is not nil, a full-window layer beneath content reports
+// presses for Dismissed.
+func Defer(gtx layout.Context, offset image.Point, dismiss event.Tag, content op.CallOp) {
+	macro := op.Record(gtx.Ops)
+
+	if dismiss != nil {
+		area := clip.Rect{Min: image.Pt(-far, -far), Max: image.Pt(far, far)}.Push(gtx.Ops)
+		event.Op(gtx.Ops, dismiss)
+		area.Pop()
+	}
+
+	stack := op.Offset(offset).Push(gtx.Ops)
+	content.Add(gtx.Ops)
+	stack.Pop()
+
+	op.Defer(gtx.Ops, macro.Stop())
+}
+
+// Dismissed reports whether the dismiss layer registered for tag was pressed
+// since the last frame.
+func Dismissed(gtx layout.Context, tag event.Tag) bool {
+	dismissed := false
+	for {
+		ev, ok := gtx.Event(pointer.Filter{Target: tag, Kinds: pointer.Press})
+		if !ok {
+			break
+		}
+		if e, ok := ev.(pointer.Event); ok && e.Kind == pointer.Press {
+			dismissed = true
+		}
+	}
+	return dismissed
+}
+
+// Block registers tag over an area of size at the current origin, so
+// presses on the popup don't reach the dismiss layer beneath it.
+func Block(gtx layout.Context, tag event.Tag, size image.Point) {
+	area := clip.Rect{Max: size}.Push(gtx.Ops)
+	event.Op(gtx.Ops, tag)
+	area.Pop()
+}